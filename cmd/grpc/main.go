@@ -1,10 +1,13 @@
 package main
 
 import (
+    "context"
     "log"
     "os"
     "os/signal"
     "syscall"
+    "time"
+    _ "time/tzdata" // Store opening hours need timezones even on minimal images
     
     // Application layer imports
     customerCmds "github.com/matzxrr/ddd-lemonadestore/internal/application/customer/commands"
@@ -12,16 +15,29 @@ import (
     orderCmds "github.com/matzxrr/ddd-lemonadestore/internal/application/order/commands"
     orderHandlers "github.com/matzxrr/ddd-lemonadestore/internal/application/order/event_handlers"
     orderQueries "github.com/matzxrr/ddd-lemonadestore/internal/application/order/queries"
+    paymentHandlers "github.com/matzxrr/ddd-lemonadestore/internal/application/payment/event_handlers"
+    promotionCmds "github.com/matzxrr/ddd-lemonadestore/internal/application/promotion/commands"
+    promotionQueries "github.com/matzxrr/ddd-lemonadestore/internal/application/promotion/queries"
+    purchasingCmds "github.com/matzxrr/ddd-lemonadestore/internal/application/purchasing/commands"
+    purchasingQueries "github.com/matzxrr/ddd-lemonadestore/internal/application/purchasing/queries"
     storeCmds "github.com/matzxrr/ddd-lemonadestore/internal/application/store/commands"
+    storeHandlers "github.com/matzxrr/ddd-lemonadestore/internal/application/store/event_handlers"
     storeQueries "github.com/matzxrr/ddd-lemonadestore/internal/application/store/queries"
     
     // Domain imports
+    "github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+    "github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+    "github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
     "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
     "github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+    "github.com/matzxrr/ddd-lemonadestore/internal/domain/tax"
     
     // Infrastructure imports
+    "github.com/matzxrr/ddd-lemonadestore/internal/infrastructure/config"
     "github.com/matzxrr/ddd-lemonadestore/internal/infrastructure/events"
+    "github.com/matzxrr/ddd-lemonadestore/internal/infrastructure/gateway"
     "github.com/matzxrr/ddd-lemonadestore/internal/infrastructure/persistence/memory"
+    "github.com/matzxrr/ddd-lemonadestore/internal/infrastructure/scheduler"
     
     // Interface imports
     grpcServer "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc"
@@ -29,6 +45,17 @@ import (
 )
 
 func main() {
+    // Load configuration
+    cfg, err := config.Load()
+    if err != nil {
+        log.Fatalf("Failed to load configuration: %v", err)
+    }
+    
+    pointsRate, err := customer.NewPointsExchangeRate(cfg.PointsValueCents)
+    if err != nil {
+        log.Fatalf("Invalid points exchange rate: %v", err)
+    }
+    
     // Initialize infrastructure
     // WHY: Creates all the technical implementations needed by the application
     
@@ -36,66 +63,300 @@ func main() {
     storeRepo := memory.NewInMemoryStoreRepository()
     orderRepo := memory.NewInMemoryOrderRepository()
     customerRepo := memory.NewInMemoryCustomerRepository()
+    promoRepo := memory.NewInMemoryPromotionRepository()
+    paymentRepo := memory.NewInMemoryPaymentRepository()
+    supplierRepo := memory.NewInMemorySupplierRepository()
+    purchaseOrderRepo := memory.NewInMemoryPurchaseOrderRepository()
+    transferRepo := memory.NewInMemoryTransferRepository()
+    stockAlertStore := memory.NewInMemoryStockAlertStore()
+    kitchenQueueStore := memory.NewInMemoryKitchenQueueStore()
     
     // 2. Create unit of work
-    uow := memory.NewInMemoryUnitOfWork(storeRepo, orderRepo, customerRepo)
+    uow := memory.NewInMemoryUnitOfWork(
+        storeRepo,
+        orderRepo,
+        customerRepo,
+        promoRepo,
+        paymentRepo,
+        supplierRepo,
+        purchaseOrderRepo,
+        transferRepo,
+    )
     
     // 3. Create event bus
     eventBus := events.NewInMemoryEventBus()
     
+    // 4. Create tax rate table and calculator
+    taxRates := memory.NewInMemoryTaxRateTable()
+    taxCalculator := tax.NewCalculator(taxRates)
+    
+    // 5. Create payment gateway (fake for demo, declines amounts ending in .13)
+    paymentGateway := gateway.NewFakePaymentGateway()
+    
     // Initialize application layer
     // WHAT: Create all command and query handlers
     
     // Store handlers
+    createStoreHandler := storeCmds.NewCreateStoreHandler(storeRepo, eventBus)
+    addProductHandler := storeCmds.NewAddProductHandler(storeRepo, eventBus)
     addInventoryHandler := storeCmds.NewAddInventoryHandler(storeRepo, eventBus)
     updatePriceHandler := storeCmds.NewUpdatePriceHandler(storeRepo, eventBus)
+    addModifierGroupHandler := storeCmds.NewAddModifierGroupHandler(storeRepo, eventBus)
+    addIngredientHandler := storeCmds.NewAddIngredientHandler(uow, eventBus)
+    restockIngredientHandler := storeCmds.NewRestockIngredientHandler(uow, eventBus)
+    setRecipeHandler := storeCmds.NewSetRecipeHandler(uow, eventBus)
+    expireReservationsHandler := storeCmds.NewExpireReservationsHandler(uow, eventBus)
+    setReorderPointHandler := storeCmds.NewSetReorderPointHandler(uow, eventBus)
+    adjustInventoryHandler := storeCmds.NewAdjustInventoryHandler(uow, eventBus)
+    countInventoryHandler := storeCmds.NewCountInventoryHandler(uow, eventBus)
+    transferInventoryHandler := storeCmds.NewTransferInventoryHandler(uow, eventBus)
+    receiveTransferHandler := storeCmds.NewReceiveTransferHandler(uow, eventBus)
+    cancelTransferHandler := storeCmds.NewCancelTransferHandler(uow, eventBus)
+    setOpeningHoursHandler := storeCmds.NewSetOpeningHoursHandler(storeRepo, eventBus)
+    pauseOrderingHandler := storeCmds.NewPauseOrderingHandler(storeRepo, eventBus)
+    resumeOrderingHandler := storeCmds.NewResumeOrderingHandler(storeRepo, eventBus)
+    schedulePriceChangeHandler := storeCmds.NewSchedulePriceChangeHandler(storeRepo, eventBus)
+    cancelPriceChangeHandler := storeCmds.NewCancelPriceChangeHandler(storeRepo, eventBus)
+    applyScheduledPricesHandler := storeCmds.NewApplyScheduledPricesHandler(uow, eventBus)
+    addPriceRuleHandler := storeCmds.NewAddPriceRuleHandler(storeRepo, eventBus)
+    removePriceRuleHandler := storeCmds.NewRemovePriceRuleHandler(storeRepo, eventBus)
+    addCategoryHandler := storeCmds.NewAddCategoryHandler(storeRepo, eventBus)
+    updateCategoryHandler := storeCmds.NewUpdateCategoryHandler(storeRepo, eventBus)
+    editProductHandler := storeCmds.NewEditProductHandler(storeRepo, eventBus)
+    deactivateProductHandler := storeCmds.NewDeactivateProductHandler(storeRepo, eventBus)
+    reactivateProductHandler := storeCmds.NewReactivateProductHandler(storeRepo, eventBus)
+    setDeliveryBandsHandler := storeCmds.NewSetDeliveryBandsHandler(storeRepo, eventBus)
+    setPrepTimesHandler := storeCmds.NewSetPrepTimesHandler(storeRepo, eventBus)
     getProductHandler := storeQueries.NewGetProductHandler(storeRepo)
+    listProductsHandler := storeQueries.NewListProductsHandler(storeRepo)
     getInventoryHandler := storeQueries.NewGetInventoryHandler(storeRepo)
+    listLowStockHandler := storeQueries.NewListLowStockHandler(stockAlertStore)
+    listAdjustmentsHandler := storeQueries.NewListInventoryAdjustmentsHandler(storeRepo)
+    listTransfersHandler := storeQueries.NewListOpenTransfersHandler(transferRepo)
+    getStoreStatusHandler := storeQueries.NewGetStoreStatusHandler(storeRepo)
+    getPriceHistoryHandler := storeQueries.NewGetPriceHistoryHandler(storeRepo)
+    listPriceRulesHandler := storeQueries.NewListPriceRulesHandler(storeRepo)
+    listCategoriesHandler := storeQueries.NewListCategoriesHandler(storeRepo)
+    listDeliveryBandsHandler := storeQueries.NewListDeliveryBandsHandler(storeRepo)
+    getPrepTimesHandler := storeQueries.NewGetPrepTimesHandler(storeRepo)
     
     // Order handlers
-    createOrderHandler := orderCmds.NewCreateOrderHandler(uow, eventBus)
+    // WHAT: Every store starts on the standard policy, the minutes it works with are set
+    // per store and a store can be given a policy of its own with SetPolicy
+    orderPolicies := memory.NewInMemoryOrderPolicies(&order.StandardOrderPolicy{})
+    createOrderHandler := orderCmds.NewCreateOrderHandler(
+        uow,
+        eventBus,
+        paymentGateway,
+        pointsRate,
+        taxCalculator,
+        orderPolicies,
+        cfg.ReservationTTL,
+    )
     cancelOrderHandler := orderCmds.NewCancelOrderHandler(uow, eventBus)
+    startPreparingHandler := orderCmds.NewStartPreparingOrderHandler(uow, eventBus)
+    markReadyHandler := orderCmds.NewMarkOrderReadyHandler(uow, eventBus)
+    completeOrderHandler := orderCmds.NewCompleteOrderHandler(uow, eventBus)
+    refundOrderHandler := orderCmds.NewRefundOrderHandler(uow, eventBus, paymentGateway)
+    assignCourierHandler := orderCmds.NewAssignCourierHandler(uow, eventBus)
+    dispatchOrderHandler := orderCmds.NewDispatchOrderHandler(uow, eventBus)
+    markDeliveredHandler := orderCmds.NewMarkOrderDeliveredHandler(uow, eventBus)
+    releaseScheduledOrdersHandler := orderCmds.NewReleaseScheduledOrdersHandler(uow, eventBus)
+    refreshReadyEstimatesHandler := orderCmds.NewRefreshReadyEstimatesHandler(uow, orderPolicies, eventBus)
     getOrderHandler := orderQueries.NewGetOrderHandler(orderRepo)
+    getOrderTimelineHandler := orderQueries.NewGetOrderTimelineHandler(orderRepo)
     listOrdersHandler := orderQueries.NewListOrdersHandler(orderRepo)
+    listOrdersByStatusHandler := orderQueries.NewListOrdersByStatusHandler(orderRepo)
+    trackOrderHandler := orderQueries.NewTrackOrderHandler(orderRepo, eventBus)
+    
+    // Kitchen handlers
+    bumpOrderHandler := orderCmds.NewBumpOrderHandler(orderRepo, startPreparingHandler, markReadyHandler)
+    recallOrderHandler := orderCmds.NewRecallOrderHandler(uow, eventBus)
+    getKitchenQueueHandler := orderQueries.NewGetKitchenQueueHandler(storeRepo, kitchenQueueStore)
+    watchKitchenQueueHandler := orderQueries.NewWatchKitchenQueueHandler(storeRepo, kitchenQueueStore)
     
     // Customer handlers
     registerCustomerHandler := customerCmds.NewRegisterCustomerHandler(customerRepo, eventBus)
     updateCustomerHandler := customerCmds.NewUpdateCustomerHandler(customerRepo, eventBus)
+    redeemPointsHandler := customerCmds.NewRedeemPointsHandler(uow, eventBus)
+    deactivateCustomerHandler := customerCmds.NewDeactivateCustomerHandler(customerRepo, eventBus)
     getCustomerHandler := customerQueries.NewGetCustomerHandler(customerRepo)
+    getCustomerByEmailHandler := customerQueries.NewGetCustomerByEmailHandler(customerRepo)
+    listCustomersByTypeHandler := customerQueries.NewListCustomersByTypeHandler(customerRepo)
+    
+    // Promotion handlers
+    createPromotionHandler := promotionCmds.NewCreatePromotionHandler(promoRepo, eventBus)
+    disablePromotionHandler := promotionCmds.NewDisablePromotionHandler(promoRepo, eventBus)
+    listPromotionsHandler := promotionQueries.NewListPromotionsHandler(promoRepo)
+    
+    // Purchasing handlers
+    registerSupplierHandler := purchasingCmds.NewRegisterSupplierHandler(supplierRepo, eventBus)
+    createPurchaseOrderHandler := purchasingCmds.NewCreatePurchaseOrderHandler(supplierRepo, purchaseOrderRepo, storeRepo)
+    submitPurchaseOrderHandler := purchasingCmds.NewSubmitPurchaseOrderHandler(purchaseOrderRepo, eventBus)
+    receiveGoodsHandler := purchasingCmds.NewReceiveGoodsHandler(uow, eventBus)
+    cancelPurchaseOrderHandler := purchasingCmds.NewCancelPurchaseOrderHandler(purchaseOrderRepo, eventBus)
+    listSuppliersHandler := purchasingQueries.NewListSuppliersHandler(supplierRepo)
+    getPurchaseOrderHandler := purchasingQueries.NewGetPurchaseOrderHandler(purchaseOrderRepo)
+    listPurchaseOrdersHandler := purchasingQueries.NewListPurchaseOrdersHandler(purchaseOrderRepo)
     
     // Register event handlers
     // WHY: Implements eventual consistency between aggregates
-    orderPlacedHandler := orderHandlers.NewOrderPlacedHandler(customerRepo)
+    orderPlacedHandler := orderHandlers.NewOrderPlacedHandler(uow)
     eventBus.Subscribe("order.confirmed", orderPlacedHandler.Handle)
     
+    orderRefundedHandler := orderHandlers.NewOrderRefundedHandler(uow)
+    eventBus.Subscribe("order.refunded", orderRefundedHandler.Handle)
+    
+    orderCancelledHandler := orderHandlers.NewOrderCancelledHandler(uow)
+    eventBus.Subscribe("order.cancelled", orderCancelledHandler.Handle)
+    
+    orderPaymentHandler := paymentHandlers.NewOrderPaymentHandler(paymentRepo, paymentGateway, eventBus)
+    eventBus.Subscribe("order.completed", orderPaymentHandler.Handle)
+    eventBus.Subscribe("order.delivered", orderPaymentHandler.Handle)
+    eventBus.Subscribe("order.cancelled", orderPaymentHandler.Handle)
+    
+    reservationExpiredHandler := orderHandlers.NewReservationExpiredHandler(cancelOrderHandler)
+    eventBus.Subscribe("inventory.reservation_released", reservationExpiredHandler.Handle)
+    
+    stockAlertHandler := storeHandlers.NewStockAlertHandler(stockAlertStore)
+    eventBus.Subscribe("inventory.low_stock", stockAlertHandler.Handle)
+    eventBus.Subscribe("inventory.out_of_stock", stockAlertHandler.Handle)
+    eventBus.Subscribe("inventory.stock_replenished", stockAlertHandler.Handle)
+    eventBus.Subscribe("inventory.stock_alert_cleared", stockAlertHandler.Handle)
+    
+    // Orders of up to two drinks jump ahead of bigger ones on the kitchen display
+    kitchenQueueHandler := orderHandlers.NewKitchenQueueHandler(orderRepo, kitchenQueueStore, order.NewRushOrderSpec(2))
+    eventBus.Subscribe("order.confirmed", kitchenQueueHandler.Handle)
+    eventBus.Subscribe("order.preparation_started", kitchenQueueHandler.Handle)
+    eventBus.Subscribe("order.ready", kitchenQueueHandler.Handle)
+    eventBus.Subscribe("order.recalled", kitchenQueueHandler.Handle)
+    eventBus.Subscribe("order.cancelled", kitchenQueueHandler.Handle)
+    
+    readyEstimateHandler := orderHandlers.NewReadyEstimateHandler(orderRepo, refreshReadyEstimatesHandler)
+    eventBus.Subscribe("order.confirmed", readyEstimateHandler.Handle)
+    eventBus.Subscribe("order.preparation_started", readyEstimateHandler.Handle)
+    eventBus.Subscribe("order.ready", readyEstimateHandler.Handle)
+    eventBus.Subscribe("order.recalled", readyEstimateHandler.Handle)
+    eventBus.Subscribe("order.cancelled", readyEstimateHandler.Handle)
+    eventBus.Subscribe("store.prep_times_set", readyEstimateHandler.Handle)
+    
     // Initialize sample data
     initializeSampleData(storeRepo)
+    initializeTaxRates(taxRates)
+    initializeSuppliers(supplierRepo)
     
     // Initialize presentation layer
     // WHERE: Create gRPC services that expose application functionality
     
     storeService := services.NewStoreService(
+        createStoreHandler,
+        addProductHandler,
         addInventoryHandler,
         updatePriceHandler,
+        addModifierGroupHandler,
+        addIngredientHandler,
+        restockIngredientHandler,
+        setRecipeHandler,
+        setReorderPointHandler,
+        adjustInventoryHandler,
+        countInventoryHandler,
+        transferInventoryHandler,
+        receiveTransferHandler,
+        cancelTransferHandler,
+        setOpeningHoursHandler,
+        pauseOrderingHandler,
+        resumeOrderingHandler,
+        schedulePriceChangeHandler,
+        cancelPriceChangeHandler,
+        addPriceRuleHandler,
+        removePriceRuleHandler,
+        addCategoryHandler,
+        updateCategoryHandler,
+        editProductHandler,
+        deactivateProductHandler,
+        reactivateProductHandler,
+        setDeliveryBandsHandler,
+        setPrepTimesHandler,
         getProductHandler,
+        listProductsHandler,
         getInventoryHandler,
+        listLowStockHandler,
+        listAdjustmentsHandler,
+        listTransfersHandler,
+        getStoreStatusHandler,
+        getPriceHistoryHandler,
+        listPriceRulesHandler,
+        listCategoriesHandler,
+        listDeliveryBandsHandler,
+        getPrepTimesHandler,
     )
     
     orderService := services.NewOrderService(
         createOrderHandler,
         cancelOrderHandler,
+        startPreparingHandler,
+        markReadyHandler,
+        completeOrderHandler,
+        refundOrderHandler,
+        assignCourierHandler,
+        dispatchOrderHandler,
+        markDeliveredHandler,
         getOrderHandler,
+        getOrderTimelineHandler,
         listOrdersHandler,
+        listOrdersByStatusHandler,
+        trackOrderHandler,
     )
     
     customerService := services.NewCustomerService(
         registerCustomerHandler,
         updateCustomerHandler,
+        redeemPointsHandler,
+        deactivateCustomerHandler,
         getCustomerHandler,
+        getCustomerByEmailHandler,
+        listCustomersByTypeHandler,
+    )
+    
+    promotionService := services.NewPromotionService(
+        createPromotionHandler,
+        disablePromotionHandler,
+        listPromotionsHandler,
+    )
+    
+    purchasingService := services.NewPurchasingService(
+        registerSupplierHandler,
+        createPurchaseOrderHandler,
+        submitPurchaseOrderHandler,
+        receiveGoodsHandler,
+        cancelPurchaseOrderHandler,
+        listSuppliersHandler,
+        getPurchaseOrderHandler,
+        listPurchaseOrdersHandler,
+    )
+    
+    kitchenService := services.NewKitchenService(
+        bumpOrderHandler,
+        recallOrderHandler,
+        getKitchenQueueHandler,
+        watchKitchenQueueHandler,
     )
     
     // Create and start gRPC server
-    server := grpcServer.NewServer(storeService, orderService, customerService)
+    server := grpcServer.NewServer(storeService, orderService, customerService, promotionService, purchasingService, kitchenService)
+    
+    // Release stock held by abandoned orders in the background
+    ctx, stopBackground := context.WithCancel(context.Background())
+    sweeper := scheduler.NewReservationSweeper(expireReservationsHandler, cfg.ReservationSweepInterval)
+    go sweeper.Run(ctx)
+    
+    // Apply scheduled price changes as they come due
+    priceScheduler := scheduler.NewPriceScheduler(applyScheduledPricesHandler, cfg.PriceScheduleInterval)
+    go priceScheduler.Run(ctx)
+    
+    // Send pre-orders to the kitchen in time for their pickup
+    preOrderScheduler := scheduler.NewPreOrderScheduler(releaseScheduledOrdersHandler, cfg.PreOrderReleaseInterval)
+    go preOrderScheduler.Run(ctx)
     
     // Handle graceful shutdown
     go func() {
@@ -103,11 +364,12 @@ func main() {
         signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
         <-sigChan
         
+        stopBackground()
         server.Stop()
     }()
     
     // Start server
-    if err := server.Start(":50051"); err != nil {
+    if err := server.Start(cfg.GRPCAddress); err != nil {
         log.Fatalf("Failed to start server: %v", err)
     }
 }
@@ -130,7 +392,7 @@ func initializeSampleData(storeRepo store.StoreRepository) {
     // Add products
     // Classic Lemonade
     classicPrice, _ := shared.NewMoney(299, "USD") // $2.99
-    mainStore.AddProduct(
+    classic, _ := mainStore.AddProduct(
         "Classic Lemonade",
         "Our traditional lemonade made with fresh lemons",
         classicPrice,
@@ -138,7 +400,7 @@ func initializeSampleData(storeRepo store.StoreRepository) {
     
     // Strawberry Lemonade
     strawberryPrice, _ := shared.NewMoney(349, "USD") // $3.49
-    mainStore.AddProduct(
+    strawberry, _ := mainStore.AddProduct(
         "Strawberry Lemonade",
         "Sweet strawberry mixed with tart lemonade",
         strawberryPrice,
@@ -146,20 +408,147 @@ func initializeSampleData(storeRepo store.StoreRepository) {
     
     // Pink Lemonade
     pinkPrice, _ := shared.NewMoney(329, "USD") // $3.29
-    mainStore.AddProduct(
+    pink, _ := mainStore.AddProduct(
         "Pink Lemonade",
         "A fun twist on classic lemonade",
         pinkPrice,
     )
     
-    // Add initial inventory
-    products := mainStore.Products()
-    for productID := range products {
-        mainStore.AddInventory(productID, 100) // 100 units each
+    // Strawberry Lemonade comes in two sizes, with ice and add-on choices
+    noCharge, _ := shared.NewMoney(0, "USD")
+    largeUpcharge, _ := shared.NewMoney(100, "USD") // +$1.00
+    mintUpcharge, _ := shared.NewMoney(50, "USD")   // +$0.50
+    
+    size, _ := store.NewModifierGroup("Size", true, 1, 1)
+    size.AddOption("Regular", noCharge)
+    large, _ := size.AddOption("Large", largeUpcharge)
+    mainStore.AddModifierGroup(strawberry.ID(), size)
+    
+    ice, _ := store.NewModifierGroup("Ice Level", false, 0, 1)
+    ice.AddOption("Less Ice", noCharge)
+    ice.AddOption("No Ice", noCharge)
+    mainStore.AddModifierGroup(strawberry.ID(), ice)
+    
+    addOns, _ := store.NewModifierGroup("Add-ons", false, 0, 2)
+    extraMint, _ := addOns.AddOption("Extra Mint", mintUpcharge)
+    basil, _ := addOns.AddOption("Fresh Basil", mintUpcharge)
+    mainStore.AddModifierGroup(strawberry.ID(), addOns)
+    
+    // Ingredients the stand stocks
+    lemons, _ := mainStore.AddIngredient("Lemons", store.UnitEach)
+    sugar, _ := mainStore.AddIngredient("Sugar", store.UnitGram)
+    strawberries, _ := mainStore.AddIngredient("Strawberries", store.UnitGram)
+    mint, _ := mainStore.AddIngredient("Mint", store.UnitGram)
+    basilLeaves, _ := mainStore.AddIngredient("Basil", store.UnitGram)
+    cups, _ := mainStore.AddIngredient("Cups", store.UnitEach)
+    
+    // Classic and Strawberry are made to order from ingredients
+    setRecipe := func(productID store.ProductID, optionID string, amounts map[*store.Ingredient]int) {
+        var components []store.RecipeComponent
+        for ingredient, quantity := range amounts {
+            component, _ := store.NewRecipeComponent(ingredient.ID(), quantity)
+            components = append(components, component)
+        }
+        recipe, _ := store.NewRecipe(components...)
+        mainStore.SetRecipe(productID, optionID, recipe)
     }
+    setRecipe(classic.ID(), "", map[*store.Ingredient]int{lemons: 2, sugar: 30, cups: 1})
+    setRecipe(strawberry.ID(), "", map[*store.Ingredient]int{lemons: 2, sugar: 25, strawberries: 60, cups: 1})
+    setRecipe(strawberry.ID(), large.ID(), map[*store.Ingredient]int{lemons: 1, sugar: 10, strawberries: 30})
+    setRecipe(strawberry.ID(), extraMint.ID(), map[*store.Ingredient]int{mint: 5})
+    setRecipe(strawberry.ID(), basil.ID(), map[*store.Ingredient]int{basilLeaves: 3})
+    
+    // Add initial ingredient stock
+    mainStore.RestockIngredient(lemons.ID(), 200)
+    mainStore.RestockIngredient(sugar.ID(), 5000)        // 5kg
+    mainStore.RestockIngredient(strawberries.ID(), 3000) // 3kg
+    mainStore.RestockIngredient(mint.ID(), 250)
+    mainStore.RestockIngredient(basilLeaves.ID(), 150)
+    mainStore.RestockIngredient(cups.ID(), 150)
+    
+    // Pink Lemonade arrives pre-made, so it is stocked by the bottle
+    mainStore.AddInventory(pink.ID(), 100)
+    
+    // Alert staff before anything runs out
+    mainStore.SetReorderPoint(classic.ID(), 20)
+    mainStore.SetReorderPoint(strawberry.ID(), 20)
+    mainStore.SetReorderPoint(pink.ID(), 10)
+    
+    // Group the menu, fresh-made drinks first
+    freshMade, _ := mainStore.AddCategory("Fresh Made", 1)
+    bottled, _ := mainStore.AddCategory("Bottled", 2)
+    mainStore.EditProduct(classic.ID(), string(classic.Name()), classic.Description(), freshMade.ID(), 1)
+    mainStore.EditProduct(strawberry.ID(), string(strawberry.Name()), strawberry.Description(), freshMade.ID(), 2)
+    mainStore.EditProduct(pink.ID(), string(pink.Name()), pink.Description(), bottled.ID(), 1)
+    
+    // Deliver nearby, with a higher fee for the next zip codes out
+    nearbyFee, _ := shared.NewMoney(299, "USD")  // $2.99
+    furtherFee, _ := shared.NewMoney(499, "USD") // $4.99
+    nearby, _ := store.NewDeliveryBand("Within 2 miles", []string{"12345"}, nearbyFee)
+    further, _ := store.NewDeliveryBand("2 to 5 miles", []string{"12344", "12346", "12347"}, furtherFee)
+    mainStore.SetDeliveryBands([]store.DeliveryBand{nearby, further})
     
     // Save store
     storeRepo.Save(mainStore)
     
     log.Printf("Initialized store with ID: %s", mainStore.ID())
+    
+    // A cart that only sells the bottled Pink Lemonade, stocked by transfer from Main Street
+    cartAddress, _ := shared.NewAddress(
+        "1 Boardwalk",
+        "Lemonade City",
+        "CA",
+        "12345",
+        "USA",
+    )
+    cart, _ := store.NewStore("Boardwalk Lemonade Cart", cartAddress)
+    cartPink, _ := cart.AddProduct("Pink Lemonade", "A fun twist on classic lemonade", pinkPrice)
+    cart.SetReorderPoint(cartPink.ID(), 10)
+    
+    // The cart only opens in the afternoon, and never on New Year's Day
+    var cartPeriods []store.OpeningPeriod
+    for day := time.Sunday; day <= time.Saturday; day++ {
+        opens, _ := store.ParseTimeOfDay("12:00")
+        closes, _ := store.ParseTimeOfDay("20:00")
+        period, _ := store.NewOpeningPeriod(day, opens, closes)
+        cartPeriods = append(cartPeriods, period)
+    }
+    newYear, _ := store.NewHolidayClosure("2027-01-01", "New Year's Day")
+    cartHours, _ := store.NewOpeningHours("America/Los_Angeles", cartPeriods, []store.HolidayClosure{newYear})
+    cart.SetOpeningHours(cartHours)
+    
+    // Happy hour on weekday afternoons
+    happyStarts, _ := store.ParseTimeOfDay("15:00")
+    happyEnds, _ := store.ParseTimeOfDay("17:00")
+    weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+    happyHour, _ := store.NewPriceRule("Happy Hour", weekdays, happyStarts, happyEnds, 20, nil)
+    cart.AddPriceRule(happyHour)
+    storeRepo.Save(cart)
+    
+    log.Printf("Initialized store with ID: %s", cart.ID())
+}
+
+// initializeTaxRates loads sales tax rates for the sample store's location
+// WHY: Real deployments would load these from a tax data provider
+func initializeTaxRates(taxRates *memory.InMemoryTaxRateTable) {
+    // California state sales tax
+    california, _ := tax.NewJurisdiction("USA", "CA", "")
+    stateRate, _ := tax.NewRate(california, "CA State Sales Tax", 0.0725)
+    taxRates.AddRate(stateRate)
+    
+    // Local district tax for Lemonade City
+    lemonadeCity, _ := tax.NewJurisdiction("USA", "CA", "12345")
+    localRate, _ := tax.NewRate(lemonadeCity, "Lemonade City District Tax", 0.01)
+    taxRates.AddRate(localRate)
+}
+
+// initializeSuppliers registers the vendors the sample store buys from
+func initializeSuppliers(supplierRepo purchasing.SupplierRepository) {
+    produce, _ := purchasing.NewSupplier("Sunny Citrus Farms", "orders@sunnycitrus.example", "555-0100")
+    supplierRepo.Save(produce)
+    
+    packaging, _ := purchasing.NewSupplier("Cup & Straw Supply Co.", "sales@cupandstraw.example", "555-0199")
+    supplierRepo.Save(packaging)
+    
+    log.Printf("Initialized supplier with ID: %s", produce.ID())
 }
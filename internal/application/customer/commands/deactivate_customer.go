@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+)
+
+// DeactivateCustomerCommand represents request to close a customer account
+type DeactivateCustomerCommand struct {
+    CustomerID string
+}
+
+// DeactivateCustomerHandler handles customer deactivation
+type DeactivateCustomerHandler struct {
+    customerRepo   customer.CustomerRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewDeactivateCustomerHandler(
+    customerRepo customer.CustomerRepository,
+    eventPublisher interfaces.EventPublisher,
+) *DeactivateCustomerHandler {
+    return &DeactivateCustomerHandler{
+        customerRepo:   customerRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *DeactivateCustomerHandler) Handle(ctx context.Context, cmd DeactivateCustomerCommand) error {
+    // Load customer
+    customerAgg, err := h.customerRepo.FindByID(customer.CustomerID(cmd.CustomerID))
+    if err != nil {
+        return err
+    }
+    
+    // Deactivate
+    err = customerAgg.Deactivate()
+    if err != nil {
+        return err
+    }
+    
+    // Save
+    err = h.customerRepo.Save(customerAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := customerAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+)
+
+// RedeemPointsCommand represents request to spend loyalty points
+type RedeemPointsCommand struct {
+    CustomerID string
+    Points     int
+}
+
+// RedeemPointsHandler handles loyalty point redemption
+// WHY: Checkout spends points on the same customer, so the balance is checked
+// and changed inside a unit of work
+type RedeemPointsHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewRedeemPointsHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *RedeemPointsHandler {
+    return &RedeemPointsHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+// Handle redeems points and returns the remaining balance
+func (h *RedeemPointsHandler) Handle(ctx context.Context, cmd RedeemPointsCommand) (int, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return 0, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load customer
+    var customerAgg *customer.Customer
+    customerAgg, err = h.uow.CustomerRepository().FindByID(customer.CustomerID(cmd.CustomerID))
+    if err != nil {
+        return 0, err
+    }
+    
+    // Redeem points
+    err = customerAgg.RedeemPoints(cmd.Points)
+    if err != nil {
+        return 0, err
+    }
+    
+    // Save
+    err = h.uow.CustomerRepository().Save(customerAgg)
+    if err != nil {
+        return 0, err
+    }
+    balance := customerAgg.LoyaltyPoints()
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return 0, err
+    }
+    
+    // Publish events
+    events := customerAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return balance, nil
+}
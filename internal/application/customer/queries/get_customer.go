@@ -29,6 +29,12 @@ func (h *GetCustomerHandler) Handle(ctx context.Context, query GetCustomerQuery)
     }
     
     // Convert to DTO
+    return toCustomerDTO(customerAgg), nil
+}
+
+// toCustomerDTO converts domain customer to DTO
+// WHY: Shared by all customer queries so they expose the same fields
+func toCustomerDTO(customerAgg *customer.Customer) *dtos.CustomerDTO {
     return &dtos.CustomerDTO{
         ID:            string(customerAgg.ID()),
         Email:         string(customerAgg.Email()),
@@ -37,6 +43,7 @@ func (h *GetCustomerHandler) Handle(ctx context.Context, query GetCustomerQuery)
         PhoneNumber:   string(customerAgg.PhoneNumber()),
         Type:          string(customerAgg.Type()),
         LoyaltyPoints: customerAgg.LoyaltyPoints(),
+        RegisteredAt:  customerAgg.RegisteredAt(),
         IsActive:      customerAgg.IsActive(),
-    }, nil
+    }
 }
@@ -0,0 +1,34 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+)
+
+// GetCustomerByEmailQuery represents customer lookup by email
+type GetCustomerByEmailQuery struct {
+    Email string
+}
+
+// GetCustomerByEmailHandler handles customer lookups by natural key
+// WHERE: Used at the counter when a customer only knows their email
+type GetCustomerByEmailHandler struct {
+    customerRepo customer.CustomerRepository
+}
+
+func NewGetCustomerByEmailHandler(customerRepo customer.CustomerRepository) *GetCustomerByEmailHandler {
+    return &GetCustomerByEmailHandler{customerRepo: customerRepo}
+}
+
+func (h *GetCustomerByEmailHandler) Handle(ctx context.Context, query GetCustomerByEmailQuery) (*dtos.CustomerDTO, error) {
+    // Load customer
+    customerAgg, err := h.customerRepo.FindByEmail(customer.Email(query.Email))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Convert to DTO
+    return toCustomerDTO(customerAgg), nil
+}
@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+)
+
+// ListCustomersByTypeQuery represents request for customers in a loyalty tier
+type ListCustomersByTypeQuery struct {
+    Type string
+}
+
+// ListCustomersByTypeHandler handles customer listing by tier
+type ListCustomersByTypeHandler struct {
+    customerRepo customer.CustomerRepository
+}
+
+func NewListCustomersByTypeHandler(customerRepo customer.CustomerRepository) *ListCustomersByTypeHandler {
+    return &ListCustomersByTypeHandler{customerRepo: customerRepo}
+}
+
+func (h *ListCustomersByTypeHandler) Handle(ctx context.Context, query ListCustomersByTypeQuery) ([]*dtos.CustomerDTO, error) {
+    // Validate tier
+    customerType, err := customer.NewCustomerType(query.Type)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Find customers
+    customers, err := h.customerRepo.FindByType(customerType)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Convert to DTOs
+    result := make([]*dtos.CustomerDTO, len(customers))
+    for i, customerAgg := range customers {
+        result[i] = toCustomerDTO(customerAgg)
+    }
+    
+    return result, nil
+}
@@ -0,0 +1,35 @@
+package dtos
+
+import (
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// DeliveryBandDTO represents the delivery fee for a group of zip codes
+type DeliveryBandDTO struct {
+    Name     string   `json:"name"` // e.g. "Within 2 miles"
+    ZipCodes []string `json:"zip_codes"`
+    Fee      float64  `json:"fee"`
+    Currency string   `json:"currency"`
+}
+
+// NewDeliveryBandDTO converts a domain delivery band to DTO
+func NewDeliveryBandDTO(band store.DeliveryBand) DeliveryBandDTO {
+    return DeliveryBandDTO{
+        Name:     band.Name(),
+        ZipCodes: band.ZipCodes(),
+        Fee:      float64(band.Fee().Amount()) / 100,
+        Currency: band.Fee().Currency(),
+    }
+}
+
+// NewAddressDTO converts a domain address to DTO
+func NewAddressDTO(address shared.Address) AddressDTO {
+    return AddressDTO{
+        Street:  address.Street(),
+        City:    address.City(),
+        State:   address.State(),
+        ZipCode: address.ZipCode(),
+        Country: address.Country(),
+    }
+}
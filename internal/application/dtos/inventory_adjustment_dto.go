@@ -0,0 +1,37 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// InventoryAdjustmentDTO represents one manual stock correction
+type InventoryAdjustmentDTO struct {
+    ID             string    `json:"id"`
+    ItemType       string    `json:"item_type"` // PRODUCT or INGREDIENT
+    ItemID         string    `json:"item_id"`
+    ItemName       string    `json:"item_name"`
+    Delta          int       `json:"delta"`
+    QuantityBefore int       `json:"quantity_before"`
+    QuantityAfter  int       `json:"quantity_after"`
+    Reason         string    `json:"reason"`
+    Note           string    `json:"note,omitempty"`
+    RecordedAt     time.Time `json:"recorded_at"`
+}
+
+// NewInventoryAdjustmentDTO converts a domain adjustment to DTO
+func NewInventoryAdjustmentDTO(adjustment store.Adjustment) *InventoryAdjustmentDTO {
+    return &InventoryAdjustmentDTO{
+        ID:             adjustment.ID(),
+        ItemType:       string(adjustment.Item().Type()),
+        ItemID:         adjustment.Item().ID(),
+        ItemName:       adjustment.ItemName(),
+        Delta:          adjustment.Delta(),
+        QuantityBefore: adjustment.QuantityBefore(),
+        QuantityAfter:  adjustment.QuantityAfter(),
+        Reason:         string(adjustment.Reason()),
+        Note:           adjustment.Note(),
+        RecordedAt:     adjustment.RecordedAt(),
+    }
+}
@@ -0,0 +1,73 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+)
+
+// KitchenTicketDTO represents an order waiting on the kitchen display
+type KitchenTicketDTO struct {
+    OrderID     string           `json:"order_id"`
+    StoreID     string           `json:"store_id"`
+    Status      string           `json:"status"`   // CONFIRMED or PREPARING
+    Priority    string           `json:"priority"` // SCHEDULED, RUSH or NORMAL
+    Fulfillment string           `json:"fulfillment"`
+    Items       []KitchenItemDTO `json:"items"`
+    PickupAt    time.Time        `json:"pickup_at,omitempty"` // Zero for as soon as possible
+    QueuedAt    time.Time        `json:"queued_at"`           // When the order reached the kitchen
+    UpdatedAt   time.Time        `json:"updated_at"`          // Last status change, newer tickets win
+    
+    // Queue order is Rank then DueAt, worked out by the kitchen queue handler
+    Rank  int       `json:"-"`
+    DueAt time.Time `json:"-"`
+}
+
+// KitchenItemDTO represents one line the kitchen has to make
+type KitchenItemDTO struct {
+    Name      string   `json:"name"`
+    Quantity  int      `json:"quantity"`
+    Modifiers []string `json:"modifiers"` // Option names, e.g. "Large", "Extra ice"
+}
+
+// KitchenQueueUpdateDTO represents one change to a store's kitchen queue
+type KitchenQueueUpdateDTO struct {
+    StoreID  string             `json:"store_id"`
+    Snapshot bool               `json:"snapshot"`  // First update on a stream, Upserted holds the whole queue
+    Upserted []KitchenTicketDTO `json:"upserted"`  // Tickets that are new or changed
+    Removed  []string           `json:"removed"`   // Order IDs that left the queue
+    OrderIDs []string           `json:"order_ids"` // Whole queue in display order after the change
+}
+
+// NewKitchenTicketDTO converts a domain order to a kitchen ticket
+func NewKitchenTicketDTO(orderAgg *order.Order, priority order.KitchenPriority) KitchenTicketDTO {
+    items := make([]KitchenItemDTO, len(orderAgg.Items()))
+    for i, item := range orderAgg.Items() {
+        modifiers := make([]string, len(item.Modifiers()))
+        for j, modifier := range item.Modifiers() {
+            modifiers[j] = modifier.OptionName()
+        }
+        items[i] = KitchenItemDTO{
+            Name:      item.Name(),
+            Quantity:  item.Quantity(),
+            Modifiers: modifiers,
+        }
+    }
+    
+    ticket := KitchenTicketDTO{
+        OrderID:     string(orderAgg.ID()),
+        StoreID:     string(orderAgg.StoreID()),
+        Status:      string(orderAgg.Status()),
+        Priority:    priority.String(),
+        Fulfillment: string(orderAgg.Fulfillment()),
+        Items:       items,
+        PickupAt:    orderAgg.PickupAt(),
+    }
+    for _, change := range orderAgg.StatusHistory() {
+        if change.To() == order.OrderStatusConfirmed {
+            ticket.QueuedAt = change.At()
+        }
+        ticket.UpdatedAt = change.At()
+    }
+    return ticket
+}
@@ -0,0 +1,84 @@
+package dtos
+
+import (
+	"strings"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// OpeningHoursDTO represents a store's weekly schedule
+type OpeningHoursDTO struct {
+    Timezone string              `json:"timezone"`
+    Periods  []OpeningPeriodDTO  `json:"periods"`
+    Holidays []HolidayClosureDTO `json:"holidays,omitempty"`
+}
+
+// OpeningPeriodDTO represents one stretch of a day the store is open
+type OpeningPeriodDTO struct {
+    Day    string `json:"day"`    // MONDAY to SUNDAY
+    Opens  string `json:"opens"`  // HH:MM local time
+    Closes string `json:"closes"` // HH:MM local time, 24:00 for midnight
+}
+
+// HolidayClosureDTO represents a date the store stays shut
+type HolidayClosureDTO struct {
+    Date string `json:"date"` // YYYY-MM-DD
+    Name string `json:"name"`
+}
+
+// StoreStatusDTO represents whether a store is taking orders
+type StoreStatusDTO struct {
+    StoreID      string           `json:"store_id"`
+    Open         bool             `json:"open"`
+    Reason       string           `json:"reason,omitempty"`       // Why the store is closed
+    NextOpening  time.Time        `json:"next_opening,omitempty"` // Zero when open or not known
+    Paused       bool             `json:"paused"`
+    PauseReason  string           `json:"pause_reason,omitempty"`
+    PausedUntil  time.Time        `json:"paused_until,omitempty"`
+    OpeningHours *OpeningHoursDTO `json:"opening_hours,omitempty"` // Nil means always open
+}
+
+// NewOpeningHoursDTO converts domain opening hours to DTO
+func NewOpeningHoursDTO(hours store.OpeningHours) *OpeningHoursDTO {
+    if !hours.IsSet() {
+        return nil
+    }
+    
+    dto := &OpeningHoursDTO{Timezone: hours.Timezone()}
+    for _, period := range hours.Periods() {
+        dto.Periods = append(dto.Periods, OpeningPeriodDTO{
+            Day:    strings.ToUpper(period.Day().String()),
+            Opens:  period.Opens().String(),
+            Closes: period.Closes().String(),
+        })
+    }
+    for _, holiday := range hours.Holidays() {
+        dto.Holidays = append(dto.Holidays, HolidayClosureDTO{
+            Date: holiday.Date(),
+            Name: holiday.Name(),
+        })
+    }
+    
+    return dto
+}
+
+// NewStoreStatusDTO converts a store's open status at a point in time to DTO
+func NewStoreStatusDTO(storeAgg *store.Store, at time.Time) *StoreStatusDTO {
+    status := storeAgg.OpenStatusAt(at)
+    
+    dto := &StoreStatusDTO{
+        StoreID:      string(storeAgg.ID()),
+        Open:         status.IsOpen(),
+        Reason:       status.Reason(),
+        NextOpening:  status.NextOpening(),
+        Paused:       storeAgg.IsPausedAt(at),
+        OpeningHours: NewOpeningHoursDTO(storeAgg.OpeningHours()),
+    }
+    if dto.Paused {
+        dto.PauseReason = storeAgg.PauseReason()
+        dto.PausedUntil = storeAgg.PausedUntil()
+    }
+    
+    return dto
+}
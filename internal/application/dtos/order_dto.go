@@ -4,14 +4,34 @@ import "time"
 
 // OrderDTO represents order data for application layer
 type OrderDTO struct {
-    ID          string         `json:"id"`
-    CustomerID  string         `json:"customer_id"`
-    StoreID     string         `json:"store_id"`
-    Status      string         `json:"status"`
-    TotalAmount float64        `json:"total_amount"`
-    Currency    string         `json:"currency"`
-    Items       []OrderItemDTO `json:"items"`
-    PlacedAt    time.Time      `json:"placed_at"`
+    ID             string         `json:"id"`
+    CustomerID     string         `json:"customer_id"`
+    StoreID        string         `json:"store_id"`
+    Status         string         `json:"status"`
+    Subtotal       float64        `json:"subtotal"`
+    DiscountAmount float64        `json:"discount_amount"`
+    Discounts      []DiscountDTO  `json:"discounts,omitempty"`
+    PromoCode      string         `json:"promo_code,omitempty"`
+    TaxAmount      float64        `json:"tax_amount"`
+    TaxLines       []TaxLineDTO   `json:"tax_lines,omitempty"`
+    PointsRedeemed int            `json:"points_redeemed"`
+    PointsCredit   float64        `json:"points_credit"`
+    TotalAmount    float64        `json:"total_amount"`
+    RefundedAmount float64        `json:"refunded_amount"`
+    Refunds        []RefundDTO    `json:"refunds,omitempty"`
+    Currency       string         `json:"currency"`
+    Items          []OrderItemDTO `json:"items"`
+    PlacedAt       time.Time      `json:"placed_at"`
+    PickupAt       time.Time      `json:"pickup_at,omitempty"`  // Zero for as soon as possible
+    ReleaseAt      time.Time      `json:"release_at,omitempty"` // When a pre-order goes to the kitchen
+    EstimatedReady time.Time      `json:"estimated_ready_at,omitempty"`
+    
+    FulfillmentType string      `json:"fulfillment_type"` // PICKUP or DELIVERY
+    DeliveryAddress *AddressDTO `json:"delivery_address,omitempty"`
+    DeliveryFee     float64     `json:"delivery_fee"`
+    CourierID       string      `json:"courier_id,omitempty"`
+    
+    StatusHistory []StatusChangeDTO `json:"status_history"` // Oldest first
 }
 
 // OrderItemDTO represents order item data
@@ -22,4 +42,87 @@ type OrderItemDTO struct {
     Quantity  int     `json:"quantity"`
     UnitPrice float64 `json:"unit_price"`
     Total     float64 `json:"total"`
+    TaxExempt bool    `json:"tax_exempt"`
+    
+    Modifiers []OrderItemModifierDTO `json:"modifiers,omitempty"`
+}
+
+// OrderItemModifierDTO represents an option chosen for an order item
+type OrderItemModifierDTO struct {
+    GroupName  string  `json:"group_name"`
+    OptionID   string  `json:"option_id"`
+    OptionName string  `json:"option_name"`
+    PriceDelta float64 `json:"price_delta"`
+}
+
+// DiscountDTO represents a discount line on an order
+type DiscountDTO struct {
+    Type        string  `json:"type"`
+    Description string  `json:"description"`
+    Amount      float64 `json:"amount"`
+}
+
+// TaxLineDTO represents a sales tax line on an order
+type TaxLineDTO struct {
+    Name          string  `json:"name"`
+    Rate          float64 `json:"rate"`
+    TaxableAmount float64 `json:"taxable_amount"`
+    Amount        float64 `json:"amount"`
+}
+
+// RefundDTO represents money given back on an order
+type RefundDTO struct {
+    ID         string          `json:"id"`
+    OrderID    string          `json:"order_id"`
+    Amount     float64         `json:"amount"`
+    Currency   string          `json:"currency"`
+    Points     int             `json:"points"` // Loyalty points given back
+    Reason     string          `json:"reason"`
+    Lines      []RefundLineDTO `json:"lines,omitempty"`
+    RefundedAt time.Time       `json:"refunded_at"`
+}
+
+// RefundLineDTO represents the refunded units of one order item
+type RefundLineDTO struct {
+    ItemID    string  `json:"item_id"`
+    ProductID string  `json:"product_id"`
+    Quantity  int     `json:"quantity"`
+    Amount    float64 `json:"amount"`
+}
+
+// OrderUpdateDTO represents a single status change pushed to order trackers
+// WHAT: Also pushed with the same status when only the ready estimate moved
+type OrderUpdateDTO struct {
+    OrderID        string    `json:"order_id"`
+    Status         string    `json:"status"`
+    UpdatedAt      time.Time `json:"updated_at"`
+    EstimatedReady time.Time `json:"estimated_ready_at,omitempty"` // Zero when it didn't change
+}
+
+// StatusChangeDTO represents one step in an order's lifecycle
+type StatusChangeDTO struct {
+    From   string    `json:"from,omitempty"` // Empty for the order being placed
+    To     string    `json:"to"`
+    At     time.Time `json:"at"`
+    Actor  string    `json:"actor,omitempty"`
+    Reason string    `json:"reason,omitempty"`
+}
+
+// OrderTimelineDTO represents an order's transitions and how long each stage took
+type OrderTimelineDTO struct {
+    OrderID     string            `json:"order_id"`
+    Status      string            `json:"status"`
+    PlacedAt    time.Time         `json:"placed_at"`
+    Transitions []StatusChangeDTO `json:"transitions"`
+    Stages      []StageTimingDTO  `json:"stages"`
+    Elapsed     time.Duration     `json:"elapsed"` // Placed until finished, or until now if still open
+}
+
+// StageTimingDTO represents the time an order spent in one status
+type StageTimingDTO struct {
+    Status    string        `json:"status"`
+    EnteredAt time.Time     `json:"entered_at"`
+    LeftAt    time.Time     `json:"left_at"` // Zero while the order is still in this status
+    Duration  time.Duration `json:"duration"`
+    Current   bool          `json:"current"`
 }
@@ -0,0 +1,161 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+)
+
+// NewOrderDTO converts domain order to DTO
+// WHY: Commands and queries return orders in the same shape, keep the mapping in one place
+func NewOrderDTO(orderAgg *order.Order) *OrderDTO {
+    items := make([]OrderItemDTO, len(orderAgg.Items()))
+    for i, item := range orderAgg.Items() {
+        modifiers := make([]OrderItemModifierDTO, len(item.Modifiers()))
+        for j, modifier := range item.Modifiers() {
+            modifiers[j] = OrderItemModifierDTO{
+                GroupName:  modifier.GroupName(),
+                OptionID:   modifier.OptionID(),
+                OptionName: modifier.OptionName(),
+                PriceDelta: float64(modifier.PriceDelta().Amount()) / 100,
+            }
+        }
+        
+        items[i] = OrderItemDTO{
+            ID:        item.ID(),
+            ProductID: string(item.ProductID()),
+            Name:      item.Name(),
+            Quantity:  item.Quantity(),
+            UnitPrice: float64(item.UnitPrice().Amount()) / 100,
+            Total:     float64(item.Total().Amount()) / 100,
+            TaxExempt: item.IsTaxExempt(),
+            Modifiers: modifiers,
+        }
+    }
+    
+    discounts := make([]DiscountDTO, len(orderAgg.Discounts()))
+    for i, discount := range orderAgg.Discounts() {
+        discounts[i] = DiscountDTO{
+            Type:        string(discount.Type()),
+            Description: discount.Description(),
+            Amount:      float64(discount.Amount().Amount()) / 100,
+        }
+    }
+    
+    taxLines := make([]TaxLineDTO, len(orderAgg.TaxLines()))
+    for i, line := range orderAgg.TaxLines() {
+        taxLines[i] = TaxLineDTO{
+            Name:          line.Name(),
+            Rate:          line.Rate(),
+            TaxableAmount: float64(line.TaxableAmount().Amount()) / 100,
+            Amount:        float64(line.Amount().Amount()) / 100,
+        }
+    }
+    
+    refunds := make([]RefundDTO, len(orderAgg.Refunds()))
+    for i, refund := range orderAgg.Refunds() {
+        refunds[i] = *NewRefundDTO(orderAgg.ID(), refund)
+    }
+    
+    orderDTO := &OrderDTO{
+        ID:             string(orderAgg.ID()),
+        CustomerID:     string(orderAgg.CustomerID()),
+        StoreID:        string(orderAgg.StoreID()),
+        Status:         string(orderAgg.Status()),
+        Subtotal:       float64(orderAgg.Subtotal().Amount()) / 100,
+        DiscountAmount: float64(orderAgg.DiscountAmount().Amount()) / 100,
+        Discounts:      discounts,
+        PromoCode:      orderAgg.PromoCode(),
+        TaxAmount:      float64(orderAgg.TaxAmount().Amount()) / 100,
+        TaxLines:       taxLines,
+        PointsRedeemed: orderAgg.PointsRedeemed(),
+        PointsCredit:   float64(orderAgg.PointsCredit().Amount()) / 100,
+        TotalAmount:    float64(orderAgg.TotalAmount().Amount()) / 100,
+        RefundedAmount: float64(orderAgg.RefundedAmount().Amount()) / 100,
+        Refunds:        refunds,
+        Currency:       orderAgg.TotalAmount().Currency(),
+        Items:          items,
+        PlacedAt:       orderAgg.PlacedAt(),
+        PickupAt:       orderAgg.PickupAt(),
+        ReleaseAt:      orderAgg.ReleaseAt(),
+        EstimatedReady: orderAgg.EstimatedReadyAt(),
+        StatusHistory:  newStatusChangeDTOs(orderAgg.StatusHistory()),
+        
+        FulfillmentType: string(orderAgg.Fulfillment()),
+        DeliveryFee:     float64(orderAgg.DeliveryFee().Amount()) / 100,
+        CourierID:       orderAgg.CourierID(),
+    }
+    if orderAgg.IsDelivery() {
+        address := NewAddressDTO(orderAgg.DeliveryAddress())
+        orderDTO.DeliveryAddress = &address
+    }
+    return orderDTO
+}
+
+func newStatusChangeDTOs(history []order.StatusChange) []StatusChangeDTO {
+    changes := make([]StatusChangeDTO, len(history))
+    for i, change := range history {
+        changes[i] = StatusChangeDTO{
+            From:   string(change.From()),
+            To:     string(change.To()),
+            At:     change.At(),
+            Actor:  change.Actor(),
+            Reason: change.Reason(),
+        }
+    }
+    return changes
+}
+
+// NewOrderTimelineDTO converts an order's history to a timeline as of now
+func NewOrderTimelineDTO(orderAgg *order.Order, now time.Time) *OrderTimelineDTO {
+    timings := orderAgg.StageTimings(now)
+    stages := make([]StageTimingDTO, len(timings))
+    for i, timing := range timings {
+        stages[i] = StageTimingDTO{
+            Status:    string(timing.Status()),
+            EnteredAt: timing.EnteredAt(),
+            LeftAt:    timing.LeftAt(),
+            Duration:  timing.Duration(),
+            Current:   timing.IsCurrent(),
+        }
+    }
+    
+    // A finished order stops the clock when it reached its final status
+    end := now
+    if history := orderAgg.StatusHistory(); orderAgg.Status().IsTerminal() && len(history) > 0 {
+        end = history[len(history)-1].At()
+    }
+    
+    return &OrderTimelineDTO{
+        OrderID:     string(orderAgg.ID()),
+        Status:      string(orderAgg.Status()),
+        PlacedAt:    orderAgg.PlacedAt(),
+        Transitions: newStatusChangeDTOs(orderAgg.StatusHistory()),
+        Stages:      stages,
+        Elapsed:     end.Sub(orderAgg.PlacedAt()),
+    }
+}
+
+// NewRefundDTO converts a refund recorded on an order to DTO
+func NewRefundDTO(orderID order.OrderID, refund order.Refund) *RefundDTO {
+    lines := make([]RefundLineDTO, len(refund.Lines()))
+    for i, line := range refund.Lines() {
+        lines[i] = RefundLineDTO{
+            ItemID:    line.ItemID(),
+            ProductID: string(line.ProductID()),
+            Quantity:  line.Quantity(),
+            Amount:    float64(line.Amount().Amount()) / 100,
+        }
+    }
+    
+    return &RefundDTO{
+        ID:         refund.ID(),
+        OrderID:    string(orderID),
+        Amount:     float64(refund.Amount().Amount()) / 100,
+        Currency:   refund.Amount().Currency(),
+        Points:     refund.Points(),
+        Reason:     refund.Reason(),
+        Lines:      lines,
+        RefundedAt: refund.RefundedAt(),
+    }
+}
@@ -0,0 +1,25 @@
+package dtos
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+
+// PrepTimesDTO represents the figures a store's ready estimates are based on
+type PrepTimesDTO struct {
+    BaseMinutes       int     `json:"base_minutes"`
+    MinutesPerItem    int     `json:"minutes_per_item"`
+    LargeOrderAmount  float64 `json:"large_order_amount"` // Orders totalling at least this take extra
+    Currency          string  `json:"currency"`
+    LargeOrderMinutes int     `json:"large_order_minutes"`
+    MinutesPerQueued  int     `json:"minutes_per_queued"` // Per order ahead in the kitchen
+}
+
+// NewPrepTimesDTO converts domain prep times to DTO
+func NewPrepTimesDTO(times store.PrepTimes) PrepTimesDTO {
+    return PrepTimesDTO{
+        BaseMinutes:       times.BaseMinutes(),
+        MinutesPerItem:    times.MinutesPerItem(),
+        LargeOrderAmount:  float64(times.LargeOrderAmount().Amount()) / 100,
+        Currency:          times.LargeOrderAmount().Currency(),
+        LargeOrderMinutes: times.LargeOrderMinutes(),
+        MinutesPerQueued:  times.MinutesPerQueued(),
+    }
+}
@@ -0,0 +1,113 @@
+package dtos
+
+import (
+	"strings"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// PriceHistoryDTO represents a product's past, current and upcoming base prices
+type PriceHistoryDTO struct {
+    ProductID    string                 `json:"product_id"`
+    Currency     string                 `json:"currency"`
+    CurrentPrice float64                `json:"current_price"` // Base price before modifiers and price rules
+    PriceNow     float64                `json:"price_now"`     // What one unit costs right now after price rules
+    History      []PriceHistoryEntryDTO `json:"history"`       // Oldest first
+    Scheduled    []ScheduledPriceDTO    `json:"scheduled,omitempty"`
+}
+
+// PriceHistoryEntryDTO represents one base price and when it was in force
+type PriceHistoryEntryDTO struct {
+    Price         float64   `json:"price"`
+    Reason        string    `json:"reason"` // INITIAL, MANUAL or SCHEDULED
+    EffectiveFrom time.Time `json:"effective_from"`
+    EffectiveTo   time.Time `json:"effective_to"` // Zero for the current price
+}
+
+// ScheduledPriceDTO represents a base price change queued for the future
+type ScheduledPriceDTO struct {
+    ID          string    `json:"id"`
+    ProductID   string    `json:"product_id"`
+    Price       float64   `json:"price"`
+    EffectiveAt time.Time `json:"effective_at"`
+}
+
+// PriceRuleDTO represents a recurring discount window such as happy hour
+type PriceRuleDTO struct {
+    ID         string   `json:"id"`
+    Name       string   `json:"name"`
+    Days       []string `json:"days"`   // MONDAY to SUNDAY
+    Starts     string   `json:"starts"` // HH:MM store local time
+    Ends       string   `json:"ends"`
+    PercentOff int      `json:"percent_off"`
+    ProductIDs []string `json:"product_ids,omitempty"` // Empty covers the whole menu
+}
+
+// NewPriceHistoryDTO converts a product's price record to DTO
+func NewPriceHistoryDTO(storeAgg *store.Store, product *store.Product, now time.Time) (*PriceHistoryDTO, error) {
+    priceNow, err := storeAgg.UnitPriceAt(product.ID(), nil, now)
+    if err != nil {
+        return nil, err
+    }
+    
+    entries := product.PriceHistory()
+    history := make([]PriceHistoryEntryDTO, len(entries))
+    for i, entry := range entries {
+        history[i] = PriceHistoryEntryDTO{
+            Price:         float64(entry.Price().Amount()) / 100,
+            Reason:        string(entry.Reason()),
+            EffectiveFrom: entry.EffectiveFrom(),
+        }
+        if i+1 < len(entries) {
+            history[i].EffectiveTo = entries[i+1].EffectiveFrom()
+        }
+    }
+    
+    scheduled := make([]ScheduledPriceDTO, len(product.ScheduledPrices()))
+    for i, change := range product.ScheduledPrices() {
+        scheduled[i] = NewScheduledPriceDTO(product.ID(), change)
+    }
+    
+    return &PriceHistoryDTO{
+        ProductID:    string(product.ID()),
+        Currency:     product.Price().Currency(),
+        CurrentPrice: float64(product.Price().Amount()) / 100,
+        PriceNow:     float64(priceNow.Amount()) / 100,
+        History:      history,
+        Scheduled:    scheduled,
+    }, nil
+}
+
+// NewScheduledPriceDTO converts a queued price change to DTO
+func NewScheduledPriceDTO(productID store.ProductID, change store.ScheduledPrice) ScheduledPriceDTO {
+    return ScheduledPriceDTO{
+        ID:          change.ID(),
+        ProductID:   string(productID),
+        Price:       float64(change.Price().Amount()) / 100,
+        EffectiveAt: change.EffectiveAt(),
+    }
+}
+
+// NewPriceRuleDTO converts a domain price rule to DTO
+func NewPriceRuleDTO(rule store.PriceRule) PriceRuleDTO {
+    days := make([]string, len(rule.Days()))
+    for i, day := range rule.Days() {
+        days[i] = strings.ToUpper(day.String())
+    }
+    
+    productIDs := make([]string, len(rule.Products()))
+    for i, productID := range rule.Products() {
+        productIDs[i] = string(productID)
+    }
+    
+    return PriceRuleDTO{
+        ID:         rule.ID(),
+        Name:       rule.Name(),
+        Days:       days,
+        Starts:     rule.Starts().String(),
+        Ends:       rule.Ends().String(),
+        PercentOff: rule.PercentOff(),
+        ProductIDs: productIDs,
+    }
+}
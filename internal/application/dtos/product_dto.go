@@ -10,5 +10,66 @@ type ProductDTO struct {
     Price       float64 `json:"price"`
     Currency    string  `json:"currency"`
     IsActive    bool    `json:"is_active"`
-    Quantity    int     `json:"quantity"`
+    TaxExempt   bool    `json:"tax_exempt"`
+    Quantity    int     `json:"quantity"` // Makeable count for made-to-order products
+    MadeToOrder bool    `json:"made_to_order"`
+    
+    CategoryID   string `json:"category_id,omitempty"` // Empty for uncategorized products
+    DisplayOrder int    `json:"display_order"`
+    
+    // Inventory queries only
+    Reserved     int    `json:"reserved,omitempty"` // Units held for open orders
+    Incoming     int    `json:"incoming,omitempty"` // Units in transit from other stores
+    ReorderPoint int    `json:"reorder_point,omitempty"`
+    StockLevel   string `json:"stock_level,omitempty"`
+    
+    ModifierGroups []ModifierGroupDTO   `json:"modifier_groups,omitempty"`
+    Recipe         []RecipeComponentDTO `json:"recipe,omitempty"`
+}
+
+// ModifierGroupDTO represents a customizable choice on a product
+type ModifierGroupDTO struct {
+    ID            string              `json:"id"`
+    Name          string              `json:"name"`
+    Required      bool                `json:"required"`
+    MinSelections int                 `json:"min_selections"`
+    MaxSelections int                 `json:"max_selections"`
+    Options       []ModifierOptionDTO `json:"options"`
+}
+
+// ModifierOptionDTO represents one option within a modifier group
+type ModifierOptionDTO struct {
+    ID         string               `json:"id"`
+    Name       string               `json:"name"`
+    PriceDelta float64              `json:"price_delta"`
+    Recipe     []RecipeComponentDTO `json:"recipe,omitempty"`
+}
+
+// RecipeComponentDTO represents one ingredient in a recipe
+type RecipeComponentDTO struct {
+    IngredientID string `json:"ingredient_id"`
+    Quantity     int    `json:"quantity"`
+}
+
+// IngredientDTO represents an ingredient and its stock level
+type IngredientDTO struct {
+    ID       string `json:"id"`
+    Name     string `json:"name"`
+    Unit     string `json:"unit"`
+    Quantity int    `json:"quantity"` // On hand
+    Reserved int    `json:"reserved"` // Held for open orders
+    Incoming int    `json:"incoming"` // In transit from other stores
+}
+
+// InventoryDTO represents a store's stock of products and ingredients
+type InventoryDTO struct {
+    Products    []ProductDTO    `json:"products"`
+    Ingredients []IngredientDTO `json:"ingredients"`
+}
+
+// CategoryDTO represents a section of a store's menu
+type CategoryDTO struct {
+    ID           string `json:"id"`
+    Name         string `json:"name"`
+    DisplayOrder int    `json:"display_order"`
 }
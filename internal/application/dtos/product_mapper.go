@@ -0,0 +1,80 @@
+package dtos
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+
+// NewProductDTO converts domain product to DTO
+// WHY: Commands and queries return products in the same shape, keep the mapping in one place
+func NewProductDTO(product *store.Product, quantity int) *ProductDTO {
+    groups := make([]ModifierGroupDTO, len(product.ModifierGroups()))
+    for i, group := range product.ModifierGroups() {
+        options := make([]ModifierOptionDTO, len(group.Options()))
+        for j, option := range group.Options() {
+            options[j] = ModifierOptionDTO{
+                ID:         option.ID(),
+                Name:       option.Name(),
+                PriceDelta: float64(option.PriceDelta().Amount()) / 100,
+                Recipe:     newRecipeDTOs(option.Recipe()),
+            }
+        }
+        
+        groups[i] = ModifierGroupDTO{
+            ID:            group.ID(),
+            Name:          group.Name(),
+            Required:      group.IsRequired(),
+            MinSelections: group.MinSelections(),
+            MaxSelections: group.MaxSelections(),
+            Options:       options,
+        }
+    }
+    
+    return &ProductDTO{
+        ID:             string(product.ID()),
+        Name:           string(product.Name()),
+        Description:    product.Description(),
+        Price:          float64(product.Price().Amount()) / 100,
+        Currency:       product.Price().Currency(),
+        IsActive:       product.IsActive(),
+        TaxExempt:      product.IsTaxExempt(),
+        Quantity:       quantity,
+        MadeToOrder:    product.IsMadeToOrder(),
+        CategoryID:     string(product.CategoryID()),
+        DisplayOrder:   product.DisplayOrder(),
+        ModifierGroups: groups,
+        Recipe:         newRecipeDTOs(product.Recipe()),
+    }
+}
+
+// NewCategoryDTO converts a domain menu category to DTO
+func NewCategoryDTO(category *store.Category) *CategoryDTO {
+    return &CategoryDTO{
+        ID:           string(category.ID()),
+        Name:         category.Name(),
+        DisplayOrder: category.DisplayOrder(),
+    }
+}
+
+func newRecipeDTOs(recipe store.Recipe) []RecipeComponentDTO {
+    if recipe.IsEmpty() {
+        return nil
+    }
+    
+    components := make([]RecipeComponentDTO, len(recipe.Components()))
+    for i, component := range recipe.Components() {
+        components[i] = RecipeComponentDTO{
+            IngredientID: string(component.IngredientID()),
+            Quantity:     component.Quantity(),
+        }
+    }
+    return components
+}
+
+// NewIngredientDTO converts a domain ingredient and its stock levels to DTO
+func NewIngredientDTO(ingredient *store.Ingredient, quantity int, reserved int) *IngredientDTO {
+    return &IngredientDTO{
+        ID:       string(ingredient.ID()),
+        Name:     ingredient.Name(),
+        Unit:     string(ingredient.Unit()),
+        Quantity: quantity,
+        Reserved: reserved,
+    }
+}
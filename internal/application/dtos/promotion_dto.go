@@ -0,0 +1,25 @@
+package dtos
+
+import "time"
+
+// PromotionDTO represents promotion data for application layer
+// WHAT: Reward fields that don't apply to RewardType are left zero
+type PromotionDTO struct {
+    ID                 string    `json:"id"`
+    Code               string    `json:"code"`
+    Description        string    `json:"description"`
+    RewardType         string    `json:"reward_type"`
+    PercentOff         float64   `json:"percent_off,omitempty"`
+    AmountOff          float64   `json:"amount_off,omitempty"`
+    Currency           string    `json:"currency,omitempty"`
+    ProductID          string    `json:"product_id,omitempty"`
+    BuyQuantity        int       `json:"buy_quantity,omitempty"`
+    FreeQuantity       int       `json:"free_quantity,omitempty"`
+    MinimumSpend       float64   `json:"minimum_spend,omitempty"`
+    ValidFrom          time.Time `json:"valid_from"`
+    ValidUntil         time.Time `json:"valid_until"`
+    MaxUses            int       `json:"max_uses"`
+    MaxUsesPerCustomer int       `json:"max_uses_per_customer"`
+    TotalUses          int       `json:"total_uses"`
+    IsActive           bool      `json:"is_active"`
+}
@@ -0,0 +1,33 @@
+package dtos
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+
+// NewPromotionDTO converts domain promotion to DTO
+func NewPromotionDTO(promotionAgg *promotion.Promotion) *PromotionDTO {
+    reward := promotionAgg.Reward()
+    
+    currency := reward.Amount().Currency()
+    if currency == "" {
+        currency = promotionAgg.MinimumSpend().Currency()
+    }
+    
+    return &PromotionDTO{
+        ID:                 string(promotionAgg.ID()),
+        Code:               string(promotionAgg.Code()),
+        Description:        promotionAgg.Description(),
+        RewardType:         string(reward.Type()),
+        PercentOff:         reward.Rate() * 100,
+        AmountOff:          float64(reward.Amount().Amount()) / 100,
+        Currency:           currency,
+        ProductID:          string(reward.ProductID()),
+        BuyQuantity:        reward.BuyQuantity(),
+        FreeQuantity:       reward.FreeQuantity(),
+        MinimumSpend:       float64(promotionAgg.MinimumSpend().Amount()) / 100,
+        ValidFrom:          promotionAgg.ValidFrom(),
+        ValidUntil:         promotionAgg.ValidUntil(),
+        MaxUses:            promotionAgg.Limits().MaxUses(),
+        MaxUsesPerCustomer: promotionAgg.Limits().MaxUsesPerCustomer(),
+        TotalUses:          promotionAgg.TotalUses(),
+        IsActive:           promotionAgg.IsActive(),
+    }
+}
@@ -0,0 +1,37 @@
+package dtos
+
+import "time"
+
+// SupplierDTO represents supplier data for application layer
+type SupplierDTO struct {
+    ID           string `json:"id"`
+    Name         string `json:"name"`
+    ContactEmail string `json:"contact_email,omitempty"`
+    Phone        string `json:"phone,omitempty"`
+    IsActive     bool   `json:"is_active"`
+}
+
+// PurchaseOrderDTO represents purchase order data for application layer
+type PurchaseOrderDTO struct {
+    ID          string                 `json:"id"`
+    SupplierID  string                 `json:"supplier_id"`
+    StoreID     string                 `json:"store_id"`
+    Status      string                 `json:"status"`
+    Lines       []PurchaseOrderLineDTO `json:"lines"`
+    TotalCost   float64                `json:"total_cost"`
+    Currency    string                 `json:"currency"`
+    CreatedAt   time.Time              `json:"created_at"`
+    SubmittedAt time.Time              `json:"submitted_at"` // Zero while still a draft
+}
+
+// PurchaseOrderLineDTO represents one item on a purchase order
+type PurchaseOrderLineDTO struct {
+    ID               string  `json:"id"`
+    ItemType         string  `json:"item_type"` // PRODUCT or INGREDIENT
+    ItemID           string  `json:"item_id"`
+    ItemName         string  `json:"item_name"`
+    QuantityOrdered  int     `json:"quantity_ordered"`
+    QuantityReceived int     `json:"quantity_received"`
+    UnitCost         float64 `json:"unit_cost"`
+    LineCost         float64 `json:"line_cost"`
+}
@@ -0,0 +1,43 @@
+package dtos
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+
+// NewSupplierDTO converts domain supplier to DTO
+func NewSupplierDTO(supplier *purchasing.Supplier) *SupplierDTO {
+    return &SupplierDTO{
+        ID:           string(supplier.ID()),
+        Name:         supplier.Name(),
+        ContactEmail: supplier.ContactEmail(),
+        Phone:        supplier.Phone(),
+        IsActive:     supplier.IsActive(),
+    }
+}
+
+// NewPurchaseOrderDTO converts domain purchase order to DTO
+func NewPurchaseOrderDTO(purchaseOrder *purchasing.PurchaseOrder) *PurchaseOrderDTO {
+    lines := make([]PurchaseOrderLineDTO, len(purchaseOrder.Lines()))
+    for i, line := range purchaseOrder.Lines() {
+        lines[i] = PurchaseOrderLineDTO{
+            ID:               line.ID(),
+            ItemType:         string(line.Item().Type()),
+            ItemID:           line.Item().ID(),
+            ItemName:         line.ItemName(),
+            QuantityOrdered:  line.QuantityOrdered(),
+            QuantityReceived: line.QuantityReceived(),
+            UnitCost:         float64(line.UnitCost().Amount()) / 100,
+            LineCost:         float64(line.LineCost().Amount()) / 100,
+        }
+    }
+    
+    return &PurchaseOrderDTO{
+        ID:          string(purchaseOrder.ID()),
+        SupplierID:  string(purchaseOrder.SupplierID()),
+        StoreID:     string(purchaseOrder.StoreID()),
+        Status:      string(purchaseOrder.Status()),
+        Lines:       lines,
+        TotalCost:   float64(purchaseOrder.TotalCost().Amount()) / 100,
+        Currency:    purchaseOrder.Currency(),
+        CreatedAt:   purchaseOrder.CreatedAt(),
+        SubmittedAt: purchaseOrder.SubmittedAt(),
+    }
+}
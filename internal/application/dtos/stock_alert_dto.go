@@ -0,0 +1,14 @@
+package dtos
+
+import "time"
+
+// StockAlertDTO represents a product that is low on or out of stock
+type StockAlertDTO struct {
+    StoreID      string    `json:"store_id"`
+    ProductID    string    `json:"product_id"`
+    ProductName  string    `json:"product_name"`
+    Level        string    `json:"level"` // LOW or OUT_OF_STOCK, OK once cleared
+    Available    int       `json:"available"`
+    ReorderPoint int       `json:"reorder_point"`
+    RaisedAt     time.Time `json:"raised_at"`
+}
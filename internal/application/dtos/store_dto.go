@@ -0,0 +1,9 @@
+package dtos
+
+// StoreDTO represents store data for application layer
+// WHY: Exposes store identity and location without leaking the aggregate
+type StoreDTO struct {
+    ID       string     `json:"id"`
+    Name     string     `json:"name"`
+    Location AddressDTO `json:"location"`
+}
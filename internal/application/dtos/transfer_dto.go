@@ -0,0 +1,53 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// TransferDTO represents stock moving between two stores
+type TransferDTO struct {
+    ID                 string            `json:"id"`
+    SourceStoreID      string            `json:"source_store_id"`
+    DestinationStoreID string            `json:"destination_store_id"`
+    Status             string            `json:"status"` // IN_TRANSIT, RECEIVED or CANCELLED
+    Lines              []TransferLineDTO `json:"lines"`
+    Note               string            `json:"note,omitempty"`
+    DispatchedAt       time.Time         `json:"dispatched_at"`
+    ClosedAt           time.Time         `json:"closed_at"` // Zero while in transit
+}
+
+// TransferLineDTO represents one item on a transfer
+type TransferLineDTO struct {
+    ItemType          string `json:"item_type"` // PRODUCT or INGREDIENT
+    SourceItemID      string `json:"source_item_id"`
+    DestinationItemID string `json:"destination_item_id"`
+    ItemName          string `json:"item_name"`
+    Quantity          int    `json:"quantity"`
+}
+
+// NewTransferDTO converts a domain transfer to DTO
+func NewTransferDTO(transfer *store.Transfer) *TransferDTO {
+    lines := make([]TransferLineDTO, len(transfer.Lines()))
+    for i, line := range transfer.Lines() {
+        lines[i] = TransferLineDTO{
+            ItemType:          string(line.SourceItem().Type()),
+            SourceItemID:      line.SourceItem().ID(),
+            DestinationItemID: line.DestinationItem().ID(),
+            ItemName:          line.ItemName(),
+            Quantity:          line.Quantity(),
+        }
+    }
+    
+    return &TransferDTO{
+        ID:                 string(transfer.ID()),
+        SourceStoreID:      string(transfer.SourceStoreID()),
+        DestinationStoreID: string(transfer.DestinationStoreID()),
+        Status:             string(transfer.Status()),
+        Lines:              lines,
+        Note:               transfer.Note(),
+        DispatchedAt:       transfer.DispatchedAt(),
+        ClosedAt:           transfer.ClosedAt(),
+    }
+}
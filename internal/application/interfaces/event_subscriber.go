@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// EventHandler is a function that handles domain events
+type EventHandler func(ctx context.Context, event shared.DomainEvent) error
+
+// EventSubscriber defines how application listens for domain events
+// WHY: Long-lived consumers (e.g. streaming RPCs) need to attach and detach at runtime
+// WHERE: Injected into query handlers that push live updates to clients
+type EventSubscriber interface {
+    // Subscribe registers a handler and returns a function that removes it
+    Subscribe(eventName string, handler EventHandler) (unsubscribe func())
+}
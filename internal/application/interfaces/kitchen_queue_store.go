@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+)
+
+// KitchenQueueStore keeps each store's queue of orders for the kitchen display
+// WHY: A read model built from order events, so displays don't scan every order
+// WHERE: Written by the kitchen queue event handler, read by the kitchen queue queries
+type KitchenQueueStore interface {
+    // Record adds or replaces the order's ticket unless newer news about the order is already recorded
+    Record(ticket dtos.KitchenTicketDTO) error
+    // Remove takes the order off its store's queue unless the ticket was updated after at
+    Remove(storeID string, orderID string, at time.Time) error
+    // List returns the store's tickets in display order
+    List(storeID string) ([]dtos.KitchenTicketDTO, error)
+    // Watch calls notify after every change to the store's queue until unsubscribed
+    Watch(storeID string, notify func()) (unsubscribe func())
+}
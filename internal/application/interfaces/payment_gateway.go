@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// PaymentGateway defines how application moves money through a payment provider
+// WHY: Application layer needs card payments without depending on a specific provider
+// WHERE: Injected into command handlers that take or return money for orders
+type PaymentGateway interface {
+    // Authorize holds funds and returns the provider's authorization reference
+    // WHAT: A declined payment returns an error wrapping payment.ErrPaymentDeclined
+    Authorize(ctx context.Context, orderID string, amount shared.Money) (authorizationID string, err error)
+    Capture(ctx context.Context, authorizationID string, amount shared.Money) error
+    Void(ctx context.Context, authorizationID string) error
+    Refund(ctx context.Context, authorizationID string, amount shared.Money) error
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+
+// StockAlertStore keeps the current stock alert for each product
+// WHY: A read model built from stock events, so listing alerts doesn't scan every store
+// WHERE: Written by the stock alert event handler, read by the low-stock query
+type StockAlertStore interface {
+    // Record replaces the product's alert unless a newer one is already recorded
+    Record(alert dtos.StockAlertDTO) error
+    // ListActive returns alerts that are not OK, for one store or all when storeID is empty
+    ListActive(storeID string) ([]dtos.StockAlertDTO, error)
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
 )
 
@@ -20,4 +23,9 @@ type UnitOfWork interface {
     StoreRepository() store.StoreRepository
     OrderRepository() order.OrderRepository
     CustomerRepository() customer.CustomerRepository
+    PromotionRepository() promotion.PromotionRepository
+    PaymentRepository() payment.PaymentRepository
+    SupplierRepository() purchasing.SupplierRepository
+    PurchaseOrderRepository() purchasing.PurchaseOrderRepository
+    TransferRepository() store.TransferRepository
 }
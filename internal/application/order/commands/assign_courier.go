@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+)
+
+// AssignCourierCommand represents request to give a delivery order to a courier
+type AssignCourierCommand struct {
+    OrderID   string
+    CourierID string
+}
+
+// AssignCourierHandler handles courier assignment
+// WHERE: Called by dispatch, before or while the order is being made
+type AssignCourierHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewAssignCourierHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *AssignCourierHandler {
+    return &AssignCourierHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *AssignCourierHandler) Handle(ctx context.Context, cmd AssignCourierCommand) error {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load order
+    orderAgg, err := h.uow.OrderRepository().FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return err
+    }
+    
+    // Assign courier
+    err = orderAgg.AssignCourier(cmd.CourierID)
+    if err != nil {
+        return err
+    }
+    
+    // Save order
+    err = h.uow.OrderRepository().Save(orderAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := orderAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// BumpOrderCommand represents request to move a kitchen ticket on to its next stage
+type BumpOrderCommand struct {
+    StoreID string // The display's store, a ticket from another store is not found
+    OrderID string
+    Actor   string
+}
+
+// BumpOrderHandler handles bumps from the kitchen display
+// WHY: Staff tap the same button to start an order and to send it out,
+// the order's status decides which one it means
+type BumpOrderHandler struct {
+    orderRepo      order.OrderRepository
+    startPreparing *StartPreparingOrderHandler
+    markReady      *MarkOrderReadyHandler
+}
+
+func NewBumpOrderHandler(
+    orderRepo order.OrderRepository,
+    startPreparing *StartPreparingOrderHandler,
+    markReady *MarkOrderReadyHandler,
+) *BumpOrderHandler {
+    return &BumpOrderHandler{
+        orderRepo:      orderRepo,
+        startPreparing: startPreparing,
+        markReady:      markReady,
+    }
+}
+
+// Handle starts a confirmed order or marks a preparing one ready, returning the new status
+func (h *BumpOrderHandler) Handle(ctx context.Context, cmd BumpOrderCommand) (order.OrderStatus, error) {
+    orderAgg, err := h.orderRepo.FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return "", err
+    }
+    if orderAgg.StoreID() != store.StoreID(cmd.StoreID) {
+        return "", fmt.Errorf("%w: %s is not queued at store %s", order.ErrOrderNotFound, cmd.OrderID, cmd.StoreID)
+    }
+    
+    switch orderAgg.Status() {
+    case order.OrderStatusConfirmed:
+        err = h.startPreparing.Handle(ctx, StartPreparingOrderCommand{
+            OrderID: cmd.OrderID,
+            Actor:   cmd.Actor,
+        })
+        return order.OrderStatusPreparing, err
+    case order.OrderStatusPreparing:
+        err = h.markReady.Handle(ctx, MarkOrderReadyCommand{
+            OrderID: cmd.OrderID,
+            Actor:   cmd.Actor,
+        })
+        return order.OrderStatusReady, err
+    default:
+        return "", fmt.Errorf("%w: order in %s status is not on the kitchen queue", order.ErrInvalidStatusTransition, orderAgg.Status())
+    }
+}
@@ -2,18 +2,27 @@ package commands
 
 import (
 	"context"
+	"errors"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
 )
 
 // CancelOrderCommand represents request to cancel an order
 type CancelOrderCommand struct {
     OrderID string
     Reason  string
+    Actor   string // Who cancelled, recorded on the order's timeline
+    // ReservationExpired is set when cancelling because the order's stock hold lapsed
+    ReservationExpired bool
 }
 
 // CancelOrderHandler handles order cancellation
+// WHAT: Points earned on the order are taken back by OrderCancelledHandler once the event is published
 type CancelOrderHandler struct {
     uow            interfaces.UnitOfWork
     eventPublisher interfaces.EventPublisher
@@ -48,28 +57,57 @@ func (h *CancelOrderHandler) Handle(ctx context.Context, cmd CancelOrderCommand)
     }
     
     // Cancel order
-    err = orderAgg.Cancel(cmd.Reason)
+    err = orderAgg.Cancel(cmd.Actor, cmd.Reason)
     if err != nil {
         return err
     }
     
-    // If order was confirmed, release inventory
-    if orderAgg.Status() == order.OrderStatusCancelled {
-        storeAgg, err := h.uow.StoreRepository().FindByID(orderAgg.StoreID())
+    // Release stock held for the order
+    reason := store.ReleaseReasonCancelled
+    if cmd.ReservationExpired {
+        reason = store.ReleaseReasonExpired
+    }
+    
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(orderAgg.StoreID())
+    if err != nil {
+        return err
+    }
+    
+    // Nothing to release when the sweeper already freed an expired hold
+    releaseErr := storeAgg.ReleaseReservation(string(orderAgg.ID()), reason)
+    if releaseErr == nil {
+        err = h.uow.StoreRepository().Save(storeAgg)
+        if err != nil {
+            return err
+        }
+    }
+    
+    // Give back any loyalty points spent on the order
+    var customerEvents []shared.DomainEvent
+    if orderAgg.PointsRedeemed() > 0 {
+        var customerAgg *customer.Customer
+        customerAgg, err = h.uow.CustomerRepository().FindByID(orderAgg.CustomerID())
         if err != nil {
             return err
         }
         
-        // Return inventory for each item
-        for _, item := range orderAgg.Items() {
-            // In real app, would have ReleaseInventory method
-            err = storeAgg.AddInventory(item.ProductID(), item.Quantity())
-            if err != nil {
-                // Log error but continue
-            }
+        err = customerAgg.RefundPoints(orderAgg.PointsRedeemed())
+        if err != nil {
+            return err
         }
         
-        err = h.uow.StoreRepository().Save(storeAgg)
+        err = h.uow.CustomerRepository().Save(customerAgg)
+        if err != nil {
+            return err
+        }
+        customerEvents = customerAgg.PullEvents()
+    }
+    
+    // Give back the promo code use so it counts towards its limits again
+    var promotionEvents []shared.DomainEvent
+    if orderAgg.PromoCode() != "" {
+        promotionEvents, err = h.releasePromotion(orderAgg)
         if err != nil {
             return err
         }
@@ -88,10 +126,41 @@ func (h *CancelOrderHandler) Handle(ctx context.Context, cmd CancelOrderCommand)
     }
     
     // Publish events
-    events := orderAgg.PullEvents()
+    events := append(orderAgg.PullEvents(), storeAgg.PullEvents()...)
+    events = append(events, customerEvents...)
+    events = append(events, promotionEvents...)
     if len(events) > 0 {
         h.eventPublisher.Publish(ctx, events...)
     }
     
     return nil
 }
+
+// releasePromotion gives back the promo code use counted for the order
+// WHAT: A use that was already given back is not given back twice
+func (h *CancelOrderHandler) releasePromotion(orderAgg *order.Order) ([]shared.DomainEvent, error) {
+    code, err := promotion.NewPromoCode(orderAgg.PromoCode())
+    if err != nil {
+        return nil, err
+    }
+    
+    promotionAgg, err := h.uow.PromotionRepository().FindByCode(code)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = promotionAgg.ReleaseRedemption(orderAgg.ID())
+    if errors.Is(err, promotion.ErrRedemptionNotFound) {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, err
+    }
+    
+    err = h.uow.PromotionRepository().Save(promotionAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    return promotionAgg.PullEvents(), nil
+}
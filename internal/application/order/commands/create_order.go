@@ -3,12 +3,19 @@ package commands
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
+	"time"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/tax"
 )
 
 // CreateOrderCommand represents request to create an order
@@ -16,12 +23,24 @@ type CreateOrderCommand struct {
     CustomerID string
     StoreID    string
     Items      []OrderItemRequest
+    // PointsToRedeem is how many loyalty points to spend on this order (optional)
+    PointsToRedeem int
+    // PromoCode is a coupon code to apply to this order (optional)
+    PromoCode string
+    // PickupAt is when the customer wants to collect a pre-order, zero for as soon as possible
+    PickupAt time.Time
+    // FulfillmentType is PICKUP or DELIVERY, empty means pickup
+    FulfillmentType string
+    // DeliveryAddress is where to deliver, nil uses the customer's address on file
+    DeliveryAddress *dtos.AddressDTO
 }
 
 // OrderItemRequest represents item in order request
 type OrderItemRequest struct {
     ProductID string
     Quantity  int
+    // ModifierOptionIDs are the chosen options, e.g. size and ice level (optional)
+    ModifierOptionIDs []string
 }
 
 // CreateOrderHandler handles order creation
@@ -29,21 +48,42 @@ type OrderItemRequest struct {
 type CreateOrderHandler struct {
     uow            interfaces.UnitOfWork
     eventPublisher interfaces.EventPublisher
+    paymentGateway interfaces.PaymentGateway
+    pointsRate     customer.PointsExchangeRate
+    taxCalculator  *tax.Calculator
+    policies       order.OrderPolicyProvider
+    reservationTTL time.Duration // How long stock is held before the order must start
 }
 
 func NewCreateOrderHandler(
     uow interfaces.UnitOfWork,
     eventPublisher interfaces.EventPublisher,
+    paymentGateway interfaces.PaymentGateway,
+    pointsRate customer.PointsExchangeRate,
+    taxCalculator *tax.Calculator,
+    policies order.OrderPolicyProvider,
+    reservationTTL time.Duration,
 ) *CreateOrderHandler {
     return &CreateOrderHandler{
         uow:            uow,
         eventPublisher: eventPublisher,
+        paymentGateway: paymentGateway,
+        pointsRate:     pointsRate,
+        taxCalculator:  taxCalculator,
+        policies:       policies,
+        reservationTTL: reservationTTL,
     }
 }
 
 // Handle creates a new order
-// WHAT: Complex orchestration involving store, order, and customer aggregates
+// WHAT: Complex orchestration involving store, order, customer and payment aggregates
 func (h *CreateOrderHandler) Handle(ctx context.Context, cmd CreateOrderCommand) (*dtos.OrderDTO, error) {
+    var storeAgg *store.Store
+    var orderAgg *order.Order
+    var paymentAgg *payment.Payment
+    var customerAgg *customer.Customer
+    pointsSpent := 0
+    
     // Start transaction
     err := h.uow.Begin(ctx)
     if err != nil {
@@ -51,55 +91,91 @@ func (h *CreateOrderHandler) Handle(ctx context.Context, cmd CreateOrderCommand)
     }
     defer func() {
         if err != nil {
+            // Undo changes to shared aggregates before another unit of work can see them
+            h.releaseReservation(storeAgg, orderAgg)
+            h.returnPoints(customerAgg, pointsSpent)
             h.uow.Rollback()
+            // WHY: The gateway is outside the transaction, release any hold we placed
+            h.voidAuthorization(ctx, paymentAgg)
         }
     }()
     
     // 1. Validate customer exists and is active
-    customerAgg, err := h.uow.CustomerRepository().FindByID(customer.CustomerID(cmd.CustomerID))
+    customerAgg, err = h.uow.CustomerRepository().FindByID(customer.CustomerID(cmd.CustomerID))
     if err != nil {
         return nil, err
     }
     if !customerAgg.IsActive() {
-        return nil, errors.New("customer is not active")
+        err = customer.ErrCustomerInactive
+        return nil, err
+    }
+    
+    var fulfillment order.FulfillmentType
+    fulfillment, err = order.ParseFulfillmentType(cmd.FulfillmentType)
+    if err != nil {
+        return nil, err
     }
     
     // 2. Load store and validate products
-    storeAgg, err := h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
     if err != nil {
         return nil, err
     }
     
+    // Reject orders while the store is closed or paused
+    // WHAT: One timestamp for the whole order, so hours, price rules and promo dates agree.
+    // Pre-orders are checked against pickup time once their items are known.
+    placedAt := time.Now()
+    preOrder := !cmd.PickupAt.IsZero()
+    orderPolicy := h.policies.PolicyFor(storeAgg.ID())
+    if !preOrder {
+        err = orderPolicy.CanAcceptOrder(storeAgg, placedAt)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
     // 3. Create order aggregate
-    orderAgg := order.NewOrder(
+    orderAgg = order.NewOrder(
         customer.CustomerID(cmd.CustomerID),
         store.StoreID(cmd.StoreID),
     )
     
-    // 4. Add items and reserve inventory
+    // 4. Add items and reserve inventory until the order is started
+    // WHAT: Assign to the outer err so a failure here still rolls back.
+    // A pre-order holds its stock until the usual window after pickup time.
+    expiresAt := placedAt.Add(h.reservationTTL)
+    if preOrder {
+        expiresAt = cmd.PickupAt.Add(h.reservationTTL)
+    }
     for _, item := range cmd.Items {
         // Get product details
-        product, err := storeAgg.GetProduct(store.ProductID(item.ProductID))
+        var product *store.Product
+        product, err = storeAgg.GetProduct(store.ProductID(item.ProductID))
         if err != nil {
             return nil, err
         }
         
         if !product.IsActive() {
-            return nil, errors.New("product is not available")
+            err = errors.New("product is not available")
+            return nil, err
         }
         
-        // Check inventory
-        available, err := storeAgg.GetAvailableQuantity(product.ID())
+        // Validate modifiers and price the customized product
+        var modifiers []store.SelectedModifier
+        modifiers, err = product.SelectModifiers(item.ModifierOptionIDs)
         if err != nil {
             return nil, err
         }
         
-        if available < item.Quantity {
-            return nil, errors.New("insufficient inventory for product: " + string(product.Name()))
+        var unitPrice shared.Money
+        unitPrice, err = storeAgg.UnitPriceAt(product.ID(), modifiers, placedAt)
+        if err != nil {
+            return nil, err
         }
         
-        // Reserve inventory
-        err = storeAgg.ReserveInventory(product.ID(), item.Quantity)
+        // Reserve inventory, checks ingredient stock for the drink and its modifiers
+        err = storeAgg.ReserveInventory(string(orderAgg.ID()), product.ID(), item.Quantity, modifiers, expiresAt)
         if err != nil {
             return nil, err
         }
@@ -109,20 +185,169 @@ func (h *CreateOrderHandler) Handle(ctx context.Context, cmd CreateOrderCommand)
             product.ID(),
             string(product.Name()),
             item.Quantity,
-            product.Price(),
+            unitPrice,
+            product.IsTaxExempt(),
+            modifiers,
         )
         if err != nil {
             return nil, err
         }
     }
     
-    // 5. Confirm order (in real app, would process payment first)
-    err = orderAgg.Confirm()
+    // Check a pre-order can be made in time and collected during opening hours
+    if preOrder {
+        err = orderPolicy.CanSchedulePickup(storeAgg, orderAgg, cmd.PickupAt)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // Price delivery to the requested address, or the customer's own
+    if fulfillment == order.FulfillmentDelivery {
+        var address shared.Address
+        address, err = deliveryAddress(cmd.DeliveryAddress, customerAgg)
+        if err != nil {
+            return nil, err
+        }
+        
+        var fee shared.Money
+        fee, err = storeAgg.DeliveryFeeFor(address)
+        if err != nil {
+            return nil, err
+        }
+        
+        err = orderAgg.ArrangeDelivery(address, fee)
+        if err != nil {
+            return nil, err
+        }
+    } else if cmd.DeliveryAddress != nil {
+        err = fmt.Errorf("%w: pickup orders are not delivered", order.ErrInvalidDeliveryAddress)
+        return nil, err
+    }
+    
+    // 5. Price the order with the customer's current loyalty tier
+    err = orderAgg.ApplyTierDiscount(customerAgg.Type(), customerAgg.GetDiscountRate())
     if err != nil {
         return nil, err
     }
     
-    // 6. Save all changes
+    // 6. Apply promo code if provided
+    var promotionAgg *promotion.Promotion
+    if cmd.PromoCode != "" {
+        var code promotion.PromoCode
+        code, err = promotion.NewPromoCode(cmd.PromoCode)
+        if err != nil {
+            return nil, err
+        }
+        
+        promotionAgg, err = h.uow.PromotionRepository().FindByCode(code)
+        if err != nil {
+            return nil, err
+        }
+        
+        var discount order.Discount
+        discount, err = promotionAgg.DiscountFor(orderAgg, placedAt)
+        if err != nil {
+            return nil, err
+        }
+        
+        err = orderAgg.ApplyPromotion(string(code), discount)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // 7. Charge sales tax for the store's location on the discounted price
+    var taxLines []tax.Line
+    taxLines, err = h.taxCalculator.Calculate(storeAgg.Location(), orderAgg.TaxableItems(), orderAgg.DiscountAmount())
+    if err != nil {
+        return nil, err
+    }
+    
+    err = orderAgg.ApplyTax(taxLines)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 8. Pay with loyalty points if requested
+    // WHY: Validate against the order first so points are only spent when they fit
+    if cmd.PointsToRedeem > 0 {
+        var credit shared.Money
+        credit, err = h.pointsRate.Value(cmd.PointsToRedeem, orderAgg.TotalAmount().Currency())
+        if err != nil {
+            return nil, err
+        }
+        
+        err = orderAgg.ApplyPointsCredit(cmd.PointsToRedeem, credit)
+        if err != nil {
+            return nil, err
+        }
+        
+        err = customerAgg.RedeemPoints(cmd.PointsToRedeem)
+        if err != nil {
+            return nil, err
+        }
+        pointsSpent = cmd.PointsToRedeem
+    }
+    
+    // 9. Authorize payment for whatever points did not cover
+    // WHY: An order is only confirmed once the money is held
+    if !orderAgg.TotalAmount().IsZero() {
+        paymentAgg, err = payment.NewPayment(orderAgg.ID(), orderAgg.TotalAmount())
+        if err != nil {
+            return nil, err
+        }
+        
+        var authorizationID string
+        authorizationID, err = h.paymentGateway.Authorize(ctx, string(orderAgg.ID()), paymentAgg.Amount())
+        if err != nil {
+            if errors.Is(err, payment.ErrPaymentDeclined) {
+                // Record the decline even though the order is abandoned
+                paymentAgg.Fail(err.Error())
+                h.eventPublisher.Publish(ctx, paymentAgg.PullEvents()...)
+            }
+            return nil, err
+        }
+        
+        err = paymentAgg.Authorize(authorizationID)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // 10. Confirm order, or hold a pre-order until it is time to make it
+    if preOrder {
+        prepTime := time.Duration(orderPolicy.GetPreparationTime(storeAgg, orderAgg)) * time.Minute
+        err = orderAgg.Schedule(order.CustomerActor(cmd.CustomerID), cmd.PickupAt, prepTime)
+    } else {
+        err = orderAgg.Confirm(order.CustomerActor(cmd.CustomerID))
+    }
+    if err != nil {
+        return nil, err
+    }
+    
+    // Tell the customer when to expect it, behind every order already in the kitchen
+    var queued []*order.Order
+    queued, err = queuedOrders(h.uow.OrderRepository(), storeAgg.ID())
+    if err != nil {
+        return nil, err
+    }
+    orderAgg.UpdateReadyEstimate(orderPolicy.EstimateReadyAt(storeAgg, orderAgg, len(queued), placedAt))
+    
+    // 11. Count promo code usage in the same transaction as the order
+    if promotionAgg != nil {
+        err = promotionAgg.Redeem(orderAgg.CustomerID(), orderAgg.ID(), orderAgg.PromotionDiscount(), placedAt)
+        if err != nil {
+            return nil, err
+        }
+        
+        err = h.uow.PromotionRepository().Save(promotionAgg)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // 12. Save all changes
     err = h.uow.OrderRepository().Save(orderAgg)
     if err != nil {
         return nil, err
@@ -133,44 +358,96 @@ func (h *CreateOrderHandler) Handle(ctx context.Context, cmd CreateOrderCommand)
         return nil, err
     }
     
-    // 7. Commit transaction
+    err = h.uow.CustomerRepository().Save(customerAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    if paymentAgg != nil {
+        err = h.uow.PaymentRepository().Save(paymentAgg)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // 13. Commit transaction
     err = h.uow.Commit()
     if err != nil {
         return nil, err
     }
     
-    // 8. Publish events (after commit)
+    // 14. Publish events (after commit)
     allEvents := append(orderAgg.PullEvents(), storeAgg.PullEvents()...)
+    allEvents = append(allEvents, customerAgg.PullEvents()...)
+    if promotionAgg != nil {
+        allEvents = append(allEvents, promotionAgg.PullEvents()...)
+    }
+    if paymentAgg != nil {
+        allEvents = append(allEvents, paymentAgg.PullEvents()...)
+    }
     if len(allEvents) > 0 {
         h.eventPublisher.Publish(ctx, allEvents...)
     }
     
-    // 9. Return DTO
-    return h.toOrderDTO(orderAgg), nil
+    // 15. Return DTO
+    return dtos.NewOrderDTO(orderAgg), nil
 }
 
-// toOrderDTO converts domain order to DTO
-func (h *CreateOrderHandler) toOrderDTO(orderAgg *order.Order) *dtos.OrderDTO {
-    items := make([]dtos.OrderItemDTO, len(orderAgg.Items()))
-    for i, item := range orderAgg.Items() {
-        items[i] = dtos.OrderItemDTO{
-            ID:        item.ID(),
-            ProductID: string(item.ProductID()),
-            Name:      item.Name(),
-            Quantity:  item.Quantity(),
-            UnitPrice: float64(item.UnitPrice().Amount()) / 100,
-            Total:     float64(item.Total().Amount()) / 100,
-        }
-    }
-    
-    return &dtos.OrderDTO{
-        ID:          string(orderAgg.ID()),
-        CustomerID:  string(orderAgg.CustomerID()),
-        StoreID:     string(orderAgg.StoreID()),
-        Status:      string(orderAgg.Status()),
-        TotalAmount: float64(orderAgg.TotalAmount().Amount()) / 100,
-        Currency:    orderAgg.TotalAmount().Currency(),
-        Items:       items,
-        PlacedAt:    orderAgg.PlacedAt(),
+// deliveryAddress works out where a delivery order goes
+// WHAT: Falls back to the address on the customer's account
+func deliveryAddress(input *dtos.AddressDTO, customerAgg *customer.Customer) (shared.Address, error) {
+    if input == nil {
+        if customerAgg.Address().Street() == "" {
+            return shared.Address{}, fmt.Errorf("%w: none given and the customer has no address on file",
+                order.ErrInvalidDeliveryAddress)
+        }
+        return customerAgg.Address(), nil
     }
+    
+    address, err := shared.NewAddress(input.Street, input.City, input.State, input.ZipCode, input.Country)
+    if err != nil {
+        return shared.Address{}, fmt.Errorf("%w: %v", order.ErrInvalidDeliveryAddress, err)
+    }
+    return address, nil
+}
+
+// releaseReservation frees stock held for an order that failed to save
+// WHY: Repositories hand out shared aggregates, so the hold would otherwise linger until it expires
+// WHAT: The order never existed, so its reservation and stock alert events are dropped rather than published
+func (h *CreateOrderHandler) releaseReservation(storeAgg *store.Store, orderAgg *order.Order) {
+    if storeAgg == nil || orderAgg == nil {
+        return
+    }
+    
+    storeAgg.ReleaseReservation(string(orderAgg.ID()), store.ReleaseReasonCancelled)
+    storeAgg.PullEvents()
+}
+
+// returnPoints gives back loyalty points spent on an order that failed to save
+// WHY: Repositories hand out shared aggregates, so the lower balance would otherwise stick
+// WHAT: The redeem and refund cancel out, so their events are dropped rather than published
+func (h *CreateOrderHandler) returnPoints(customerAgg *customer.Customer, points int) {
+    if customerAgg == nil || points == 0 {
+        return
+    }
+    
+    customerAgg.RefundPoints(points)
+    customerAgg.PullEvents()
+}
+
+// voidAuthorization releases a payment hold after the order failed to save
+// WHAT: Best effort, a failed void is logged and expires at the provider
+func (h *CreateOrderHandler) voidAuthorization(ctx context.Context, paymentAgg *payment.Payment) {
+    if paymentAgg == nil || paymentAgg.Status() != payment.PaymentStatusAuthorized {
+        return
+    }
+    
+    err := h.paymentGateway.Void(ctx, paymentAgg.AuthorizationID())
+    if err != nil {
+        log.Printf("Failed to void authorization %s for order %s: %v",
+            paymentAgg.AuthorizationID(), paymentAgg.OrderID(), err)
+        return
+    }
+    
+    paymentAgg.Void()
 }
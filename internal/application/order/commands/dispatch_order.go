@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// DispatchOrderCommand represents request to send a ready delivery order out
+type DispatchOrderCommand struct {
+    OrderID string
+    Actor   string // Who handed the order to the courier, recorded on the order's timeline
+}
+
+// DispatchOrderHandler handles delivery orders leaving the stand
+// WHERE: Called by stand staff when the courier collects the order
+type DispatchOrderHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewDispatchOrderHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *DispatchOrderHandler {
+    return &DispatchOrderHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *DispatchOrderHandler) Handle(ctx context.Context, cmd DispatchOrderCommand) error {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load order
+    orderAgg, err := h.uow.OrderRepository().FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return err
+    }
+    
+    // Check the order can move on and its stock is still held before changing either
+    err = orderAgg.CanDispatchForDelivery()
+    if err != nil {
+        return err
+    }
+    
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(orderAgg.StoreID())
+    if err != nil {
+        return err
+    }
+    
+    err = storeAgg.CanCommitReservation(string(orderAgg.ID()))
+    if err != nil {
+        return err
+    }
+    
+    // Transition order
+    err = orderAgg.DispatchForDelivery(cmd.Actor)
+    if err != nil {
+        return err
+    }
+    
+    // Take the order's held stock off hand, it has left the stand
+    err = storeAgg.CommitReservation(string(orderAgg.ID()))
+    if err != nil {
+        return err
+    }
+    
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Save order
+    err = h.uow.OrderRepository().Save(orderAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := append(orderAgg.PullEvents(), storeAgg.PullEvents()...)
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+)
+
+// MarkOrderDeliveredCommand represents request to record a delivery as handed over
+type MarkOrderDeliveredCommand struct {
+    OrderID string
+    Actor   string // Usually the courier, recorded on the order's timeline
+}
+
+// MarkOrderDeliveredHandler handles finishing delivery orders
+// WHERE: Called from the courier's app at the customer's door
+type MarkOrderDeliveredHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewMarkOrderDeliveredHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *MarkOrderDeliveredHandler {
+    return &MarkOrderDeliveredHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *MarkOrderDeliveredHandler) Handle(ctx context.Context, cmd MarkOrderDeliveredCommand) error {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load order
+    orderAgg, err := h.uow.OrderRepository().FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return err
+    }
+    
+    // Transition order, payment is captured once the delivered event is handled
+    err = orderAgg.MarkDelivered(cmd.Actor)
+    if err != nil {
+        return err
+    }
+    
+    // Save order
+    err = h.uow.OrderRepository().Save(orderAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := orderAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
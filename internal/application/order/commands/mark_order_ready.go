@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+)
+
+// MarkOrderReadyCommand represents request to mark an order ready for pickup
+type MarkOrderReadyCommand struct {
+    OrderID string
+    Actor   string // Who made the drinks, recorded on the order's timeline
+}
+
+// MarkOrderReadyHandler handles marking an order ready for pickup
+// WHERE: Called by stand staff once the drinks are made
+type MarkOrderReadyHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewMarkOrderReadyHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *MarkOrderReadyHandler {
+    return &MarkOrderReadyHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *MarkOrderReadyHandler) Handle(ctx context.Context, cmd MarkOrderReadyCommand) error {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load order
+    orderAgg, err := h.uow.OrderRepository().FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return err
+    }
+    
+    // Transition order
+    err = orderAgg.MarkReady(cmd.Actor)
+    if err != nil {
+        return err
+    }
+    
+    // Save order
+    err = h.uow.OrderRepository().Save(orderAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := orderAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// RecallOrderCommand represents request to send a ready order back to be remade
+type RecallOrderCommand struct {
+    StoreID string // The display's store, an order from another store is not found
+    OrderID string
+    Actor   string // Who sent it back, recorded on the order's timeline
+    Reason  string // e.g. "wrong size"
+}
+
+// RecallOrderHandler handles recalling ready orders
+// WHERE: Called from the kitchen display when an order has to be made again
+type RecallOrderHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewRecallOrderHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *RecallOrderHandler {
+    return &RecallOrderHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *RecallOrderHandler) Handle(ctx context.Context, cmd RecallOrderCommand) error {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load order
+    orderAgg, err := h.uow.OrderRepository().FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return err
+    }
+    if orderAgg.StoreID() != store.StoreID(cmd.StoreID) {
+        err = fmt.Errorf("%w: %s is not queued at store %s", order.ErrOrderNotFound, cmd.OrderID, cmd.StoreID)
+        return err
+    }
+    
+    // Send the order back
+    err = orderAgg.Recall(cmd.Actor, cmd.Reason)
+    if err != nil {
+        return err
+    }
+    
+    // Save order
+    err = h.uow.OrderRepository().Save(orderAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := orderAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// RefreshReadyEstimatesCommand represents request to re-estimate a store's orders in the kitchen
+type RefreshReadyEstimatesCommand struct {
+    StoreID string
+}
+
+// RefreshReadyEstimatesHandler re-estimates when a store's confirmed and preparing orders will be ready
+// WHY: Every order that starts, finishes or is cancelled changes how long the rest will wait
+// WHERE: Called by the ready estimate event handler as orders move through the kitchen
+type RefreshReadyEstimatesHandler struct {
+    uow            interfaces.UnitOfWork
+    policies       order.OrderPolicyProvider
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewRefreshReadyEstimatesHandler(
+    uow interfaces.UnitOfWork,
+    policies order.OrderPolicyProvider,
+    eventPublisher interfaces.EventPublisher,
+) *RefreshReadyEstimatesHandler {
+    return &RefreshReadyEstimatesHandler{
+        uow:            uow,
+        policies:       policies,
+        eventPublisher: eventPublisher,
+    }
+}
+
+// Handle updates every estimate that moved and returns how many did
+// WHY: Runs in a unit of work because requests change the same orders
+func (h *RefreshReadyEstimatesHandler) Handle(ctx context.Context, cmd RefreshReadyEstimatesCommand) (int, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return 0, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return 0, err
+    }
+    
+    var queued []*order.Order
+    queued, err = queuedOrders(h.uow.OrderRepository(), storeAgg.ID())
+    if err != nil {
+        return 0, err
+    }
+    
+    orderPolicy := h.policies.PolicyFor(storeAgg.ID())
+    now := time.Now()
+    updated := 0
+    var events []shared.DomainEvent
+    for ahead, orderAgg := range queued {
+        // Execute domain logic
+        if !orderAgg.UpdateReadyEstimate(orderPolicy.EstimateReadyAt(storeAgg, orderAgg, ahead, now)) {
+            continue
+        }
+        
+        // Save changes
+        err = h.uow.OrderRepository().Save(orderAgg)
+        if err != nil {
+            return 0, err
+        }
+        updated++
+        events = append(events, orderAgg.PullEvents()...)
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return 0, err
+    }
+    
+    // Publish events, trackers push the new estimate to the customer
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return updated, nil
+}
+
+// queuedOrders returns the store's kitchen load in the order it will be worked through
+// WHAT: Orders being made come first, oldest start first, then confirmed orders oldest first
+func queuedOrders(orderRepo order.OrderRepository, storeID store.StoreID) ([]*order.Order, error) {
+    var queued []*order.Order
+    for _, status := range []order.OrderStatus{order.OrderStatusPreparing, order.OrderStatusConfirmed} {
+        orders, err := orderRepo.FindByStatus(status)
+        if err != nil {
+            return nil, err
+        }
+        
+        atStore := make([]*order.Order, 0, len(orders))
+        for _, orderAgg := range orders {
+            if orderAgg.StoreID() == storeID {
+                atStore = append(atStore, orderAgg)
+            }
+        }
+        sort.Slice(atStore, func(i, j int) bool {
+            return atStore[i].EnteredStatusAt(status).Before(atStore[j].EnteredStatusAt(status))
+        })
+        queued = append(queued, atStore...)
+    }
+    return queued, nil
+}
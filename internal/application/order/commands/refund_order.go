@@ -0,0 +1,233 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// RefundOrderCommand represents request to refund part or all of a completed order
+// WHAT: Either Items or Amount is set - item refunds are priced from what was paid
+type RefundOrderCommand struct {
+    OrderID string
+    Items   []RefundItemRequest
+    Amount  float64
+    Reason  string
+    // Restock puts refunded items back into inventory (only for Items refunds)
+    Restock bool
+}
+
+// RefundItemRequest represents units of an order item to refund
+type RefundItemRequest struct {
+    ItemID   string
+    Quantity int
+}
+
+// RefundOrderHandler handles refunds on completed orders
+// WHY: Cancelling is only possible before pickup, refunds cover everything after
+type RefundOrderHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+    paymentGateway interfaces.PaymentGateway
+}
+
+func NewRefundOrderHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+    paymentGateway interfaces.PaymentGateway,
+) *RefundOrderHandler {
+    return &RefundOrderHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+        paymentGateway: paymentGateway,
+    }
+}
+
+// Handle refunds the order
+// WHAT: Points paid for the refunded items go back to the customer here, points
+// earned on the refunded money are clawed back by OrderRefundedHandler once the event is published
+func (h *RefundOrderHandler) Handle(ctx context.Context, cmd RefundOrderCommand) (*dtos.RefundDTO, error) {
+    if len(cmd.Items) > 0 && cmd.Amount != 0 {
+        return nil, errors.New("refund either items or an amount, not both")
+    }
+    if len(cmd.Items) == 0 && cmd.Amount <= 0 {
+        return nil, errors.New("items or a positive amount are required")
+    }
+    
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // 1. Load order
+    orderAgg, err := h.uow.OrderRepository().FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Price the refund without changing the order yet
+    var refund order.Refund
+    if len(cmd.Items) > 0 {
+        requests := make([]order.ItemRefund, len(cmd.Items))
+        for i, item := range cmd.Items {
+            requests[i] = order.ItemRefund{ItemID: item.ItemID, Quantity: item.Quantity}
+        }
+        refund, err = orderAgg.QuoteItemRefund(requests, cmd.Reason)
+    } else {
+        var amount shared.Money
+        amount, err = shared.NewMoney(int64(math.Round(cmd.Amount*100)), orderAgg.TotalAmount().Currency())
+        if err != nil {
+            return nil, err
+        }
+        refund, err = orderAgg.QuoteAmountRefund(amount, cmd.Reason)
+    }
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Check returned items can go back on the shelf if requested
+    // A made drink can't go back into ingredient stock
+    var storeAgg *store.Store
+    var restock []order.RefundLine
+    if cmd.Restock && len(refund.Lines()) > 0 {
+        storeAgg, err = h.uow.StoreRepository().FindByID(orderAgg.StoreID())
+        if err != nil {
+            return nil, err
+        }
+        
+        for _, line := range refund.Lines() {
+            var product *store.Product
+            product, err = storeAgg.GetProduct(line.ProductID())
+            if err != nil {
+                return nil, err
+            }
+            if product.IsMadeToOrder() {
+                continue
+            }
+            
+            err = storeAgg.CanAddInventory(line.ProductID(), line.Quantity())
+            if err != nil {
+                return nil, err
+            }
+            restock = append(restock, line)
+        }
+    }
+    
+    // Load the customer who gets the points share back
+    var customerAgg *customer.Customer
+    if refund.Points() > 0 {
+        customerAgg, err = h.uow.CustomerRepository().FindByID(orderAgg.CustomerID())
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // 4. Check the payment can take the refund
+    // WHY: Orders paid entirely with points never had a payment to refund
+    var paymentAgg *payment.Payment
+    if !orderAgg.TotalAmount().IsZero() {
+        paymentAgg, err = h.uow.PaymentRepository().FindByOrderID(orderAgg.ID())
+        if err != nil {
+            return nil, err
+        }
+        
+        err = paymentAgg.CanRefund(refund.Amount())
+        if err != nil {
+            return nil, err
+        }
+        
+        // 5. Return the money before changing anything
+        // WHY: Repositories hand out shared aggregates, so a rollback can't undo
+        // changes made before a gateway failure
+        err = h.paymentGateway.Refund(ctx, paymentAgg.AuthorizationID(), refund.Amount())
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // 6. Record the refund everywhere - each step was checked above
+    err = orderAgg.ApplyRefund(refund)
+    if err != nil {
+        return nil, err
+    }
+    
+    if paymentAgg != nil {
+        err = paymentAgg.Refund(refund.Amount())
+        if err != nil {
+            return nil, err
+        }
+        
+        err = h.uow.PaymentRepository().Save(paymentAgg)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    if customerAgg != nil {
+        err = customerAgg.RefundPoints(refund.Points())
+        if err != nil {
+            return nil, err
+        }
+        
+        err = h.uow.CustomerRepository().Save(customerAgg)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    for _, line := range restock {
+        err = storeAgg.AddInventory(line.ProductID(), line.Quantity())
+        if err != nil {
+            return nil, err
+        }
+    }
+    if storeAgg != nil {
+        err = h.uow.StoreRepository().Save(storeAgg)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    err = h.uow.OrderRepository().Save(orderAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 7. Commit transaction
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // 8. Publish events (after commit)
+    allEvents := orderAgg.PullEvents()
+    if paymentAgg != nil {
+        allEvents = append(allEvents, paymentAgg.PullEvents()...)
+    }
+    if storeAgg != nil {
+        allEvents = append(allEvents, storeAgg.PullEvents()...)
+    }
+    if customerAgg != nil {
+        allEvents = append(allEvents, customerAgg.PullEvents()...)
+    }
+    if len(allEvents) > 0 {
+        h.eventPublisher.Publish(ctx, allEvents...)
+    }
+    
+    // 9. Return DTO
+    return dtos.NewRefundDTO(orderAgg.ID(), refund), nil
+}
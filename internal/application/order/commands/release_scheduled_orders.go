@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// ReleaseScheduledOrdersCommand represents a sweep for pre-orders that are due
+type ReleaseScheduledOrdersCommand struct {
+    Now time.Time
+}
+
+// ReleaseScheduledOrdersHandler confirms pre-orders once it is time to make them
+// WHY: Nobody asks for a pre-order to start, its pickup time decides
+// WHERE: Run periodically by the pre-order scheduler
+type ReleaseScheduledOrdersHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewReleaseScheduledOrdersHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *ReleaseScheduledOrdersHandler {
+    return &ReleaseScheduledOrdersHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+// Handle releases every due pre-order and returns how many were released
+// WHY: Runs in a unit of work because a customer may cancel the same order
+func (h *ReleaseScheduledOrdersHandler) Handle(ctx context.Context, cmd ReleaseScheduledOrdersCommand) (int, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return 0, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    var orders []*order.Order
+    orders, err = h.uow.OrderRepository().FindByStatus(order.OrderStatusScheduled)
+    if err != nil {
+        return 0, err
+    }
+    
+    released := 0
+    var events []shared.DomainEvent
+    for _, orderAgg := range orders {
+        if !orderAgg.IsDueForRelease(cmd.Now) {
+            continue
+        }
+        
+        // Execute domain logic
+        err = orderAgg.Release(cmd.Now)
+        if err != nil {
+            return 0, err
+        }
+        
+        // Save changes
+        err = h.uow.OrderRepository().Save(orderAgg)
+        if err != nil {
+            return 0, err
+        }
+        released++
+        events = append(events, orderAgg.PullEvents()...)
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return 0, err
+    }
+    
+    // Publish events, confirmation awards loyalty points and notifies trackers
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return released, nil
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// StartPreparingOrderCommand represents request to start preparing an order
+type StartPreparingOrderCommand struct {
+    OrderID string
+    Actor   string // Who picked the order up, recorded on the order's timeline
+}
+
+// StartPreparingOrderHandler handles moving an order into preparation
+// WHERE: Called by stand staff as they work through the order queue
+type StartPreparingOrderHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewStartPreparingOrderHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *StartPreparingOrderHandler {
+    return &StartPreparingOrderHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *StartPreparingOrderHandler) Handle(ctx context.Context, cmd StartPreparingOrderCommand) error {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load order
+    orderAgg, err := h.uow.OrderRepository().FindByID(order.OrderID(cmd.OrderID))
+    if err != nil {
+        return err
+    }
+    
+    // Check the status first so a rejected order leaves its reservation expiring
+    err = orderAgg.CanStartPreparing()
+    if err != nil {
+        return err
+    }
+    
+    // Keep the order's stock held now that it is being made
+    // WHY: Fails if the reservation already expired and the stock was released
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(orderAgg.StoreID())
+    if err != nil {
+        return err
+    }
+    
+    err = storeAgg.HoldReservation(string(orderAgg.ID()))
+    if err != nil {
+        return err
+    }
+    
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Transition order
+    err = orderAgg.StartPreparing(cmd.Actor)
+    if err != nil {
+        return err
+    }
+    
+    // Save order
+    err = h.uow.OrderRepository().Save(orderAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := append(orderAgg.PullEvents(), storeAgg.PullEvents()...)
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
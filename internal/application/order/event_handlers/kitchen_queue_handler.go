@@ -0,0 +1,76 @@
+package eventhandlers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// KitchenQueueHandler keeps the kitchen queue read model up to date
+// WHY: Staff work from one list per stand instead of polling every order
+type KitchenQueueHandler struct {
+    orderRepo  order.OrderRepository
+    queueStore interfaces.KitchenQueueStore
+    rushSpec   order.Specification
+}
+
+func NewKitchenQueueHandler(
+    orderRepo order.OrderRepository,
+    queueStore interfaces.KitchenQueueStore,
+    rushSpec order.Specification,
+) *KitchenQueueHandler {
+    return &KitchenQueueHandler{
+        orderRepo:  orderRepo,
+        queueStore: queueStore,
+        rushSpec:   rushSpec,
+    }
+}
+
+// Handle processes the event
+// WHERE: Registered with event bus to handle order.confirmed, order.preparation_started,
+// order.ready, order.recalled and order.cancelled events
+// WHAT: Events only say something changed, the ticket is built from the order as it is now,
+// so events handled out of order still leave the queue right
+func (h *KitchenQueueHandler) Handle(ctx context.Context, event shared.DomainEvent) error {
+    switch event.(type) {
+    case order.OrderConfirmedEvent, order.OrderPreparationStartedEvent, order.OrderReadyEvent,
+        order.OrderRecalledEvent, order.OrderCancelledEvent:
+    default:
+        return nil // Not our event
+    }
+    
+    orderAgg, err := h.orderRepo.FindByID(order.OrderID(event.AggregateID()))
+    if err != nil {
+        log.Printf("Failed to find order %s for kitchen queue: %v", event.AggregateID(), err)
+        return err
+    }
+    
+    priority := order.KitchenPriorityOf(orderAgg, h.rushSpec)
+    ticket := dtos.NewKitchenTicketDTO(orderAgg, priority)
+    switch orderAgg.Status() {
+    case order.OrderStatusConfirmed, order.OrderStatusPreparing:
+        ticket.Rank, ticket.DueAt = queuePosition(ticket, priority)
+        return h.queueStore.Record(ticket)
+    default:
+        return h.queueStore.Remove(ticket.StoreID, ticket.OrderID, ticket.UpdatedAt)
+    }
+}
+
+// queuePosition ranks a ticket for display
+// WHAT: Orders already being made stay on top in the order they were started, waiting
+// orders follow by priority, pre-orders by pickup time and the rest first come first served
+func queuePosition(ticket dtos.KitchenTicketDTO, priority order.KitchenPriority) (int, time.Time) {
+    switch {
+    case ticket.Status == string(order.OrderStatusPreparing):
+        return 0, ticket.UpdatedAt
+    case priority == order.KitchenPriorityScheduled:
+        return 1 + int(priority), ticket.PickupAt
+    default:
+        return 1 + int(priority), ticket.QueuedAt
+    }
+}
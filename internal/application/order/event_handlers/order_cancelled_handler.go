@@ -0,0 +1,77 @@
+package eventhandlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// OrderCancelledHandler handles OrderCancelledEvent
+// WHY: Points earned by OrderPlacedHandler on an order that was never paid for must be taken back,
+// whether staff cancelled it or its stock hold expired
+type OrderCancelledHandler struct {
+    uow interfaces.UnitOfWork
+}
+
+func NewOrderCancelledHandler(uow interfaces.UnitOfWork) *OrderCancelledHandler {
+    return &OrderCancelledHandler{uow: uow}
+}
+
+// Handle processes the event
+// WHERE: Registered with event bus to handle order.cancelled events
+func (h *OrderCancelledHandler) Handle(ctx context.Context, event shared.DomainEvent) error {
+    orderCancelled, ok := event.(order.OrderCancelledEvent)
+    if !ok || !orderCancelled.Confirmed {
+        return nil // Not our event, or no points were earned
+    }
+    
+    points := pointsForOrder(orderCancelled.TotalAmount, orderCancelled.TaxAmount, orderCancelled.DeliveryFee)
+    if points == 0 {
+        return nil
+    }
+    
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load customer
+    var customerAgg *customer.Customer
+    customerAgg, err = h.uow.CustomerRepository().FindByID(customer.CustomerID(orderCancelled.CustomerID))
+    if err != nil {
+        log.Printf("Failed to find customer %s: %v", orderCancelled.CustomerID, err)
+        return err
+    }
+    
+    err = customerAgg.RevokeLoyaltyPoints(points)
+    if err != nil {
+        log.Printf("Failed to revoke loyalty points: %v", err)
+        return err
+    }
+    
+    err = h.uow.CustomerRepository().Save(customerAgg)
+    if err != nil {
+        log.Printf("Failed to save customer: %v", err)
+        return err
+    }
+    
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    log.Printf("Revoked %d loyalty points from customer %s for cancelled order %s",
+        points, orderCancelled.CustomerID, orderCancelled.OrderID)
+    
+    return nil
+}
@@ -0,0 +1,68 @@
+package eventhandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/infrastructure/persistence/memory"
+)
+
+func TestOrderCancelledRevokesEarnedPoints(t *testing.T) {
+    tests := []struct {
+        name      string
+        confirmed bool
+        want      int
+    }{
+        {name: "confirmed order gives back what it earned", confirmed: true, want: 90},
+        {name: "order never confirmed earned nothing", confirmed: false, want: 100},
+    }
+    
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            customers := memory.NewInMemoryCustomerRepository()
+            uow := memory.NewInMemoryUnitOfWork(
+                memory.NewInMemoryStoreRepository(),
+                memory.NewInMemoryOrderRepository(),
+                customers,
+                memory.NewInMemoryPromotionRepository(),
+                memory.NewInMemoryPaymentRepository(),
+                memory.NewInMemorySupplierRepository(),
+                memory.NewInMemoryPurchaseOrderRepository(),
+                memory.NewInMemoryTransferRepository(),
+            )
+            
+            customerAgg, err := customer.NewCustomer("ada@example.com", "Ada", "Lovelace")
+            if err != nil {
+                t.Fatalf("NewCustomer: %v", err)
+            }
+            err = customerAgg.AddLoyaltyPoints(100)
+            if err != nil {
+                t.Fatalf("AddLoyaltyPoints: %v", err)
+            }
+            err = customers.Save(customerAgg)
+            if err != nil {
+                t.Fatalf("Save: %v", err)
+            }
+            
+            // $10.75 with 75¢ tax earned 10 points when it was confirmed
+            event := order.OrderCancelledEvent{
+                OrderID:     "order-1",
+                CustomerID:  string(customerAgg.ID()),
+                Confirmed:   tt.confirmed,
+                TotalAmount: usd(t, 1075),
+                TaxAmount:   usd(t, 75),
+                DeliveryFee: usd(t, 0),
+            }
+            err = NewOrderCancelledHandler(uow).Handle(context.Background(), event)
+            if err != nil {
+                t.Fatalf("Handle: %v", err)
+            }
+            
+            if got := customerAgg.LoyaltyPoints(); got != tt.want {
+                t.Errorf("loyalty points = %d, want %d", got, tt.want)
+            }
+        })
+    }
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
@@ -11,12 +12,13 @@ import (
 
 // OrderPlacedHandler handles OrderConfirmedEvent
 // WHY: Decouples order processing from customer loyalty updates
+// WHY: Checkout spends points on the same customer, so the balance is changed inside a unit of work
 type OrderPlacedHandler struct {
-    customerRepo customer.CustomerRepository
+    uow interfaces.UnitOfWork
 }
 
-func NewOrderPlacedHandler(customerRepo customer.CustomerRepository) *OrderPlacedHandler {
-    return &OrderPlacedHandler{customerRepo: customerRepo}
+func NewOrderPlacedHandler(uow interfaces.UnitOfWork) *OrderPlacedHandler {
+    return &OrderPlacedHandler{uow: uow}
 }
 
 // Handle processes the event
@@ -28,16 +30,30 @@ func (h *OrderPlacedHandler) Handle(ctx context.Context, event shared.DomainEven
         return nil // Not our event
     }
     
+    points := pointsForOrder(orderConfirmed.TotalAmount, orderConfirmed.TaxAmount, orderConfirmed.DeliveryFee)
+    if points == 0 {
+        return nil // Spent less than a dollar
+    }
+    
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
     // Load customer
-    customerAgg, err := h.customerRepo.FindByID(customer.CustomerID(orderConfirmed.CustomerID))
+    var customerAgg *customer.Customer
+    customerAgg, err = h.uow.CustomerRepository().FindByID(customer.CustomerID(orderConfirmed.CustomerID))
     if err != nil {
         log.Printf("Failed to find customer %s: %v", orderConfirmed.CustomerID, err)
         return err
     }
     
-    // Calculate loyalty points (1 point per dollar)
-    points := int(orderConfirmed.TotalAmount.Amount() / 100)
-    
     // Add points
     err = customerAgg.AddLoyaltyPoints(points)
     if err != nil {
@@ -46,14 +62,37 @@ func (h *OrderPlacedHandler) Handle(ctx context.Context, event shared.DomainEven
     }
     
     // Save customer
-    err = h.customerRepo.Save(customerAgg)
+    err = h.uow.CustomerRepository().Save(customerAgg)
     if err != nil {
         log.Printf("Failed to save customer: %v", err)
         return err
     }
     
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
     log.Printf("Added %d loyalty points to customer %s for order %s",
         points, orderConfirmed.CustomerID, orderConfirmed.OrderID)
     
     return nil
 }
+
+// pointsForOrder returns the loyalty points an order earns (1 point per dollar actually paid)
+// WHY: The total is after tier discounts, so points are not earned on savings.
+// Sales tax goes to the government, not the store, so it earns no points either,
+// and the delivery fee pays the courier
+func pointsForOrder(total shared.Money, taxAmount shared.Money, deliveryFee shared.Money) int {
+    paid, err := total.Subtract(taxAmount)
+    if err != nil {
+        return 0 // Nothing paid beyond tax
+    }
+    if !deliveryFee.IsZero() {
+        paid, err = paid.Subtract(deliveryFee)
+        if err != nil {
+            return 0 // Nothing paid beyond tax and delivery
+        }
+    }
+    return customer.PointsEarnedFor(paid)
+}
@@ -0,0 +1,100 @@
+package eventhandlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// OrderRefundedHandler handles OrderRefundedEvent
+// WHY: Points earned by OrderPlacedHandler on refunded money must be taken back
+type OrderRefundedHandler struct {
+    uow interfaces.UnitOfWork
+}
+
+func NewOrderRefundedHandler(uow interfaces.UnitOfWork) *OrderRefundedHandler {
+    return &OrderRefundedHandler{uow: uow}
+}
+
+// Handle processes the event
+// WHERE: Registered with event bus to handle order.refunded events
+func (h *OrderRefundedHandler) Handle(ctx context.Context, event shared.DomainEvent) error {
+    orderRefunded, ok := event.(order.OrderRefundedEvent)
+    if !ok {
+        return nil // Not our event
+    }
+    
+    // Points earned before and after this refund
+    // WHY: Comparing running totals means a series of partial refunds never
+    // revokes more than the order earned, whatever the rounding
+    earnedBefore := pointsEarned(orderRefunded, orderRefunded.RefundedAmount.Amount()-orderRefunded.Amount.Amount())
+    earnedAfter := pointsEarned(orderRefunded, orderRefunded.RefundedAmount.Amount())
+    points := earnedBefore - earnedAfter
+    if points <= 0 {
+        return nil
+    }
+    
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load customer
+    var customerAgg *customer.Customer
+    customerAgg, err = h.uow.CustomerRepository().FindByID(customer.CustomerID(orderRefunded.CustomerID))
+    if err != nil {
+        log.Printf("Failed to find customer %s: %v", orderRefunded.CustomerID, err)
+        return err
+    }
+    
+    err = customerAgg.RevokeLoyaltyPoints(points)
+    if err != nil {
+        log.Printf("Failed to revoke loyalty points: %v", err)
+        return err
+    }
+    
+    err = h.uow.CustomerRepository().Save(customerAgg)
+    if err != nil {
+        log.Printf("Failed to save customer: %v", err)
+        return err
+    }
+    
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    log.Printf("Revoked %d loyalty points from customer %s for refund on order %s",
+        points, orderRefunded.CustomerID, orderRefunded.OrderID)
+    
+    return nil
+}
+
+// pointsEarned returns the points the order is worth once refunded cents are taken off
+// WHAT: Mirrors OrderPlacedHandler - tax and delivery fee are excluded, in proportion to what was refunded
+func pointsEarned(event order.OrderRefundedEvent, refunded int64) int {
+    total := event.TotalAmount.Amount()
+    if total <= 0 {
+        return 0
+    }
+    
+    kept := total - refunded
+    taxKept := event.TaxAmount.Amount() * kept / total
+    feeKept := event.DeliveryFee.Amount() * kept / total
+    spend, err := shared.NewMoney(kept-taxKept-feeKept, event.TotalAmount.Currency())
+    if err != nil {
+        return 0
+    }
+    
+    return customer.PointsEarnedFor(spend)
+}
@@ -0,0 +1,75 @@
+package eventhandlers
+
+import (
+	"testing"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+func usd(t *testing.T, cents int64) shared.Money {
+    t.Helper()
+    money, err := shared.NewMoney(cents, "USD")
+    if err != nil {
+        t.Fatalf("NewMoney(%d): %v", cents, err)
+    }
+    return money
+}
+
+func TestPointsEarned(t *testing.T) {
+    tests := []struct {
+        name        string
+        total       int64
+        tax         int64
+        deliveryFee int64
+        refunded    int64
+        want        int
+    }{
+        {name: "nothing refunded excludes tax", total: 1075, tax: 75, want: 10},
+        {name: "nothing refunded excludes delivery fee", total: 1575, tax: 75, deliveryFee: 500, want: 10},
+        {name: "half refunded keeps half the spend", total: 2150, tax: 150, refunded: 1075, want: 10},
+        {name: "half refunded keeps half the delivery fee out", total: 3150, tax: 150, deliveryFee: 1000, refunded: 1575, want: 10},
+        {name: "fully refunded earns nothing", total: 1575, tax: 75, deliveryFee: 500, refunded: 1575, want: 0},
+        {name: "paid with points earns nothing", total: 0, want: 0},
+    }
+    
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            event := order.OrderRefundedEvent{
+                TotalAmount: usd(t, tt.total),
+                TaxAmount:   usd(t, tt.tax),
+                DeliveryFee: usd(t, tt.deliveryFee),
+            }
+            
+            got := pointsEarned(event, tt.refunded)
+            if got != tt.want {
+                t.Errorf("pointsEarned(total %d, tax %d, fee %d, refunded %d) = %d, want %d",
+                    tt.total, tt.tax, tt.deliveryFee, tt.refunded, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestPointsEarnedNeverRevokesMoreThanEarned(t *testing.T) {
+    event := order.OrderRefundedEvent{
+        TotalAmount: usd(t, 2333),
+        TaxAmount:   usd(t, 161),
+        DeliveryFee: usd(t, 399),
+    }
+    
+    // Refund the order a few cents at a time, the way OrderRefundedHandler sees it
+    earned := pointsEarned(event, 0)
+    revoked := 0
+    for refunded := int64(0); refunded < 2333; {
+        step := int64(97)
+        if refunded+step > 2333 {
+            step = 2333 - refunded
+        }
+        revoked += pointsEarned(event, refunded) - pointsEarned(event, refunded+step)
+        refunded += step
+    }
+    
+    if revoked != earned {
+        t.Errorf("revoked %d points across partial refunds, order earned %d", revoked, earned)
+    }
+}
@@ -0,0 +1,58 @@
+package eventhandlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/order/commands"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ReadyEstimateHandler re-estimates a store's orders whenever its kitchen load changes
+// WHY: Customers see their ready time move as the orders ahead of them are made
+type ReadyEstimateHandler struct {
+    orderRepo      order.OrderRepository
+    refreshHandler *commands.RefreshReadyEstimatesHandler
+}
+
+func NewReadyEstimateHandler(
+    orderRepo order.OrderRepository,
+    refreshHandler *commands.RefreshReadyEstimatesHandler,
+) *ReadyEstimateHandler {
+    return &ReadyEstimateHandler{
+        orderRepo:      orderRepo,
+        refreshHandler: refreshHandler,
+    }
+}
+
+// Handle processes the event
+// WHERE: Registered with event bus to handle order.confirmed, order.preparation_started,
+// order.ready, order.recalled, order.cancelled and store.prep_times_set events
+func (h *ReadyEstimateHandler) Handle(ctx context.Context, event shared.DomainEvent) error {
+    var storeID string
+    
+    switch event.(type) {
+    case store.PrepTimesSetEvent:
+        storeID = event.AggregateID()
+    case order.OrderConfirmedEvent, order.OrderPreparationStartedEvent, order.OrderReadyEvent,
+        order.OrderRecalledEvent, order.OrderCancelledEvent:
+        orderAgg, err := h.orderRepo.FindByID(order.OrderID(event.AggregateID()))
+        if err != nil {
+            log.Printf("Failed to find order %s for ready estimates: %v", event.AggregateID(), err)
+            return err
+        }
+        storeID = string(orderAgg.StoreID())
+    default:
+        return nil // Not our event
+    }
+    
+    _, err := h.refreshHandler.Handle(ctx, commands.RefreshReadyEstimatesCommand{StoreID: storeID})
+    if err != nil {
+        log.Printf("Failed to refresh ready estimates for store %s: %v", storeID, err)
+        return err
+    }
+    
+    return nil
+}
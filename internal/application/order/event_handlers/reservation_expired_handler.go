@@ -0,0 +1,48 @@
+package eventhandlers
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/order/commands"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ReservationExpiredHandler handles InventoryReservationReleasedEvent
+// WHY: An order whose stock hold lapsed can no longer be made, so it is cancelled
+// and its payment and loyalty points are given back
+type ReservationExpiredHandler struct {
+    cancelOrderHandler *commands.CancelOrderHandler
+}
+
+func NewReservationExpiredHandler(cancelOrderHandler *commands.CancelOrderHandler) *ReservationExpiredHandler {
+    return &ReservationExpiredHandler{cancelOrderHandler: cancelOrderHandler}
+}
+
+// Handle processes the event
+// WHERE: Registered with event bus to handle inventory.reservation_released events
+func (h *ReservationExpiredHandler) Handle(ctx context.Context, event shared.DomainEvent) error {
+    released, ok := event.(store.InventoryReservationReleasedEvent)
+    if !ok || released.Reason != string(store.ReleaseReasonExpired) {
+        return nil // Not our event
+    }
+    
+    err := h.cancelOrderHandler.Handle(ctx, commands.CancelOrderCommand{
+        OrderID:            released.OrderID,
+        Reason:             "reservation expired",
+        Actor:              order.ActorSystem,
+        ReservationExpired: true,
+    })
+    if errors.Is(err, order.ErrOrderNotFound) || errors.Is(err, order.ErrInvalidStatusTransition) {
+        return nil // Checkout never finished, or the order already moved on
+    }
+    if err != nil {
+        log.Printf("Failed to cancel order %s after its reservation expired: %v", released.OrderID, err)
+        return err
+    }
+    
+    return nil
+}
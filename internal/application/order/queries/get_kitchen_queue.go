@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// GetKitchenQueueQuery represents request for a store's kitchen queue
+type GetKitchenQueueQuery struct {
+    StoreID string
+}
+
+// GetKitchenQueueHandler handles kitchen queue lookups
+// WHY: Reads the kitchen queue read model instead of loading every open order
+type GetKitchenQueueHandler struct {
+    storeRepo  store.StoreRepository
+    queueStore interfaces.KitchenQueueStore
+}
+
+func NewGetKitchenQueueHandler(
+    storeRepo store.StoreRepository,
+    queueStore interfaces.KitchenQueueStore,
+) *GetKitchenQueueHandler {
+    return &GetKitchenQueueHandler{
+        storeRepo:  storeRepo,
+        queueStore: queueStore,
+    }
+}
+
+// Handle returns the store's confirmed and preparing orders in display order
+func (h *GetKitchenQueueHandler) Handle(ctx context.Context, query GetKitchenQueueQuery) ([]dtos.KitchenTicketDTO, error) {
+    // An unknown store would otherwise look like a quiet one
+    _, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    return h.queueStore.List(query.StoreID)
+}
@@ -29,26 +29,5 @@ func (h *GetOrderHandler) Handle(ctx context.Context, query GetOrderQuery) (*dto
     }
     
     // Convert to DTO
-    items := make([]dtos.OrderItemDTO, len(orderAgg.Items()))
-    for i, item := range orderAgg.Items() {
-        items[i] = dtos.OrderItemDTO{
-            ID:        item.ID(),
-            ProductID: string(item.ProductID()),
-            Name:      item.Name(),
-            Quantity:  item.Quantity(),
-            UnitPrice: float64(item.UnitPrice().Amount()) / 100,
-            Total:     float64(item.Total().Amount()) / 100,
-        }
-    }
-    
-    return &dtos.OrderDTO{
-        ID:          string(orderAgg.ID()),
-        CustomerID:  string(orderAgg.CustomerID()),
-        StoreID:     string(orderAgg.StoreID()),
-        Status:      string(orderAgg.Status()),
-        TotalAmount: float64(orderAgg.TotalAmount().Amount()) / 100,
-        Currency:    orderAgg.TotalAmount().Currency(),
-        Items:       items,
-        PlacedAt:    orderAgg.PlacedAt(),
-    }, nil
+    return dtos.NewOrderDTO(orderAgg), nil
 }
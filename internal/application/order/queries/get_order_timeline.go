@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+)
+
+// GetOrderTimelineQuery represents request for an order's status history
+type GetOrderTimelineQuery struct {
+    OrderID string
+}
+
+// GetOrderTimelineHandler handles order timeline queries
+// WHERE: Used by support to see which stage held up a slow order
+type GetOrderTimelineHandler struct {
+    orderRepo order.OrderRepository
+}
+
+func NewGetOrderTimelineHandler(orderRepo order.OrderRepository) *GetOrderTimelineHandler {
+    return &GetOrderTimelineHandler{orderRepo: orderRepo}
+}
+
+func (h *GetOrderTimelineHandler) Handle(ctx context.Context, query GetOrderTimelineQuery) (*dtos.OrderTimelineDTO, error) {
+    // Load order
+    orderAgg, err := h.orderRepo.FindByID(order.OrderID(query.OrderID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Convert to DTO, open stages are measured up to now
+    return dtos.NewOrderTimelineDTO(orderAgg, time.Now()), nil
+}
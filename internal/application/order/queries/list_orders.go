@@ -32,28 +32,7 @@ func (h *ListOrdersHandler) Handle(ctx context.Context, query ListOrdersQuery) (
     // Convert to DTOs
     result := make([]*dtos.OrderDTO, len(orders))
     for i, orderAgg := range orders {
-        items := make([]dtos.OrderItemDTO, len(orderAgg.Items()))
-        for j, item := range orderAgg.Items() {
-            items[j] = dtos.OrderItemDTO{
-                ID:        item.ID(),
-                ProductID: string(item.ProductID()),
-                Name:      item.Name(),
-                Quantity:  item.Quantity(),
-                UnitPrice: float64(item.UnitPrice().Amount()) / 100,
-                Total:     float64(item.Total().Amount()) / 100,
-            }
-        }
-        
-        result[i] = &dtos.OrderDTO{
-            ID:          string(orderAgg.ID()),
-            CustomerID:  string(orderAgg.CustomerID()),
-            StoreID:     string(orderAgg.StoreID()),
-            Status:      string(orderAgg.Status()),
-            TotalAmount: float64(orderAgg.TotalAmount().Amount()) / 100,
-            Currency:    orderAgg.TotalAmount().Currency(),
-            Items:       items,
-            PlacedAt:    orderAgg.PlacedAt(),
-        }
+        result[i] = dtos.NewOrderDTO(orderAgg)
     }
     
     return result, nil
@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListOrdersByStatusQuery represents request for orders in one status
+type ListOrdersByStatusQuery struct {
+    Status  string
+    StoreID string // Optional, limits the list to one store
+}
+
+// ListOrdersByStatusHandler handles listing orders by status
+// WHERE: Used by stand staff, e.g. SCHEDULED for a store's upcoming pre-orders
+type ListOrdersByStatusHandler struct {
+    orderRepo order.OrderRepository
+}
+
+func NewListOrdersByStatusHandler(orderRepo order.OrderRepository) *ListOrdersByStatusHandler {
+    return &ListOrdersByStatusHandler{orderRepo: orderRepo}
+}
+
+// Handle returns matching orders, oldest first
+// WHAT: Pre-orders are listed by pickup time instead, the next one due first
+func (h *ListOrdersByStatusHandler) Handle(ctx context.Context, query ListOrdersByStatusQuery) ([]*dtos.OrderDTO, error) {
+    status, err := order.ParseOrderStatus(query.Status)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Find orders
+    orders, err := h.orderRepo.FindByStatus(status)
+    if err != nil {
+        return nil, err
+    }
+    
+    matching := make([]*order.Order, 0, len(orders))
+    for _, orderAgg := range orders {
+        if query.StoreID != "" && orderAgg.StoreID() != store.StoreID(query.StoreID) {
+            continue
+        }
+        matching = append(matching, orderAgg)
+    }
+    
+    sort.Slice(matching, func(i, j int) bool {
+        if status == order.OrderStatusScheduled {
+            return matching[i].PickupAt().Before(matching[j].PickupAt())
+        }
+        return matching[i].PlacedAt().Before(matching[j].PlacedAt())
+    })
+    
+    // Convert to DTOs
+    result := make([]*dtos.OrderDTO, len(matching))
+    for i, orderAgg := range matching {
+        result[i] = dtos.NewOrderDTO(orderAgg)
+    }
+    
+    return result, nil
+}
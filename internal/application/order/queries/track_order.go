@@ -0,0 +1,124 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// trackedOrderEvents lists the events that change an order's visible status or ready estimate
+var trackedOrderEvents = []string{
+    "order.confirmed",
+    "order.preparation_started",
+    "order.ready",
+    "order.recalled",
+    "order.completed",
+    "order.cancelled",
+    "order.out_for_delivery",
+    "order.delivered",
+    "order.ready_estimate_updated",
+}
+
+// TrackOrderQuery represents request to follow an order's progress
+type TrackOrderQuery struct {
+    OrderID string
+}
+
+// TrackOrderHandler streams order status changes
+// WHY: Customers watch their order progress live instead of polling GetOrder
+// WHERE: Backs the server-streaming TrackOrder RPC
+type TrackOrderHandler struct {
+    orderRepo       order.OrderRepository
+    eventSubscriber interfaces.EventSubscriber
+}
+
+func NewTrackOrderHandler(
+    orderRepo order.OrderRepository,
+    eventSubscriber interfaces.EventSubscriber,
+) *TrackOrderHandler {
+    return &TrackOrderHandler{
+        orderRepo:       orderRepo,
+        eventSubscriber: eventSubscriber,
+    }
+}
+
+// Handle sends the current status, then every status change and new ready estimate
+// until the order reaches a terminal status or ctx is done
+// WHAT: Events only say the order changed - each update is read from the order as it
+// is now, so events delivered out of order can't leave the tracker behind
+func (h *TrackOrderHandler) Handle(
+    ctx context.Context,
+    query TrackOrderQuery,
+    send func(update dtos.OrderUpdateDTO) error,
+) error {
+    // One pending signal is enough, the reload picks up every change since
+    changed := make(chan struct{}, 1)
+    
+    // Subscribe before loading so no transition is missed in between
+    handler := func(_ context.Context, event shared.DomainEvent) error {
+        if event.AggregateID() != query.OrderID {
+            return nil
+        }
+        select {
+        case changed <- struct{}{}:
+        default:
+        }
+        return nil
+    }
+    for _, eventName := range trackedOrderEvents {
+        unsubscribe := h.eventSubscriber.Subscribe(eventName, handler)
+        defer unsubscribe()
+    }
+    
+    // Send current status immediately
+    orderAgg, err := h.orderRepo.FindByID(order.OrderID(query.OrderID))
+    if err != nil {
+        return err
+    }
+    current, estimate := orderAgg.Status(), orderAgg.EstimatedReadyAt()
+    err = send(dtos.OrderUpdateDTO{
+        OrderID:        query.OrderID,
+        Status:         string(current),
+        UpdatedAt:      time.Now(),
+        EstimatedReady: estimate,
+    })
+    if err != nil || current.IsTerminal() {
+        return err
+    }
+    
+    // Forward changes until the order is finished
+    for {
+        select {
+        case <-ctx.Done():
+            return ctx.Err()
+        case <-changed:
+        }
+        
+        orderAgg, err = h.orderRepo.FindByID(order.OrderID(query.OrderID))
+        if err != nil {
+            return err
+        }
+        
+        update := dtos.OrderUpdateDTO{
+            OrderID:   query.OrderID,
+            Status:    string(orderAgg.Status()),
+            UpdatedAt: time.Now(),
+        }
+        if next := orderAgg.EstimatedReadyAt(); !next.Equal(estimate) {
+            update.EstimatedReady, estimate = next, next
+        }
+        if orderAgg.Status() == current && update.EstimatedReady.IsZero() {
+            continue // Already sent
+        }
+        
+        current = orderAgg.Status()
+        err = send(update)
+        if err != nil || current.IsTerminal() {
+            return err
+        }
+    }
+}
@@ -0,0 +1,132 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// WatchKitchenQueueQuery represents request to follow a store's kitchen queue
+type WatchKitchenQueueQuery struct {
+    StoreID string
+}
+
+// WatchKitchenQueueHandler streams changes to a store's kitchen queue
+// WHY: Kitchen displays update as orders come and go instead of polling
+// WHERE: Backs the server-streaming WatchQueue RPC
+type WatchKitchenQueueHandler struct {
+    storeRepo  store.StoreRepository
+    queueStore interfaces.KitchenQueueStore
+}
+
+func NewWatchKitchenQueueHandler(
+    storeRepo store.StoreRepository,
+    queueStore interfaces.KitchenQueueStore,
+) *WatchKitchenQueueHandler {
+    return &WatchKitchenQueueHandler{
+        storeRepo:  storeRepo,
+        queueStore: queueStore,
+    }
+}
+
+// Handle sends the whole queue, then only what changed until ctx is done
+func (h *WatchKitchenQueueHandler) Handle(
+    ctx context.Context,
+    query WatchKitchenQueueQuery,
+    send func(update dtos.KitchenQueueUpdateDTO) error,
+) error {
+    _, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return err
+    }
+    
+    // Watch before listing so no change is missed in between
+    // WHAT: One pending signal covers any number of changes, each diff is taken
+    // against what was last sent, so a slow display skips straight to the latest queue
+    changed := make(chan struct{}, 1)
+    unsubscribe := h.queueStore.Watch(query.StoreID, func() {
+        select {
+        case changed <- struct{}{}:
+        default:
+        }
+    })
+    defer unsubscribe()
+    
+    // Send the whole queue immediately
+    sent, err := h.queueStore.List(query.StoreID)
+    if err != nil {
+        return err
+    }
+    err = send(dtos.KitchenQueueUpdateDTO{
+        StoreID:  query.StoreID,
+        Snapshot: true,
+        Upserted: sent,
+        OrderIDs: ticketOrderIDs(sent),
+    })
+    if err != nil {
+        return err
+    }
+    
+    // Forward changes until the display disconnects
+    for {
+        select {
+        case <-ctx.Done():
+            return ctx.Err()
+        case <-changed:
+            current, err := h.queueStore.List(query.StoreID)
+            if err != nil {
+                return err
+            }
+            update, ok := diffKitchenQueue(query.StoreID, sent, current)
+            if !ok {
+                continue
+            }
+            if err := send(update); err != nil {
+                return err
+            }
+            sent = current
+        }
+    }
+}
+
+// diffKitchenQueue works out the update that turns previous into current,
+// false when the display already shows current
+func diffKitchenQueue(storeID string, previous, current []dtos.KitchenTicketDTO) (dtos.KitchenQueueUpdateDTO, bool) {
+    update := dtos.KitchenQueueUpdateDTO{
+        StoreID:  storeID,
+        OrderIDs: ticketOrderIDs(current),
+    }
+    
+    before := make(map[string]dtos.KitchenTicketDTO, len(previous))
+    for _, ticket := range previous {
+        before[ticket.OrderID] = ticket
+    }
+    for _, ticket := range current {
+        old, exists := before[ticket.OrderID]
+        if !exists || !old.UpdatedAt.Equal(ticket.UpdatedAt) {
+            update.Upserted = append(update.Upserted, ticket)
+        }
+        delete(before, ticket.OrderID)
+    }
+    for _, ticket := range previous {
+        if _, gone := before[ticket.OrderID]; gone {
+            update.Removed = append(update.Removed, ticket.OrderID)
+        }
+    }
+    
+    if len(update.Upserted) == 0 && len(update.Removed) == 0 {
+        return update, false
+    }
+    return update, true
+}
+
+// ticketOrderIDs lists the tickets' order IDs in display order
+func ticketOrderIDs(tickets []dtos.KitchenTicketDTO) []string {
+    ids := make([]string, len(tickets))
+    for i, ticket := range tickets {
+        ids[i] = ticket.OrderID
+    }
+    return ids
+}
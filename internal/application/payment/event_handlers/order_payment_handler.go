@@ -0,0 +1,111 @@
+package eventhandlers
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// OrderPaymentHandler settles payments as orders finish
+// WHY: Funds are held at checkout, collected at pickup and released on cancellation
+type OrderPaymentHandler struct {
+    paymentRepo    payment.PaymentRepository
+    paymentGateway interfaces.PaymentGateway
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewOrderPaymentHandler(
+    paymentRepo payment.PaymentRepository,
+    paymentGateway interfaces.PaymentGateway,
+    eventPublisher interfaces.EventPublisher,
+) *OrderPaymentHandler {
+    return &OrderPaymentHandler{
+        paymentRepo:    paymentRepo,
+        paymentGateway: paymentGateway,
+        eventPublisher: eventPublisher,
+    }
+}
+
+// Handle processes the event
+// WHERE: Registered with event bus to handle order.completed, order.delivered and order.cancelled events
+func (h *OrderPaymentHandler) Handle(ctx context.Context, event shared.DomainEvent) error {
+    switch e := event.(type) {
+    case order.OrderCompletedEvent:
+        return h.capture(ctx, order.OrderID(e.OrderID))
+    case order.OrderDeliveredEvent:
+        return h.capture(ctx, order.OrderID(e.OrderID))
+    case order.OrderCancelledEvent:
+        return h.void(ctx, order.OrderID(e.OrderID))
+    default:
+        return nil // Not our event
+    }
+}
+
+// capture collects the held funds for a completed order
+func (h *OrderPaymentHandler) capture(ctx context.Context, orderID order.OrderID) error {
+    paymentAgg, err := h.paymentRepo.FindByOrderID(orderID)
+    if errors.Is(err, payment.ErrPaymentNotFound) {
+        return nil // Fully paid with points
+    }
+    if err != nil {
+        return err
+    }
+    
+    err = h.paymentGateway.Capture(ctx, paymentAgg.AuthorizationID(), paymentAgg.Amount())
+    if err != nil {
+        log.Printf("Failed to capture payment for order %s: %v", orderID, err)
+        return err
+    }
+    
+    err = paymentAgg.Capture()
+    if err != nil {
+        return err
+    }
+    
+    return h.save(ctx, paymentAgg)
+}
+
+// void releases the held funds for a cancelled order
+func (h *OrderPaymentHandler) void(ctx context.Context, orderID order.OrderID) error {
+    paymentAgg, err := h.paymentRepo.FindByOrderID(orderID)
+    if errors.Is(err, payment.ErrPaymentNotFound) {
+        return nil // Fully paid with points
+    }
+    if err != nil {
+        return err
+    }
+    
+    err = h.paymentGateway.Void(ctx, paymentAgg.AuthorizationID())
+    if err != nil {
+        log.Printf("Failed to void payment for order %s: %v", orderID, err)
+        return err
+    }
+    
+    err = paymentAgg.Void()
+    if err != nil {
+        return err
+    }
+    
+    return h.save(ctx, paymentAgg)
+}
+
+// save persists the payment and publishes its events
+func (h *OrderPaymentHandler) save(ctx context.Context, paymentAgg *payment.Payment) error {
+    err := h.paymentRepo.Save(paymentAgg)
+    if err != nil {
+        log.Printf("Failed to save payment: %v", err)
+        return err
+    }
+    
+    events := paymentAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// CreatePromotionCommand represents request to set up a promo code
+// WHAT: Only the reward fields matching RewardType are read
+type CreatePromotionCommand struct {
+    Code               string
+    Description        string
+    RewardType         string
+    PercentOff         float64 // PERCENT_OFF, 0-100
+    AmountOff          float64 // FIXED_AMOUNT_OFF
+    Currency           string  // FIXED_AMOUNT_OFF and MinimumSpend
+    ProductID          string  // BUY_X_GET_Y
+    BuyQuantity        int     // BUY_X_GET_Y
+    FreeQuantity       int     // BUY_X_GET_Y
+    MinimumSpend       float64 // Optional, 0 means none
+    ValidFrom          time.Time
+    ValidUntil         time.Time
+    MaxUses            int // 0 means unlimited
+    MaxUsesPerCustomer int // 0 means unlimited
+}
+
+// CreatePromotionHandler handles promotion creation
+// WHERE: Called from back-office tooling before a promotion goes live
+type CreatePromotionHandler struct {
+    promoRepo      promotion.PromotionRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewCreatePromotionHandler(
+    promoRepo promotion.PromotionRepository,
+    eventPublisher interfaces.EventPublisher,
+) *CreatePromotionHandler {
+    return &CreatePromotionHandler{
+        promoRepo:      promoRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *CreatePromotionHandler) Handle(ctx context.Context, cmd CreatePromotionCommand) (*dtos.PromotionDTO, error) {
+    // Check if code already exists
+    code, err := promotion.NewPromoCode(cmd.Code)
+    if err != nil {
+        return nil, err
+    }
+    existing, _ := h.promoRepo.FindByCode(code)
+    if existing != nil {
+        return nil, promotion.ErrDuplicatePromoCode
+    }
+    
+    // Build reward value object
+    reward, err := h.buildReward(cmd)
+    if err != nil {
+        return nil, err
+    }
+    
+    limits, err := promotion.NewUsageLimits(cmd.MaxUses, cmd.MaxUsesPerCustomer)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Create promotion aggregate
+    promotionAgg, err := promotion.NewPromotion(
+        cmd.Code,
+        cmd.Description,
+        reward,
+        cmd.ValidFrom,
+        cmd.ValidUntil,
+        limits,
+    )
+    if err != nil {
+        return nil, err
+    }
+    
+    if cmd.MinimumSpend > 0 {
+        minimumSpend, err := shared.NewMoney(toCents(cmd.MinimumSpend), cmd.Currency)
+        if err != nil {
+            return nil, err
+        }
+        err = promotionAgg.RequireMinimumSpend(minimumSpend)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // Save
+    err = h.promoRepo.Save(promotionAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := promotionAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewPromotionDTO(promotionAgg), nil
+}
+
+// buildReward converts the flat command fields into a reward value object
+func (h *CreatePromotionHandler) buildReward(cmd CreatePromotionCommand) (promotion.Reward, error) {
+    switch promotion.RewardType(cmd.RewardType) {
+    case promotion.RewardTypePercentOff:
+        return promotion.NewPercentOffReward(cmd.PercentOff / 100)
+    case promotion.RewardTypeFixedAmountOff:
+        amount, err := shared.NewMoney(toCents(cmd.AmountOff), cmd.Currency)
+        if err != nil {
+            return promotion.Reward{}, err
+        }
+        return promotion.NewFixedAmountOffReward(amount)
+    case promotion.RewardTypeBuyXGetY:
+        return promotion.NewBuyXGetYReward(store.ProductID(cmd.ProductID), cmd.BuyQuantity, cmd.FreeQuantity)
+    default:
+        return promotion.Reward{}, promotion.ErrInvalidRewardType
+    }
+}
+
+// toCents converts a decimal amount to cents without float truncation
+func toCents(amount float64) int64 {
+    return int64(math.Round(amount * 100))
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+)
+
+// DisablePromotionCommand represents request to switch off a promo code
+type DisablePromotionCommand struct {
+    Code string
+}
+
+// DisablePromotionHandler handles promotion disabling
+type DisablePromotionHandler struct {
+    promoRepo      promotion.PromotionRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewDisablePromotionHandler(
+    promoRepo promotion.PromotionRepository,
+    eventPublisher interfaces.EventPublisher,
+) *DisablePromotionHandler {
+    return &DisablePromotionHandler{
+        promoRepo:      promoRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *DisablePromotionHandler) Handle(ctx context.Context, cmd DisablePromotionCommand) error {
+    // Load promotion
+    code, err := promotion.NewPromoCode(cmd.Code)
+    if err != nil {
+        return err
+    }
+    promotionAgg, err := h.promoRepo.FindByCode(code)
+    if err != nil {
+        return err
+    }
+    
+    // Disable
+    err = promotionAgg.Disable()
+    if err != nil {
+        return err
+    }
+    
+    // Save
+    err = h.promoRepo.Save(promotionAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Publish events
+    events := promotionAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
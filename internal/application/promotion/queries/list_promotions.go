@@ -0,0 +1,47 @@
+package queries
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+)
+
+// ListPromotionsQuery represents request for promo codes
+type ListPromotionsQuery struct {
+    ActiveOnly bool
+}
+
+// ListPromotionsHandler handles promotion listing
+type ListPromotionsHandler struct {
+    promoRepo promotion.PromotionRepository
+}
+
+func NewListPromotionsHandler(promoRepo promotion.PromotionRepository) *ListPromotionsHandler {
+    return &ListPromotionsHandler{promoRepo: promoRepo}
+}
+
+// Handle returns promotions sorted by code
+func (h *ListPromotionsHandler) Handle(ctx context.Context, query ListPromotionsQuery) ([]*dtos.PromotionDTO, error) {
+    // Find promotions
+    promotions, err := h.promoRepo.FindAll()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Convert to DTOs
+    result := make([]*dtos.PromotionDTO, 0, len(promotions))
+    for _, promotionAgg := range promotions {
+        if query.ActiveOnly && !promotionAgg.IsActive() {
+            continue
+        }
+        result = append(result, dtos.NewPromotionDTO(promotionAgg))
+    }
+    
+    sort.Slice(result, func(i, j int) bool {
+        return result[i].Code < result[j].Code
+    })
+    
+    return result, nil
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+)
+
+// CancelPurchaseOrderCommand represents request to cancel a purchase order
+type CancelPurchaseOrderCommand struct {
+    PurchaseOrderID string
+    Reason          string
+}
+
+// CancelPurchaseOrderHandler handles purchase order cancellation
+type CancelPurchaseOrderHandler struct {
+    purchaseOrderRepo purchasing.PurchaseOrderRepository
+    eventPublisher    interfaces.EventPublisher
+}
+
+func NewCancelPurchaseOrderHandler(
+    purchaseOrderRepo purchasing.PurchaseOrderRepository,
+    eventPublisher interfaces.EventPublisher,
+) *CancelPurchaseOrderHandler {
+    return &CancelPurchaseOrderHandler{
+        purchaseOrderRepo: purchaseOrderRepo,
+        eventPublisher:    eventPublisher,
+    }
+}
+
+func (h *CancelPurchaseOrderHandler) Handle(ctx context.Context, cmd CancelPurchaseOrderCommand) (*dtos.PurchaseOrderDTO, error) {
+    // Load aggregate
+    purchaseOrder, err := h.purchaseOrderRepo.FindByID(purchasing.PurchaseOrderID(cmd.PurchaseOrderID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Execute domain logic
+    err = purchaseOrder.Cancel(cmd.Reason)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save
+    err = h.purchaseOrderRepo.Save(purchaseOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := purchaseOrder.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewPurchaseOrderDTO(purchaseOrder), nil
+}
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// CreatePurchaseOrderCommand represents request to draft a purchase order
+type CreatePurchaseOrderCommand struct {
+    SupplierID string
+    StoreID    string
+    Currency   string
+    Lines      []PurchaseOrderLineInput
+}
+
+// PurchaseOrderLineInput is one item to order from the supplier
+type PurchaseOrderLineInput struct {
+    ItemType string // PRODUCT or INGREDIENT
+    ItemID   string
+    Quantity int
+    UnitCost float64
+}
+
+// CreatePurchaseOrderHandler handles drafting purchase orders
+// WHERE: Called by the ops team when planning a restock
+type CreatePurchaseOrderHandler struct {
+    supplierRepo      purchasing.SupplierRepository
+    purchaseOrderRepo purchasing.PurchaseOrderRepository
+    storeRepo         store.StoreRepository
+}
+
+func NewCreatePurchaseOrderHandler(
+    supplierRepo purchasing.SupplierRepository,
+    purchaseOrderRepo purchasing.PurchaseOrderRepository,
+    storeRepo store.StoreRepository,
+) *CreatePurchaseOrderHandler {
+    return &CreatePurchaseOrderHandler{
+        supplierRepo:      supplierRepo,
+        purchaseOrderRepo: purchaseOrderRepo,
+        storeRepo:         storeRepo,
+    }
+}
+
+func (h *CreatePurchaseOrderHandler) Handle(ctx context.Context, cmd CreatePurchaseOrderCommand) (*dtos.PurchaseOrderDTO, error) {
+    // Validate supplier
+    supplier, err := h.supplierRepo.FindByID(purchasing.SupplierID(cmd.SupplierID))
+    if err != nil {
+        return nil, err
+    }
+    if !supplier.IsActive() {
+        return nil, purchasing.ErrSupplierInactive
+    }
+    
+    // Load store to check the items exist
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Create purchase order aggregate
+    purchaseOrder, err := purchasing.NewPurchaseOrder(supplier.ID(), storeAgg.ID(), cmd.Currency)
+    if err != nil {
+        return nil, err
+    }
+    
+    for _, input := range cmd.Lines {
+        item, err := store.NewStockItem(input.ItemType, input.ItemID)
+        if err != nil {
+            return nil, err
+        }
+        
+        itemName, err := stockItemName(storeAgg, item)
+        if err != nil {
+            return nil, err
+        }
+        
+        unitCost, err := shared.NewMoney(int64(math.Round(input.UnitCost*100)), cmd.Currency)
+        if err != nil {
+            return nil, err
+        }
+        
+        err = purchaseOrder.AddLine(item, itemName, input.Quantity, unitCost)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // Save
+    err = h.purchaseOrderRepo.Save(purchaseOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    return dtos.NewPurchaseOrderDTO(purchaseOrder), nil
+}
+
+// stockItemName looks up an item the store can be restocked with
+// WHY: Made-to-order products are never delivered, only their ingredients are
+func stockItemName(storeAgg *store.Store, item store.StockItem) (string, error) {
+    switch item.Type() {
+    case store.StockItemProduct:
+        product, err := storeAgg.GetProduct(store.ProductID(item.ID()))
+        if err != nil {
+            return "", err
+        }
+        if product.IsMadeToOrder() {
+            return "", errors.New("product is made from a recipe, order its ingredients instead")
+        }
+        return string(product.Name()), nil
+    default:
+        ingredient, err := storeAgg.GetIngredient(store.IngredientID(item.ID()))
+        if err != nil {
+            return "", err
+        }
+        return ingredient.Name(), nil
+    }
+}
@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ReceiveGoodsCommand represents a delivery arriving against a purchase order
+type ReceiveGoodsCommand struct {
+    PurchaseOrderID string
+    Lines           []ReceivedLineInput
+}
+
+// ReceivedLineInput is how much of one purchase order line arrived
+type ReceivedLineInput struct {
+    LineID   string
+    Quantity int
+}
+
+// ReceiveGoodsHandler handles goods receiving
+// WHY: The purchase order and the store's stock must change together or not at all
+// WHERE: Called by store staff when a supplier delivery is checked in
+type ReceiveGoodsHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewReceiveGoodsHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *ReceiveGoodsHandler {
+    return &ReceiveGoodsHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *ReceiveGoodsHandler) Handle(ctx context.Context, cmd ReceiveGoodsCommand) (*dtos.PurchaseOrderDTO, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load purchase order
+    var purchaseOrder *purchasing.PurchaseOrder
+    purchaseOrder, err = h.uow.PurchaseOrderRepository().FindByID(purchasing.PurchaseOrderID(cmd.PurchaseOrderID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Check the store can take every delivered line before booking anything
+    // WHY: A product may have been deactivated or given a recipe since it was ordered
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(purchaseOrder.StoreID())
+    if err != nil {
+        return nil, err
+    }
+    
+    receipts := make([]purchasing.LineReceipt, len(cmd.Lines))
+    for i, receipt := range cmd.Lines {
+        var line *purchasing.PurchaseOrderLine
+        line, err = purchaseOrder.Line(receipt.LineID)
+        if err != nil {
+            return nil, err
+        }
+        
+        err = canStockIn(storeAgg, line.Item(), receipt.Quantity)
+        if err != nil {
+            return nil, fmt.Errorf("cannot receive %s: %w", line.ItemName(), err)
+        }
+        receipts[i] = purchasing.LineReceipt{LineID: receipt.LineID, Quantity: receipt.Quantity}
+    }
+    
+    // Book the delivery against the purchase order
+    var received []purchasing.ReceivedLine
+    received, err = purchaseOrder.Receive(receipts)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Put the delivered goods into the store's stock
+    for _, line := range received {
+        err = stockIn(storeAgg, line.Item, line.Quantity)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save purchase order
+    err = h.uow.PurchaseOrderRepository().Save(purchaseOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := append(purchaseOrder.PullEvents(), storeAgg.PullEvents()...)
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewPurchaseOrderDTO(purchaseOrder), nil
+}
+
+// canStockIn checks that delivered goods could be added to the store
+func canStockIn(storeAgg *store.Store, item store.StockItem, quantity int) error {
+    if item.Type() == store.StockItemProduct {
+        return storeAgg.CanAddInventory(store.ProductID(item.ID()), quantity)
+    }
+    return storeAgg.CanRestockIngredient(store.IngredientID(item.ID()), quantity)
+}
+
+// stockIn adds delivered goods to the store
+func stockIn(storeAgg *store.Store, item store.StockItem, quantity int) error {
+    if item.Type() == store.StockItemProduct {
+        return storeAgg.AddInventory(store.ProductID(item.ID()), quantity)
+    }
+    return storeAgg.RestockIngredient(store.IngredientID(item.ID()), quantity)
+}
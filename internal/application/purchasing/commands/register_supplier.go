@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+)
+
+// RegisterSupplierCommand represents request to onboard a supplier
+type RegisterSupplierCommand struct {
+    Name         string
+    ContactEmail string
+    Phone        string
+}
+
+// RegisterSupplierHandler handles supplier registration
+type RegisterSupplierHandler struct {
+    supplierRepo   purchasing.SupplierRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewRegisterSupplierHandler(
+    supplierRepo purchasing.SupplierRepository,
+    eventPublisher interfaces.EventPublisher,
+) *RegisterSupplierHandler {
+    return &RegisterSupplierHandler{
+        supplierRepo:   supplierRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *RegisterSupplierHandler) Handle(ctx context.Context, cmd RegisterSupplierCommand) (*dtos.SupplierDTO, error) {
+    // Create supplier aggregate
+    supplier, err := purchasing.NewSupplier(cmd.Name, cmd.ContactEmail, cmd.Phone)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save
+    err = h.supplierRepo.Save(supplier)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := supplier.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewSupplierDTO(supplier), nil
+}
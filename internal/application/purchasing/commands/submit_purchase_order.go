@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+)
+
+// SubmitPurchaseOrderCommand represents request to send a purchase order to its supplier
+type SubmitPurchaseOrderCommand struct {
+    PurchaseOrderID string
+}
+
+// SubmitPurchaseOrderHandler handles purchase order submission
+type SubmitPurchaseOrderHandler struct {
+    purchaseOrderRepo purchasing.PurchaseOrderRepository
+    eventPublisher    interfaces.EventPublisher
+}
+
+func NewSubmitPurchaseOrderHandler(
+    purchaseOrderRepo purchasing.PurchaseOrderRepository,
+    eventPublisher interfaces.EventPublisher,
+) *SubmitPurchaseOrderHandler {
+    return &SubmitPurchaseOrderHandler{
+        purchaseOrderRepo: purchaseOrderRepo,
+        eventPublisher:    eventPublisher,
+    }
+}
+
+func (h *SubmitPurchaseOrderHandler) Handle(ctx context.Context, cmd SubmitPurchaseOrderCommand) (*dtos.PurchaseOrderDTO, error) {
+    // Load aggregate
+    purchaseOrder, err := h.purchaseOrderRepo.FindByID(purchasing.PurchaseOrderID(cmd.PurchaseOrderID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Execute domain logic
+    err = purchaseOrder.Submit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save
+    err = h.purchaseOrderRepo.Save(purchaseOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := purchaseOrder.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewPurchaseOrderDTO(purchaseOrder), nil
+}
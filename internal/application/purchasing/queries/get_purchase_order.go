@@ -0,0 +1,31 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+)
+
+// GetPurchaseOrderQuery represents request for purchase order details
+type GetPurchaseOrderQuery struct {
+    PurchaseOrderID string
+}
+
+// GetPurchaseOrderHandler handles purchase order queries
+type GetPurchaseOrderHandler struct {
+    purchaseOrderRepo purchasing.PurchaseOrderRepository
+}
+
+func NewGetPurchaseOrderHandler(purchaseOrderRepo purchasing.PurchaseOrderRepository) *GetPurchaseOrderHandler {
+    return &GetPurchaseOrderHandler{purchaseOrderRepo: purchaseOrderRepo}
+}
+
+func (h *GetPurchaseOrderHandler) Handle(ctx context.Context, query GetPurchaseOrderQuery) (*dtos.PurchaseOrderDTO, error) {
+    purchaseOrder, err := h.purchaseOrderRepo.FindByID(purchasing.PurchaseOrderID(query.PurchaseOrderID))
+    if err != nil {
+        return nil, err
+    }
+    
+    return dtos.NewPurchaseOrderDTO(purchaseOrder), nil
+}
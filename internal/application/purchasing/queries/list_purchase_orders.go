@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListPurchaseOrdersQuery represents request for a store's purchase orders
+type ListPurchaseOrdersQuery struct {
+    StoreID string
+    Status  string // Optional, empty returns every status
+}
+
+// ListPurchaseOrdersHandler handles purchase order listing
+type ListPurchaseOrdersHandler struct {
+    purchaseOrderRepo purchasing.PurchaseOrderRepository
+}
+
+func NewListPurchaseOrdersHandler(purchaseOrderRepo purchasing.PurchaseOrderRepository) *ListPurchaseOrdersHandler {
+    return &ListPurchaseOrdersHandler{purchaseOrderRepo: purchaseOrderRepo}
+}
+
+// Handle returns purchase orders oldest first
+func (h *ListPurchaseOrdersHandler) Handle(ctx context.Context, query ListPurchaseOrdersQuery) ([]*dtos.PurchaseOrderDTO, error) {
+    purchaseOrders, err := h.purchaseOrderRepo.FindByStoreID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    result := make([]*dtos.PurchaseOrderDTO, 0, len(purchaseOrders))
+    for _, purchaseOrder := range purchaseOrders {
+        if query.Status != "" && string(purchaseOrder.Status()) != query.Status {
+            continue
+        }
+        result = append(result, dtos.NewPurchaseOrderDTO(purchaseOrder))
+    }
+    
+    return result, nil
+}
@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+)
+
+// ListSuppliersQuery represents request for suppliers
+type ListSuppliersQuery struct {
+    ActiveOnly bool
+}
+
+// ListSuppliersHandler handles supplier listing
+type ListSuppliersHandler struct {
+    supplierRepo purchasing.SupplierRepository
+}
+
+func NewListSuppliersHandler(supplierRepo purchasing.SupplierRepository) *ListSuppliersHandler {
+    return &ListSuppliersHandler{supplierRepo: supplierRepo}
+}
+
+// Handle returns suppliers sorted by name
+func (h *ListSuppliersHandler) Handle(ctx context.Context, query ListSuppliersQuery) ([]*dtos.SupplierDTO, error) {
+    suppliers, err := h.supplierRepo.FindAll()
+    if err != nil {
+        return nil, err
+    }
+    
+    result := make([]*dtos.SupplierDTO, 0, len(suppliers))
+    for _, supplier := range suppliers {
+        if query.ActiveOnly && !supplier.IsActive() {
+            continue
+        }
+        result = append(result, dtos.NewSupplierDTO(supplier))
+    }
+    
+    sort.Slice(result, func(i, j int) bool {
+        return result[i].Name < result[j].Name
+    })
+    
+    return result, nil
+}
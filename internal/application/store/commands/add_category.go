@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// AddCategoryCommand represents request to add a section to the menu
+type AddCategoryCommand struct {
+    StoreID      string
+    Name         string
+    DisplayOrder int // Lower comes first
+}
+
+// AddCategoryHandler handles adding menu categories
+type AddCategoryHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewAddCategoryHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *AddCategoryHandler {
+    return &AddCategoryHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *AddCategoryHandler) Handle(ctx context.Context, cmd AddCategoryCommand) (*dtos.CategoryDTO, error) {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Execute domain logic
+    category, err := storeAgg.AddCategory(cmd.Name, cmd.DisplayOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewCategoryDTO(category), nil
+}
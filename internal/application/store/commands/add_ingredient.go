@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// AddIngredientCommand represents request to add an ingredient to a store's catalog
+type AddIngredientCommand struct {
+    StoreID string
+    Name    string
+    Unit    string // EACH, GRAM or MILLILITER
+}
+
+// AddIngredientHandler handles adding ingredients
+type AddIngredientHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewAddIngredientHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *AddIngredientHandler {
+    return &AddIngredientHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *AddIngredientHandler) Handle(ctx context.Context, cmd AddIngredientCommand) (*dtos.IngredientDTO, error) {
+    unit, err := store.NewUnit(cmd.Unit)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Start transaction
+    err = h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load store aggregate
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Add ingredient through aggregate
+    var ingredient *store.Ingredient
+    ingredient, err = storeAgg.AddIngredient(cmd.Name, unit)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save changes
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewIngredientDTO(ingredient, 0, 0), nil
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"math"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// AddModifierGroupCommand represents request to add a customizable choice to a product
+type AddModifierGroupCommand struct {
+    StoreID       string
+    ProductID     string
+    Name          string
+    Required      bool
+    MinSelections int
+    MaxSelections int
+    Options       []ModifierOptionRequest
+}
+
+// ModifierOptionRequest represents one option in a new modifier group
+type ModifierOptionRequest struct {
+    Name       string
+    PriceDelta float64
+}
+
+// AddModifierGroupHandler handles adding modifier groups to products
+type AddModifierGroupHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewAddModifierGroupHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *AddModifierGroupHandler {
+    return &AddModifierGroupHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *AddModifierGroupHandler) Handle(ctx context.Context, cmd AddModifierGroupCommand) (*dtos.ProductDTO, error) {
+    // Load store aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    product, err := storeAgg.GetProduct(store.ProductID(cmd.ProductID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Build the group, options are priced in the product's currency
+    group, err := store.NewModifierGroup(cmd.Name, cmd.Required, cmd.MinSelections, cmd.MaxSelections)
+    if err != nil {
+        return nil, err
+    }
+    
+    for _, option := range cmd.Options {
+        priceDelta, err := shared.NewMoney(int64(math.Round(option.PriceDelta*100)), product.Price().Currency())
+        if err != nil {
+            return nil, err
+        }
+        
+        _, err = group.AddOption(option.Name, priceDelta)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // Add group through the aggregate
+    err = storeAgg.AddModifierGroup(product.ID(), group)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    qty, _ := storeAgg.GetAvailableQuantity(product.ID())
+    return dtos.NewProductDTO(product, qty), nil
+}
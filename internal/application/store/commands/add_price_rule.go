@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// AddPriceRuleCommand represents request to set up a recurring discount window
+type AddPriceRuleCommand struct {
+    StoreID    string
+    Name       string
+    Days       []string // MONDAY to SUNDAY
+    Starts     string   // HH:MM store local time
+    Ends       string
+    PercentOff int
+    ProductIDs []string // Empty covers the whole menu
+}
+
+// AddPriceRuleHandler handles new price rules
+// WHERE: Called by marketing to run happy hours and similar time-of-day offers
+type AddPriceRuleHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewAddPriceRuleHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *AddPriceRuleHandler {
+    return &AddPriceRuleHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *AddPriceRuleHandler) Handle(ctx context.Context, cmd AddPriceRuleCommand) (*dtos.PriceRuleDTO, error) {
+    // 1. Convert command to domain value objects
+    // WHAT: Day and time parsing is shared with opening hours, so report failures as rule errors
+    days := make([]time.Weekday, len(cmd.Days))
+    for i, input := range cmd.Days {
+        day, err := store.ParseWeekday(input)
+        if err != nil {
+            return nil, fmt.Errorf("%w: unknown day %q", store.ErrInvalidPriceRule, input)
+        }
+        days[i] = day
+    }
+    
+    starts, err := store.ParseTimeOfDay(cmd.Starts)
+    if err != nil {
+        return nil, fmt.Errorf("%w: starts %q must be HH:MM", store.ErrInvalidPriceRule, cmd.Starts)
+    }
+    ends, err := store.ParseTimeOfDay(cmd.Ends)
+    if err != nil {
+        return nil, fmt.Errorf("%w: ends %q must be HH:MM", store.ErrInvalidPriceRule, cmd.Ends)
+    }
+    
+    productIDs := make([]store.ProductID, len(cmd.ProductIDs))
+    for i, productID := range cmd.ProductIDs {
+        productIDs[i] = store.ProductID(productID)
+    }
+    
+    rule, err := store.NewPriceRule(cmd.Name, days, starts, ends, cmd.PercentOff, productIDs)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Execute domain logic
+    err = storeAgg.AddPriceRule(rule)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    ruleDTO := dtos.NewPriceRuleDTO(rule)
+    return &ruleDTO, nil
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+	"math"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// AddProductCommand represents request to add a product to the menu
+type AddProductCommand struct {
+    StoreID     string
+    Name        string
+    Description string
+    Price       float64
+    Currency    string
+    TaxExempt   bool
+}
+
+// AddProductHandler handles adding products to a store
+type AddProductHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewAddProductHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *AddProductHandler {
+    return &AddProductHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *AddProductHandler) Handle(ctx context.Context, cmd AddProductCommand) (*dtos.ProductDTO, error) {
+    // Convert price to domain value object
+    priceCents := int64(math.Round(cmd.Price * 100))
+    price, err := shared.NewMoney(priceCents, cmd.Currency)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Load store aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Add product through the aggregate
+    product, err := storeAgg.AddProduct(cmd.Name, cmd.Description, price)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = storeAgg.SetProductTaxExempt(product.ID(), cmd.TaxExempt)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    // Return DTO
+    return dtos.NewProductDTO(product, 0), nil
+}
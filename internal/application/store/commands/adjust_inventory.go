@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// AdjustInventoryCommand represents request to correct stock on hand
+type AdjustInventoryCommand struct {
+    StoreID  string
+    ItemType string // PRODUCT or INGREDIENT
+    ItemID   string
+    Quantity int    // Signed, negative for losses
+    Reason   string // SPOILAGE, BREAKAGE, THEFT, COUNT_CORRECTION or OTHER
+    Note     string
+}
+
+// AdjustInventoryHandler handles stock corrections
+// WHERE: Called when staff record spoilage, breakage or theft
+type AdjustInventoryHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewAdjustInventoryHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *AdjustInventoryHandler {
+    return &AdjustInventoryHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *AdjustInventoryHandler) Handle(ctx context.Context, cmd AdjustInventoryCommand) (*dtos.InventoryAdjustmentDTO, error) {
+    // 1. Validate command
+    item, err := store.NewStockItem(cmd.ItemType, cmd.ItemID)
+    if err != nil {
+        return nil, err
+    }
+    
+    reason, err := store.NewAdjustmentReason(cmd.Reason)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Start transaction
+    err = h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // 2. Load aggregate
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Execute domain logic
+    var adjustment store.Adjustment
+    adjustment, err = storeAgg.AdjustInventory(item, cmd.Quantity, reason, cmd.Note)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Persist changes
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewInventoryAdjustmentDTO(adjustment), nil
+}
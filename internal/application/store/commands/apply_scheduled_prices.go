@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ApplyScheduledPricesCommand represents a sweep for price changes that have come due
+type ApplyScheduledPricesCommand struct {
+    Now time.Time
+}
+
+// ApplyScheduledPricesHandler makes due price changes the products' base prices
+// WHY: Orders already honour due changes, this makes menus and history catch up
+// WHERE: Run periodically by the price scheduler
+type ApplyScheduledPricesHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewApplyScheduledPricesHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *ApplyScheduledPricesHandler {
+    return &ApplyScheduledPricesHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+// Handle applies every due price change and returns how many were applied
+// WHY: Runs in a unit of work because requests change the same stores
+func (h *ApplyScheduledPricesHandler) Handle(ctx context.Context, cmd ApplyScheduledPricesCommand) (int, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return 0, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    var stores []*store.Store
+    stores, err = h.uow.StoreRepository().FindAll()
+    if err != nil {
+        return 0, err
+    }
+    
+    applied := 0
+    var events []shared.DomainEvent
+    for _, storeAgg := range stores {
+        count := storeAgg.ApplyScheduledPrices(cmd.Now)
+        if count == 0 {
+            continue
+        }
+        applied += count
+        
+        // Save changes
+        err = h.uow.StoreRepository().Save(storeAgg)
+        if err != nil {
+            return 0, err
+        }
+        events = append(events, storeAgg.PullEvents()...)
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return 0, err
+    }
+    
+    // Publish events
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return applied, nil
+}
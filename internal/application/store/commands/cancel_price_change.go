@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// CancelPriceChangeCommand represents request to drop a queued price change
+type CancelPriceChangeCommand struct {
+    StoreID   string
+    ProductID string
+    ChangeID  string
+}
+
+// CancelPriceChangeHandler handles dropping queued price changes
+type CancelPriceChangeHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewCancelPriceChangeHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *CancelPriceChangeHandler {
+    return &CancelPriceChangeHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *CancelPriceChangeHandler) Handle(ctx context.Context, cmd CancelPriceChangeCommand) error {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return err
+    }
+    
+    // 2. Execute domain logic
+    err = storeAgg.CancelScheduledPrice(store.ProductID(cmd.ProductID), cmd.ChangeID)
+    if err != nil {
+        return err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// CancelTransferCommand represents request to call off a transfer in transit
+type CancelTransferCommand struct {
+    TransferID string
+    Reason     string
+}
+
+// CancelTransferHandler handles transfer cancellation
+// WHAT: Returns the stock to the source store and clears it from the destination's incoming stock
+type CancelTransferHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewCancelTransferHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *CancelTransferHandler {
+    return &CancelTransferHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *CancelTransferHandler) Handle(ctx context.Context, cmd CancelTransferCommand) (*dtos.TransferDTO, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load transfer
+    var transfer *store.Transfer
+    transfer, err = h.uow.TransferRepository().FindByID(store.TransferID(cmd.TransferID))
+    if err != nil {
+        return nil, err
+    }
+    
+    err = transfer.Cancel(cmd.Reason)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Load both stores
+    var source, destination *store.Store
+    source, err = h.uow.StoreRepository().FindByID(transfer.SourceStoreID())
+    if err != nil {
+        return nil, err
+    }
+    
+    destination, err = h.uow.StoreRepository().FindByID(transfer.DestinationStoreID())
+    if err != nil {
+        return nil, err
+    }
+    
+    // Move the stock back
+    err = source.ReturnTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = destination.CancelIncomingTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save all three aggregates
+    err = h.uow.StoreRepository().Save(source)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = h.uow.StoreRepository().Save(destination)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = h.uow.TransferRepository().Save(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := append(transfer.PullEvents(), source.PullEvents()...)
+    events = append(events, destination.PullEvents()...)
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewTransferDTO(transfer), nil
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// CountInventoryCommand represents a stocktake result for one item
+type CountInventoryCommand struct {
+    StoreID  string
+    ItemType string // PRODUCT or INGREDIENT
+    ItemID   string
+    Counted  int
+    Note     string
+}
+
+// CountInventoryHandler handles stocktakes
+// WHAT: Sets stock on hand to the counted quantity and records the variance
+type CountInventoryHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewCountInventoryHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *CountInventoryHandler {
+    return &CountInventoryHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *CountInventoryHandler) Handle(ctx context.Context, cmd CountInventoryCommand) (*dtos.InventoryAdjustmentDTO, error) {
+    // 1. Validate command
+    item, err := store.NewStockItem(cmd.ItemType, cmd.ItemID)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Start transaction
+    err = h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // 2. Load aggregate
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Execute domain logic
+    var adjustment store.Adjustment
+    adjustment, err = storeAgg.CountInventory(item, cmd.Counted, cmd.Note)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Persist changes
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewInventoryAdjustmentDTO(adjustment), nil
+}
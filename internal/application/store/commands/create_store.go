@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// CreateStoreCommand represents request to open a new store
+type CreateStoreCommand struct {
+    Name     string
+    Location dtos.AddressDTO
+}
+
+// CreateStoreHandler handles store creation
+// WHERE: Called from presentation layer when back-office sets up a new stand
+type CreateStoreHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewCreateStoreHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *CreateStoreHandler {
+    return &CreateStoreHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *CreateStoreHandler) Handle(ctx context.Context, cmd CreateStoreCommand) (*dtos.StoreDTO, error) {
+    // Create address value object
+    location, err := shared.NewAddress(
+        cmd.Location.Street,
+        cmd.Location.City,
+        cmd.Location.State,
+        cmd.Location.ZipCode,
+        cmd.Location.Country,
+    )
+    if err != nil {
+        return nil, err
+    }
+    
+    // Create store aggregate
+    storeAgg, err := store.NewStore(cmd.Name, location)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    // Return DTO
+    return &dtos.StoreDTO{
+        ID:       string(storeAgg.ID()),
+        Name:     storeAgg.Name(),
+        Location: cmd.Location,
+    }, nil
+}
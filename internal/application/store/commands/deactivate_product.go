@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// DeactivateProductCommand represents request to take a product off the menu
+type DeactivateProductCommand struct {
+    StoreID   string
+    ProductID string
+}
+
+// DeactivateProductHandler handles taking products off the menu
+// WHERE: Called when a product is discontinued or out for the season
+type DeactivateProductHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewDeactivateProductHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *DeactivateProductHandler {
+    return &DeactivateProductHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *DeactivateProductHandler) Handle(ctx context.Context, cmd DeactivateProductCommand) error {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return err
+    }
+    
+    // 2. Execute domain logic
+    err = storeAgg.DeactivateProduct(store.ProductID(cmd.ProductID))
+    if err != nil {
+        return err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
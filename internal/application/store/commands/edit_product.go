@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// EditProductCommand represents request to change a product's menu details
+// WHAT: Replaces every field, so callers send the current values for anything they keep
+type EditProductCommand struct {
+    StoreID      string
+    ProductID    string
+    Name         string
+    Description  string
+    CategoryID   string // Empty takes the product out of its category
+    DisplayOrder int
+}
+
+// EditProductHandler handles product edits
+type EditProductHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewEditProductHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *EditProductHandler {
+    return &EditProductHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *EditProductHandler) Handle(ctx context.Context, cmd EditProductCommand) (*dtos.ProductDTO, error) {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Execute domain logic
+    productID := store.ProductID(cmd.ProductID)
+    err = storeAgg.EditProduct(productID, cmd.Name, cmd.Description, store.CategoryID(cmd.CategoryID), cmd.DisplayOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    product, _ := storeAgg.GetProduct(productID)
+    qty, _ := storeAgg.GetAvailableQuantity(productID)
+    return dtos.NewProductDTO(product, qty), nil
+}
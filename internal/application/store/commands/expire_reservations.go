@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ExpireReservationsCommand represents a sweep for lapsed stock holds
+type ExpireReservationsCommand struct {
+    Now time.Time
+}
+
+// ExpireReservationsHandler releases stock held by orders that were never started
+// WHY: Abandoned orders shouldn't keep stock off the menu
+// WHERE: Run periodically by the reservation sweeper
+type ExpireReservationsHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewExpireReservationsHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *ExpireReservationsHandler {
+    return &ExpireReservationsHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+// Handle releases every expired reservation and returns how many were released
+// WHY: Runs in a unit of work because requests change the same stores
+func (h *ExpireReservationsHandler) Handle(ctx context.Context, cmd ExpireReservationsCommand) (int, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return 0, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    var stores []*store.Store
+    stores, err = h.uow.StoreRepository().FindAll()
+    if err != nil {
+        return 0, err
+    }
+    
+    released := 0
+    var events []shared.DomainEvent
+    for _, storeAgg := range stores {
+        orderIDs := storeAgg.ExpiredReservations(cmd.Now)
+        if len(orderIDs) == 0 {
+            continue
+        }
+        
+        for _, orderID := range orderIDs {
+            err = storeAgg.ReleaseReservation(orderID, store.ReleaseReasonExpired)
+            if err != nil {
+                return 0, err
+            }
+            released++
+        }
+        
+        // Save changes
+        err = h.uow.StoreRepository().Save(storeAgg)
+        if err != nil {
+            return 0, err
+        }
+        events = append(events, storeAgg.PullEvents()...)
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return 0, err
+    }
+    
+    // Publish events, the order context cancels the abandoned orders
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return released, nil
+}
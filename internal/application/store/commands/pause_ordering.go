@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// PauseOrderingCommand represents request to stop a store taking orders for a while
+type PauseOrderingCommand struct {
+    StoreID string
+    Reason  string
+    Until   time.Time // Optional, zero pauses until resumed
+}
+
+// PauseOrderingHandler handles pausing a store
+// WHERE: Called by staff when the stand is swamped or something runs out
+type PauseOrderingHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewPauseOrderingHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *PauseOrderingHandler {
+    return &PauseOrderingHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *PauseOrderingHandler) Handle(ctx context.Context, cmd PauseOrderingCommand) (*dtos.StoreStatusDTO, error) {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Execute domain logic
+    err = storeAgg.PauseOrdering(cmd.Reason, cmd.Until)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewStoreStatusDTO(storeAgg, time.Now()), nil
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ReactivateProductCommand represents request to put a product back on the menu
+type ReactivateProductCommand struct {
+    StoreID   string
+    ProductID string
+}
+
+// ReactivateProductHandler handles putting products back on the menu
+// WHERE: Called when a seasonal product comes back
+type ReactivateProductHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewReactivateProductHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *ReactivateProductHandler {
+    return &ReactivateProductHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *ReactivateProductHandler) Handle(ctx context.Context, cmd ReactivateProductCommand) error {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return err
+    }
+    
+    // 2. Execute domain logic
+    err = storeAgg.ReactivateProduct(store.ProductID(cmd.ProductID))
+    if err != nil {
+        return err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ReceiveTransferCommand represents a transfer arriving at its destination store
+type ReceiveTransferCommand struct {
+    TransferID string
+}
+
+// ReceiveTransferHandler handles checking transferred stock in
+// WHERE: Called by staff at the destination store when the delivery arrives
+type ReceiveTransferHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewReceiveTransferHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *ReceiveTransferHandler {
+    return &ReceiveTransferHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *ReceiveTransferHandler) Handle(ctx context.Context, cmd ReceiveTransferCommand) (*dtos.TransferDTO, error) {
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load transfer
+    var transfer *store.Transfer
+    transfer, err = h.uow.TransferRepository().FindByID(store.TransferID(cmd.TransferID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Check the destination can stock every line before closing the transfer
+    var destination *store.Store
+    destination, err = h.uow.StoreRepository().FindByID(transfer.DestinationStoreID())
+    if err != nil {
+        return nil, err
+    }
+    
+    err = destination.CanReceiveTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = transfer.Receive()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Put the stock on the destination store's shelf
+    err = destination.ReceiveTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = h.uow.StoreRepository().Save(destination)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save transfer
+    err = h.uow.TransferRepository().Save(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := append(transfer.PullEvents(), destination.PullEvents()...)
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewTransferDTO(transfer), nil
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// RemovePriceRuleCommand represents request to take down a recurring discount window
+type RemovePriceRuleCommand struct {
+    StoreID string
+    RuleID  string
+}
+
+// RemovePriceRuleHandler handles taking down price rules
+type RemovePriceRuleHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewRemovePriceRuleHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *RemovePriceRuleHandler {
+    return &RemovePriceRuleHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *RemovePriceRuleHandler) Handle(ctx context.Context, cmd RemovePriceRuleCommand) error {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return err
+    }
+    
+    // 2. Execute domain logic
+    err = storeAgg.RemovePriceRule(cmd.RuleID)
+    if err != nil {
+        return err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// RestockIngredientCommand represents request to add ingredient stock
+type RestockIngredientCommand struct {
+    StoreID      string
+    IngredientID string
+    Quantity     int // In the ingredient's unit
+}
+
+// RestockIngredientHandler handles ingredient deliveries
+// WHERE: Called from presentation layer when lemons, sugar, cups etc. arrive
+type RestockIngredientHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewRestockIngredientHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *RestockIngredientHandler {
+    return &RestockIngredientHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+// Handle restocks the ingredient and returns its new level
+func (h *RestockIngredientHandler) Handle(ctx context.Context, cmd RestockIngredientCommand) (int, error) {
+    // 1. Validate command
+    if cmd.Quantity <= 0 {
+        return 0, errors.New("quantity must be positive")
+    }
+    
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return 0, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // 2. Load aggregate
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return 0, err
+    }
+    
+    // 3. Execute domain logic
+    ingredientID := store.IngredientID(cmd.IngredientID)
+    err = storeAgg.RestockIngredient(ingredientID, cmd.Quantity)
+    if err != nil {
+        return 0, err
+    }
+    
+    // 4. Persist changes
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return 0, err
+    }
+    
+    var level int
+    level, err = storeAgg.GetIngredientQuantity(ingredientID)
+    if err != nil {
+        return 0, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return 0, err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return level, nil
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ResumeOrderingCommand represents request to lift a pause
+type ResumeOrderingCommand struct {
+    StoreID string
+}
+
+// ResumeOrderingHandler handles resuming a paused store
+type ResumeOrderingHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewResumeOrderingHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *ResumeOrderingHandler {
+    return &ResumeOrderingHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *ResumeOrderingHandler) Handle(ctx context.Context, cmd ResumeOrderingCommand) (*dtos.StoreStatusDTO, error) {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Execute domain logic
+    storeAgg.ResumeOrdering()
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewStoreStatusDTO(storeAgg, time.Now()), nil
+}
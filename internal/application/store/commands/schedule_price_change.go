@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// SchedulePriceChangeCommand represents request to change a product's price at a future time
+type SchedulePriceChangeCommand struct {
+    StoreID     string
+    ProductID   string
+    NewPrice    float64
+    EffectiveAt time.Time
+}
+
+// SchedulePriceChangeHandler handles queuing future price changes
+// WHERE: Called when a menu price rise is announced ahead of time
+type SchedulePriceChangeHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewSchedulePriceChangeHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *SchedulePriceChangeHandler {
+    return &SchedulePriceChangeHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *SchedulePriceChangeHandler) Handle(ctx context.Context, cmd SchedulePriceChangeCommand) (*dtos.ScheduledPriceDTO, error) {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    product, err := storeAgg.GetProduct(store.ProductID(cmd.ProductID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Convert price to domain value object, in the product's currency
+    newPrice, err := shared.NewMoney(int64(math.Round(cmd.NewPrice*100)), product.Price().Currency())
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Execute domain logic
+    change, err := storeAgg.SchedulePriceChange(product.ID(), newPrice, cmd.EffectiveAt, time.Now())
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    changeDTO := dtos.NewScheduledPriceDTO(product.ID(), change)
+    return &changeDTO, nil
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"math"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// SetDeliveryBandsCommand represents request to replace where a store delivers
+type SetDeliveryBandsCommand struct {
+    StoreID string
+    Bands   []dtos.DeliveryBandDTO // Empty stops delivery
+}
+
+// SetDeliveryBandsHandler handles delivery area changes
+// WHERE: Called by the ops team when a stand starts delivering or changes its fees
+type SetDeliveryBandsHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewSetDeliveryBandsHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *SetDeliveryBandsHandler {
+    return &SetDeliveryBandsHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *SetDeliveryBandsHandler) Handle(ctx context.Context, cmd SetDeliveryBandsCommand) ([]dtos.DeliveryBandDTO, error) {
+    // 1. Convert command to domain value objects
+    bands := make([]store.DeliveryBand, len(cmd.Bands))
+    for i, input := range cmd.Bands {
+        fee, err := shared.NewMoney(int64(math.Round(input.Fee*100)), input.Currency)
+        if err != nil {
+            return nil, err
+        }
+        bands[i], err = store.NewDeliveryBand(input.Name, input.ZipCodes, fee)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    // 2. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Execute domain logic
+    err = storeAgg.SetDeliveryBands(bands)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    result := make([]dtos.DeliveryBandDTO, len(storeAgg.DeliveryBands()))
+    for i, band := range storeAgg.DeliveryBands() {
+        result[i] = dtos.NewDeliveryBandDTO(band)
+    }
+    return result, nil
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// SetOpeningHoursCommand represents request to replace a store's schedule
+type SetOpeningHoursCommand struct {
+    StoreID  string
+    Timezone string // IANA name, e.g. America/Los_Angeles
+    Periods  []dtos.OpeningPeriodDTO
+    Holidays []dtos.HolidayClosureDTO
+}
+
+// SetOpeningHoursHandler handles schedule changes
+// WHERE: Called by the ops team when a stand changes its hours or plans a closure
+type SetOpeningHoursHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewSetOpeningHoursHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *SetOpeningHoursHandler {
+    return &SetOpeningHoursHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *SetOpeningHoursHandler) Handle(ctx context.Context, cmd SetOpeningHoursCommand) (*dtos.OpeningHoursDTO, error) {
+    // 1. Convert command to domain value objects
+    periods := make([]store.OpeningPeriod, len(cmd.Periods))
+    for i, input := range cmd.Periods {
+        day, err := store.ParseWeekday(input.Day)
+        if err != nil {
+            return nil, err
+        }
+        opens, err := store.ParseTimeOfDay(input.Opens)
+        if err != nil {
+            return nil, err
+        }
+        closes, err := store.ParseTimeOfDay(input.Closes)
+        if err != nil {
+            return nil, err
+        }
+        periods[i], err = store.NewOpeningPeriod(day, opens, closes)
+        if err != nil {
+            return nil, err
+        }
+    }
+    
+    holidays := make([]store.HolidayClosure, len(cmd.Holidays))
+    for i, input := range cmd.Holidays {
+        holiday, err := store.NewHolidayClosure(input.Date, input.Name)
+        if err != nil {
+            return nil, err
+        }
+        holidays[i] = holiday
+    }
+    
+    hours, err := store.NewOpeningHours(cmd.Timezone, periods, holidays)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Execute domain logic
+    storeAgg.SetOpeningHours(hours)
+    
+    // 4. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewOpeningHoursDTO(storeAgg.OpeningHours()), nil
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"math"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// SetPrepTimesCommand represents request to change how long a store's orders are expected to take
+type SetPrepTimesCommand struct {
+    StoreID   string
+    PrepTimes dtos.PrepTimesDTO
+}
+
+// SetPrepTimesHandler handles prep time changes
+// WHERE: Called by the ops team when a stand's ready estimates run early or late
+type SetPrepTimesHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewSetPrepTimesHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *SetPrepTimesHandler {
+    return &SetPrepTimesHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *SetPrepTimesHandler) Handle(ctx context.Context, cmd SetPrepTimesCommand) (*dtos.PrepTimesDTO, error) {
+    // 1. Convert command to domain value objects
+    input := cmd.PrepTimes
+    largeOrderAmount, err := shared.NewMoney(int64(math.Round(input.LargeOrderAmount*100)), input.Currency)
+    if err != nil {
+        return nil, err
+    }
+    times, err := store.NewPrepTimes(
+        input.BaseMinutes,
+        input.MinutesPerItem,
+        largeOrderAmount,
+        input.LargeOrderMinutes,
+        input.MinutesPerQueued,
+    )
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Execute domain logic
+    storeAgg.SetPrepTimes(times)
+    
+    // 4. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 5. Publish domain events, open orders are re-estimated with the new figures
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    result := dtos.NewPrepTimesDTO(storeAgg.PrepTimes())
+    return &result, nil
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// SetRecipeCommand represents request to set what goes into a product or modifier option
+type SetRecipeCommand struct {
+    StoreID    string
+    ProductID  string
+    OptionID   string // Optional, sets the modifier option's recipe instead
+    Components []RecipeComponentRequest
+}
+
+// RecipeComponentRequest represents one ingredient in a recipe
+type RecipeComponentRequest struct {
+    IngredientID string
+    Quantity     int
+}
+
+// SetRecipeHandler handles recipe changes
+type SetRecipeHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewSetRecipeHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *SetRecipeHandler {
+    return &SetRecipeHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *SetRecipeHandler) Handle(ctx context.Context, cmd SetRecipeCommand) (*dtos.ProductDTO, error) {
+    // Build the recipe
+    components := make([]store.RecipeComponent, len(cmd.Components))
+    for i, request := range cmd.Components {
+        component, err := store.NewRecipeComponent(store.IngredientID(request.IngredientID), request.Quantity)
+        if err != nil {
+            return nil, err
+        }
+        components[i] = component
+    }
+    
+    recipe, err := store.NewRecipe(components...)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Start transaction
+    err = h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load store aggregate
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Set recipe through aggregate
+    productID := store.ProductID(cmd.ProductID)
+    err = storeAgg.SetRecipe(productID, cmd.OptionID, recipe)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save changes
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Build the response while the store is still ours
+    var product *store.Product
+    product, err = storeAgg.GetProduct(productID)
+    if err != nil {
+        return nil, err
+    }
+    
+    qty, _ := storeAgg.GetAvailableQuantity(productID)
+    result := dtos.NewProductDTO(product, qty)
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return result, nil
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// SetReorderPointCommand represents request to set a product's low-stock threshold
+type SetReorderPointCommand struct {
+    StoreID      string
+    ProductID    string
+    ReorderPoint int // Zero turns off low-stock alerts
+}
+
+// SetReorderPointHandler handles reorder point changes
+type SetReorderPointHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewSetReorderPointHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *SetReorderPointHandler {
+    return &SetReorderPointHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *SetReorderPointHandler) Handle(ctx context.Context, cmd SetReorderPointCommand) error {
+    // 1. Validate command
+    if cmd.ReorderPoint < 0 {
+        return errors.New("reorder point cannot be negative")
+    }
+    
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // 2. Load aggregate
+    var storeAgg *store.Store
+    storeAgg, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return err
+    }
+    
+    // 3. Execute domain logic
+    err = storeAgg.SetReorderPoint(store.ProductID(cmd.ProductID), cmd.ReorderPoint)
+    if err != nil {
+        return err
+    }
+    
+    // 4. Persist changes
+    err = h.uow.StoreRepository().Save(storeAgg)
+    if err != nil {
+        return err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return err
+    }
+    
+    // 5. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return nil
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// TransferInventoryCommand represents request to send stock to another store
+type TransferInventoryCommand struct {
+    SourceStoreID      string
+    DestinationStoreID string
+    Lines              []TransferLineInput
+    Note               string
+}
+
+// TransferLineInput is one of the source store's stock items to send
+type TransferLineInput struct {
+    ItemType string // PRODUCT or INGREDIENT
+    ItemID   string // The source store's product or ingredient ID
+    Quantity int
+}
+
+// TransferInventoryHandler handles dispatching stock between stores
+// WHY: The source loses the stock and the destination expects it in one unit of work,
+// so stock is never counted at both stores or lost between them
+type TransferInventoryHandler struct {
+    uow            interfaces.UnitOfWork
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewTransferInventoryHandler(
+    uow interfaces.UnitOfWork,
+    eventPublisher interfaces.EventPublisher,
+) *TransferInventoryHandler {
+    return &TransferInventoryHandler{
+        uow:            uow,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *TransferInventoryHandler) Handle(ctx context.Context, cmd TransferInventoryCommand) (*dtos.TransferDTO, error) {
+    // Validate command
+    items := make([]store.TransferItem, len(cmd.Lines))
+    for i, line := range cmd.Lines {
+        item, err := store.NewStockItem(line.ItemType, line.ItemID)
+        if err != nil {
+            return nil, err
+        }
+        items[i] = store.TransferItem{Item: item, Quantity: line.Quantity}
+    }
+    
+    // Start transaction
+    err := h.uow.Begin(ctx)
+    if err != nil {
+        return nil, err
+    }
+    defer func() {
+        if err != nil {
+            h.uow.Rollback()
+        }
+    }()
+    
+    // Load both stores
+    var source, destination *store.Store
+    source, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.SourceStoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    destination, err = h.uow.StoreRepository().FindByID(store.StoreID(cmd.DestinationStoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // Dispatch the transfer
+    var transfer *store.Transfer
+    transfer, err = store.NewTransfer(source, destination, items, cmd.Note)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Check both stores before either one changes
+    err = source.CanDispatchTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = destination.CanExpectTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = source.DispatchTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = destination.ExpectTransfer(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Save all three aggregates
+    err = h.uow.StoreRepository().Save(source)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = h.uow.StoreRepository().Save(destination)
+    if err != nil {
+        return nil, err
+    }
+    
+    err = h.uow.TransferRepository().Save(transfer)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Commit
+    err = h.uow.Commit()
+    if err != nil {
+        return nil, err
+    }
+    
+    // Publish events
+    events := append(transfer.PullEvents(), source.PullEvents()...)
+    events = append(events, destination.PullEvents()...)
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewTransferDTO(transfer), nil
+}
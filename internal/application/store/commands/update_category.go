@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// UpdateCategoryCommand represents request to rename or move a menu section
+type UpdateCategoryCommand struct {
+    StoreID      string
+    CategoryID   string
+    Name         string
+    DisplayOrder int
+}
+
+// UpdateCategoryHandler handles menu category changes
+type UpdateCategoryHandler struct {
+    storeRepo      store.StoreRepository
+    eventPublisher interfaces.EventPublisher
+}
+
+func NewUpdateCategoryHandler(
+    storeRepo store.StoreRepository,
+    eventPublisher interfaces.EventPublisher,
+) *UpdateCategoryHandler {
+    return &UpdateCategoryHandler{
+        storeRepo:      storeRepo,
+        eventPublisher: eventPublisher,
+    }
+}
+
+func (h *UpdateCategoryHandler) Handle(ctx context.Context, cmd UpdateCategoryCommand) (*dtos.CategoryDTO, error) {
+    // 1. Load aggregate
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(cmd.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // 2. Execute domain logic
+    category, err := storeAgg.UpdateCategory(store.CategoryID(cmd.CategoryID), cmd.Name, cmd.DisplayOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 3. Persist changes
+    err = h.storeRepo.Save(storeAgg)
+    if err != nil {
+        return nil, err
+    }
+    
+    // 4. Publish domain events
+    events := storeAgg.PullEvents()
+    if len(events) > 0 {
+        h.eventPublisher.Publish(ctx, events...)
+    }
+    
+    return dtos.NewCategoryDTO(category), nil
+}
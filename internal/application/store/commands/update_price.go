@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"math"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
@@ -34,7 +35,7 @@ func NewUpdatePriceHandler(
 
 func (h *UpdatePriceHandler) Handle(ctx context.Context, cmd UpdatePriceCommand) error {
     // Convert price to domain value object
-    priceCents := int64(cmd.NewPrice * 100)
+    priceCents := int64(math.Round(cmd.NewPrice * 100))
     newPrice, err := shared.NewMoney(priceCents, cmd.Currency)
     if err != nil {
         return err
@@ -46,13 +47,8 @@ func (h *UpdatePriceHandler) Handle(ctx context.Context, cmd UpdatePriceCommand)
         return err
     }
     
-    // Get product and update price
-    product, err := storeAgg.GetProduct(store.ProductID(cmd.ProductID))
-    if err != nil {
-        return err
-    }
-    
-    err = product.UpdatePrice(newPrice)
+    // Update price, recorded in the product's price history
+    err = storeAgg.UpdateProductPrice(store.ProductID(cmd.ProductID), newPrice)
     if err != nil {
         return err
     }
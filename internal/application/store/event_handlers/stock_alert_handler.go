@@ -0,0 +1,51 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// StockAlertHandler keeps the stock alert read model up to date
+// WHY: Staff should see what needs reordering before orders start failing
+type StockAlertHandler struct {
+    alertStore interfaces.StockAlertStore
+}
+
+func NewStockAlertHandler(alertStore interfaces.StockAlertStore) *StockAlertHandler {
+    return &StockAlertHandler{alertStore: alertStore}
+}
+
+// Handle processes the event
+// WHERE: Registered with event bus to handle inventory.low_stock, inventory.out_of_stock,
+// inventory.stock_replenished and inventory.stock_alert_cleared events
+func (h *StockAlertHandler) Handle(ctx context.Context, event shared.DomainEvent) error {
+    var alert store.StockAlert
+    var level store.StockLevel
+    
+    switch e := event.(type) {
+    case store.LowStockEvent:
+        alert, level = e.StockAlert, store.StockLevelLow
+    case store.OutOfStockEvent:
+        alert, level = e.StockAlert, store.StockLevelOutOfStock
+    case store.StockReplenishedEvent:
+        alert, level = e.StockAlert, store.StockLevelOK
+    case store.StockAlertClearedEvent:
+        alert, level = e.StockAlert, store.StockLevelOK
+    default:
+        return nil // Not our event
+    }
+    
+    return h.alertStore.Record(dtos.StockAlertDTO{
+        StoreID:      alert.StoreID,
+        ProductID:    alert.ProductID,
+        ProductName:  alert.ProductName,
+        Level:        string(level),
+        Available:    alert.Available,
+        ReorderPoint: alert.ReorderPoint,
+        RaisedAt:     event.OccurredAt(),
+    })
+}
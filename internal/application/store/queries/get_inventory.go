@@ -2,6 +2,7 @@ package queries
 
 import (
 	"context"
+	"sort"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
@@ -22,8 +23,10 @@ func NewGetInventoryHandler(storeRepo store.StoreRepository) *GetInventoryHandle
     return &GetInventoryHandler{storeRepo: storeRepo}
 }
 
-// Handle returns inventory for all products in store
-func (h *GetInventoryHandler) Handle(ctx context.Context, query GetInventoryQuery) ([]dtos.ProductDTO, error) {
+// Handle returns inventory for all products and ingredients in store
+// WHAT: Made-to-order products report how many can still be made from ingredient stock,
+// stock held for open orders and stock in transit are reported separately
+func (h *GetInventoryHandler) Handle(ctx context.Context, query GetInventoryQuery) (*dtos.InventoryDTO, error) {
     // Load store
     storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
     if err != nil {
@@ -31,23 +34,42 @@ func (h *GetInventoryHandler) Handle(ctx context.Context, query GetInventoryQuer
     }
     
     // Convert to DTOs
-    var products []dtos.ProductDTO
+    inventory := &dtos.InventoryDTO{}
     
-
     // Iterate through all products and get their inventory
     for productID, product := range storeAgg.Products() {
         qty, _ := storeAgg.GetAvailableQuantity(productID)
-
-        products = append(products, dtos.ProductDTO{
-            ID: string(product.ID()),
-            Name: string(product.Name()),
-            Description: product.Description(),
-            Price: float64(product.Price().Amount()) / 100,
-            Currency: product.Price().Currency(),
-            IsActive: product.IsActive(),
-            Quantity: qty,
-        })
+    
+        productDTO := dtos.NewProductDTO(product, qty)
+        productDTO.Reserved = storeAgg.GetReservedQuantity(productID)
+        productDTO.Incoming = storeAgg.GetIncomingQuantity(store.ProductStockItem(productID))
+        productDTO.ReorderPoint = storeAgg.GetReorderPoint(productID)
+        level, _ := storeAgg.GetStockLevel(productID)
+        productDTO.StockLevel = string(level)
+        inventory.Products = append(inventory.Products, *productDTO)
     }
     
-    return products, nil
+    // Ingredient levels
+    for ingredientID, ingredient := range storeAgg.Ingredients() {
+        qty, _ := storeAgg.GetIngredientQuantity(ingredientID)
+        reserved := storeAgg.GetReservedIngredientQuantity(ingredientID)
+        
+        ingredientDTO := dtos.NewIngredientDTO(ingredient, qty, reserved)
+        ingredientDTO.Incoming = storeAgg.GetIncomingQuantity(store.IngredientStockItem(ingredientID))
+        inventory.Ingredients = append(inventory.Ingredients, *ingredientDTO)
+    }
+    
+    // Map iteration order is random, keep the listing stable for clients
+    sort.Slice(inventory.Products, func(i, j int) bool {
+        a, b := inventory.Products[i], inventory.Products[j]
+        if a.Name != b.Name {
+            return a.Name < b.Name
+        }
+        return a.ID < b.ID // A deactivated product can share its name with an active one
+    })
+    sort.Slice(inventory.Ingredients, func(i, j int) bool {
+        return inventory.Ingredients[i].Name < inventory.Ingredients[j].Name
+    })
+    
+    return inventory, nil
 }
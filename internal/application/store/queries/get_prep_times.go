@@ -0,0 +1,33 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// GetPrepTimesQuery represents request for a store's prep times
+type GetPrepTimesQuery struct {
+    StoreID string
+}
+
+// GetPrepTimesHandler handles prep time lookups
+type GetPrepTimesHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewGetPrepTimesHandler(storeRepo store.StoreRepository) *GetPrepTimesHandler {
+    return &GetPrepTimesHandler{storeRepo: storeRepo}
+}
+
+func (h *GetPrepTimesHandler) Handle(ctx context.Context, query GetPrepTimesQuery) (*dtos.PrepTimesDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    times := dtos.NewPrepTimesDTO(storeAgg.PrepTimes())
+    return &times, nil
+}
@@ -0,0 +1,40 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// GetPriceHistoryQuery represents request for a product's price record
+type GetPriceHistoryQuery struct {
+    StoreID   string
+    ProductID string
+}
+
+// GetPriceHistoryHandler handles price history queries
+// WHERE: Used by finance to see what a product cost when, and what changes are coming
+type GetPriceHistoryHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewGetPriceHistoryHandler(storeRepo store.StoreRepository) *GetPriceHistoryHandler {
+    return &GetPriceHistoryHandler{storeRepo: storeRepo}
+}
+
+func (h *GetPriceHistoryHandler) Handle(ctx context.Context, query GetPriceHistoryQuery) (*dtos.PriceHistoryDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    product, err := storeAgg.GetProduct(store.ProductID(query.ProductID))
+    if err != nil {
+        return nil, err
+    }
+    
+    return dtos.NewPriceHistoryDTO(storeAgg, product, time.Now())
+}
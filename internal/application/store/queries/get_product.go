@@ -9,8 +9,9 @@ import (
 
 // GetProductQuery represents request for product details
 type GetProductQuery struct {
-    StoreID   string
-    ProductID string
+    StoreID         string
+    ProductID       string
+    IncludeInactive bool // Staff views only, deactivated products are not found otherwise
 }
 
 // GetProductHandler handles product queries
@@ -34,18 +35,13 @@ func (h *GetProductHandler) Handle(ctx context.Context, query GetProductQuery) (
     if err != nil {
         return nil, err
     }
+    if !product.IsActive() && !query.IncludeInactive {
+        return nil, store.ErrProductNotFound
+    }
     
     // Get quantity
     qty, _ := storeAgg.GetAvailableQuantity(product.ID())
     
     // Convert to DTO
-    return &dtos.ProductDTO{
-        ID:          string(product.ID()),
-        Name:        string(product.Name()),
-        Description: product.Description(),
-        Price:       float64(product.Price().Amount()) / 100,
-        Currency:    product.Price().Currency(),
-        IsActive:    product.IsActive(),
-        Quantity:    qty,
-    }, nil
+    return dtos.NewProductDTO(product, qty), nil
 }
@@ -0,0 +1,40 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// GetStoreStatusQuery represents request for whether a store is taking orders
+type GetStoreStatusQuery struct {
+    StoreID string
+    At      time.Time // Optional, zero means now
+}
+
+// GetStoreStatusHandler handles store status queries
+// WHERE: Used by ordering apps to grey out closed stands
+type GetStoreStatusHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewGetStoreStatusHandler(storeRepo store.StoreRepository) *GetStoreStatusHandler {
+    return &GetStoreStatusHandler{storeRepo: storeRepo}
+}
+
+func (h *GetStoreStatusHandler) Handle(ctx context.Context, query GetStoreStatusQuery) (*dtos.StoreStatusDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    at := query.At
+    if at.IsZero() {
+        at = time.Now()
+    }
+    
+    return dtos.NewStoreStatusDTO(storeAgg, at), nil
+}
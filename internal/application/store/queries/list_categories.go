@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListCategoriesQuery represents request for a store's menu sections
+type ListCategoriesQuery struct {
+    StoreID string
+}
+
+// ListCategoriesHandler handles category listing
+type ListCategoriesHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewListCategoriesHandler(storeRepo store.StoreRepository) *ListCategoriesHandler {
+    return &ListCategoriesHandler{storeRepo: storeRepo}
+}
+
+// Handle returns the store's categories in display order
+func (h *ListCategoriesHandler) Handle(ctx context.Context, query ListCategoriesQuery) ([]dtos.CategoryDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    categories := make([]dtos.CategoryDTO, 0, len(storeAgg.Categories()))
+    for _, category := range storeAgg.Categories() {
+        categories = append(categories, *dtos.NewCategoryDTO(category))
+    }
+    
+    sort.Slice(categories, func(i, j int) bool {
+        if categories[i].DisplayOrder != categories[j].DisplayOrder {
+            return categories[i].DisplayOrder < categories[j].DisplayOrder
+        }
+        return categories[i].Name < categories[j].Name
+    })
+    
+    return categories, nil
+}
@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListDeliveryBandsQuery represents request for where a store delivers
+type ListDeliveryBandsQuery struct {
+    StoreID string
+}
+
+// ListDeliveryBandsHandler handles delivery band listing
+type ListDeliveryBandsHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewListDeliveryBandsHandler(storeRepo store.StoreRepository) *ListDeliveryBandsHandler {
+    return &ListDeliveryBandsHandler{storeRepo: storeRepo}
+}
+
+func (h *ListDeliveryBandsHandler) Handle(ctx context.Context, query ListDeliveryBandsQuery) ([]dtos.DeliveryBandDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    bands := make([]dtos.DeliveryBandDTO, len(storeAgg.DeliveryBands()))
+    for i, band := range storeAgg.DeliveryBands() {
+        bands[i] = dtos.NewDeliveryBandDTO(band)
+    }
+    
+    return bands, nil
+}
@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListInventoryAdjustmentsQuery represents request for a store's adjustment history
+type ListInventoryAdjustmentsQuery struct {
+    StoreID string
+    ItemID  string // Optional, only this product or ingredient
+}
+
+// ListInventoryAdjustmentsHandler handles adjustment history queries
+type ListInventoryAdjustmentsHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewListInventoryAdjustmentsHandler(storeRepo store.StoreRepository) *ListInventoryAdjustmentsHandler {
+    return &ListInventoryAdjustmentsHandler{storeRepo: storeRepo}
+}
+
+// Handle returns adjustments newest first
+func (h *ListInventoryAdjustmentsHandler) Handle(ctx context.Context, query ListInventoryAdjustmentsQuery) ([]dtos.InventoryAdjustmentDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    adjustments := storeAgg.Adjustments()
+    
+    // Convert to DTOs
+    var result []dtos.InventoryAdjustmentDTO
+    for i := len(adjustments) - 1; i >= 0; i-- {
+        if query.ItemID != "" && adjustments[i].Item().ID() != query.ItemID {
+            continue
+        }
+        result = append(result, *dtos.NewInventoryAdjustmentDTO(adjustments[i]))
+    }
+    
+    return result, nil
+}
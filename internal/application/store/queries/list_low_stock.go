@@ -0,0 +1,28 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+)
+
+// ListLowStockQuery represents request for products that need reordering
+type ListLowStockQuery struct {
+    StoreID string // Optional, all stores when empty
+}
+
+// ListLowStockHandler handles low-stock queries
+// WHY: Reads the alert read model instead of loading every store
+type ListLowStockHandler struct {
+    alertStore interfaces.StockAlertStore
+}
+
+func NewListLowStockHandler(alertStore interfaces.StockAlertStore) *ListLowStockHandler {
+    return &ListLowStockHandler{alertStore: alertStore}
+}
+
+// Handle returns products that are low on or out of stock
+func (h *ListLowStockHandler) Handle(ctx context.Context, query ListLowStockQuery) ([]dtos.StockAlertDTO, error) {
+    return h.alertStore.ListActive(query.StoreID)
+}
@@ -0,0 +1,41 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListOpenTransfersQuery represents request for transfers still in transit
+type ListOpenTransfersQuery struct {
+    StoreID string // Transfers sent from or to this store
+}
+
+// ListOpenTransfersHandler handles open transfer queries
+// WHERE: Used by staff to see what is on its way in or out of their stand
+type ListOpenTransfersHandler struct {
+    transferRepo store.TransferRepository
+}
+
+func NewListOpenTransfersHandler(transferRepo store.TransferRepository) *ListOpenTransfersHandler {
+    return &ListOpenTransfersHandler{transferRepo: transferRepo}
+}
+
+// Handle returns open transfers oldest first
+func (h *ListOpenTransfersHandler) Handle(ctx context.Context, query ListOpenTransfersQuery) ([]*dtos.TransferDTO, error) {
+    transfers, err := h.transferRepo.FindByStoreID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    result := make([]*dtos.TransferDTO, 0, len(transfers))
+    for _, transfer := range transfers {
+        if !transfer.IsOpen() {
+            continue
+        }
+        result = append(result, dtos.NewTransferDTO(transfer))
+    }
+    
+    return result, nil
+}
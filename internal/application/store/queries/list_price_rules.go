@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListPriceRulesQuery represents request for a store's recurring discounts
+type ListPriceRulesQuery struct {
+    StoreID string
+}
+
+// ListPriceRulesHandler handles price rule listing
+type ListPriceRulesHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewListPriceRulesHandler(storeRepo store.StoreRepository) *ListPriceRulesHandler {
+    return &ListPriceRulesHandler{storeRepo: storeRepo}
+}
+
+func (h *ListPriceRulesHandler) Handle(ctx context.Context, query ListPriceRulesQuery) ([]dtos.PriceRuleDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    rules := make([]dtos.PriceRuleDTO, len(storeAgg.PriceRules()))
+    for i, rule := range storeAgg.PriceRules() {
+        rules[i] = dtos.NewPriceRuleDTO(rule)
+    }
+    
+    return rules, nil
+}
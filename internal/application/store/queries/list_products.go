@@ -0,0 +1,53 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ListProductsQuery represents request for a store's menu
+type ListProductsQuery struct {
+    StoreID         string
+    CategoryID      string // Optional, only products in this category
+    IncludeInactive bool   // Staff views only, customers never see deactivated products
+}
+
+// ListProductsHandler handles product listing
+type ListProductsHandler struct {
+    storeRepo store.StoreRepository
+}
+
+func NewListProductsHandler(storeRepo store.StoreRepository) *ListProductsHandler {
+    return &ListProductsHandler{storeRepo: storeRepo}
+}
+
+// Handle returns the store's products in menu order
+func (h *ListProductsHandler) Handle(ctx context.Context, query ListProductsQuery) ([]dtos.ProductDTO, error) {
+    // Load store
+    storeAgg, err := h.storeRepo.FindByID(store.StoreID(query.StoreID))
+    if err != nil {
+        return nil, err
+    }
+    
+    // An unknown category is a caller mistake, not an empty menu
+    if query.CategoryID != "" {
+        if _, err := storeAgg.GetCategory(store.CategoryID(query.CategoryID)); err != nil {
+            return nil, err
+        }
+    }
+    
+    // Convert to DTOs
+    products := make([]dtos.ProductDTO, 0, len(storeAgg.Products()))
+    for _, product := range storeAgg.Menu(query.IncludeInactive) {
+        if query.CategoryID != "" && string(product.CategoryID()) != query.CategoryID {
+            continue
+        }
+        
+        qty, _ := storeAgg.GetAvailableQuantity(product.ID())
+        products = append(products, *dtos.NewProductDTO(product, qty))
+    }
+    
+    return products, nil
+}
@@ -62,7 +62,7 @@ func NewCustomer(email string, firstName string, lastName string) (*Customer, er
 // WHY: Contact information can change over time
 func (c *Customer) UpdateContactInfo(phone string, address shared.Address) error {
     if !c.isActive {
-        return errors.New("cannot update inactive customer")
+        return ErrCustomerInactive
     }
     
     phoneVO, err := NewPhoneNumber(phone)
@@ -92,7 +92,7 @@ func (c *Customer) AddLoyaltyPoints(points int) error {
     }
     
     if !c.isActive {
-        return errors.New("cannot add points to inactive customer")
+        return ErrCustomerInactive
     }
     
     c.loyaltyPoints += points
@@ -121,8 +121,12 @@ func (c *Customer) RedeemPoints(points int) error {
         return errors.New("points must be positive")
     }
     
+    if !c.isActive {
+        return ErrCustomerInactive
+    }
+    
     if c.loyaltyPoints < points {
-        return errors.New("insufficient loyalty points")
+        return ErrInsufficientPoints
     }
     
     c.loyaltyPoints -= points
@@ -137,6 +141,54 @@ func (c *Customer) RedeemPoints(points int) error {
     return nil
 }
 
+// RefundPoints gives back points that were redeemed on a cancelled or refunded purchase
+// WHY: Points spent on an order the customer never received, or handed back, must be returned
+// WHAT: Tier is left untouched, mirroring RedeemPoints which never downgrades
+func (c *Customer) RefundPoints(points int) error {
+    if points <= 0 {
+        return errors.New("points must be positive")
+    }
+    
+    c.loyaltyPoints += points
+    
+    c.Raise(PointsRefundedEvent{
+        BaseEvent:      shared.NewBaseEvent(),
+        CustomerID:     string(c.id),
+        PointsRefunded: points,
+        TotalPoints:    c.loyaltyPoints,
+    })
+    
+    return nil
+}
+
+// RevokeLoyaltyPoints takes back points earned on a purchase that was refunded
+// WHY: Points are earned on money paid, refunded money should not keep earning
+// WHAT: Points already spent cannot be taken back, so the balance stops at zero.
+//       Tier is left untouched, mirroring RedeemPoints which never downgrades.
+func (c *Customer) RevokeLoyaltyPoints(points int) error {
+    if points <= 0 {
+        return errors.New("points must be positive")
+    }
+    
+    if points > c.loyaltyPoints {
+        points = c.loyaltyPoints
+    }
+    if points == 0 {
+        return nil
+    }
+    
+    c.loyaltyPoints -= points
+    
+    c.Raise(PointsRevokedEvent{
+        BaseEvent:     shared.NewBaseEvent(),
+        CustomerID:    string(c.id),
+        PointsRevoked: points,
+        TotalPoints:   c.loyaltyPoints,
+    })
+    
+    return nil
+}
+
 // updateCustomerType updates tier based on points
 // WHAT: Business rule for customer tier progression
 func (c *Customer) updateCustomerType() {
@@ -151,13 +203,20 @@ func (c *Customer) updateCustomerType() {
 }
 
 // Deactivate marks customer as inactive
-func (c *Customer) Deactivate() {
+// WHAT: Soft delete - history is kept, but the customer can no longer order
+func (c *Customer) Deactivate() error {
+    if !c.isActive {
+        return ErrCustomerInactive
+    }
+    
     c.isActive = false
     
     c.Raise(CustomerDeactivatedEvent{
         BaseEvent:  shared.NewBaseEvent(),
         CustomerID: string(c.id),
     })
+    
+    return nil
 }
 
 // GetDiscountRate returns discount based on customer type
@@ -0,0 +1,12 @@
+package customer
+
+import "errors"
+
+// Domain-specific errors
+// WHY: Domain errors express business rule violations
+var (
+    ErrCustomerNotFound    = errors.New("customer not found")
+    ErrCustomerInactive    = errors.New("customer is not active")
+    ErrInsufficientPoints  = errors.New("insufficient loyalty points")
+    ErrInvalidCustomerType = errors.New("invalid customer type")
+)
@@ -52,6 +52,30 @@ func (e PointsRedeemedEvent) EventName() string     { return "customer.points_re
 func (e PointsRedeemedEvent) AggregateID() string   { return e.CustomerID }
 func (e PointsRedeemedEvent) AggregateType() string { return "customer" }
 
+// PointsRefundedEvent tracks loyalty points returned to a customer
+type PointsRefundedEvent struct {
+    shared.BaseEvent
+    CustomerID     string `json:"customer_id"`
+    PointsRefunded int    `json:"points_refunded"`
+    TotalPoints    int    `json:"total_points"`
+}
+
+func (e PointsRefundedEvent) EventName() string     { return "customer.points_refunded" }
+func (e PointsRefundedEvent) AggregateID() string   { return e.CustomerID }
+func (e PointsRefundedEvent) AggregateType() string { return "customer" }
+
+// PointsRevokedEvent tracks loyalty points taken back after a refund
+type PointsRevokedEvent struct {
+    shared.BaseEvent
+    CustomerID    string `json:"customer_id"`
+    PointsRevoked int    `json:"points_revoked"`
+    TotalPoints   int    `json:"total_points"`
+}
+
+func (e PointsRevokedEvent) EventName() string     { return "customer.points_revoked" }
+func (e PointsRevokedEvent) AggregateID() string   { return e.CustomerID }
+func (e PointsRevokedEvent) AggregateType() string { return "customer" }
+
 // CustomerDeactivatedEvent when customer is deactivated
 type CustomerDeactivatedEvent struct {
     shared.BaseEvent
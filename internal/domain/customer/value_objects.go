@@ -4,6 +4,7 @@ import (
     "errors"
     "regexp"
     "github.com/google/uuid"
+    "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
 )
 
 // CustomerID uniquely identifies a customer
@@ -45,3 +46,44 @@ const (
     CustomerTypePremium  CustomerType = "PREMIUM"
     CustomerTypeVIP      CustomerType = "VIP"
 )
+
+// NewCustomerType validates a customer tier name
+// WHERE: Used when filtering customers by tier from external requests
+func NewCustomerType(customerType string) (CustomerType, error) {
+    switch CustomerType(customerType) {
+    case CustomerTypeRegular, CustomerTypePremium, CustomerTypeVIP:
+        return CustomerType(customerType), nil
+    default:
+        return "", ErrInvalidCustomerType
+    }
+}
+
+// PointsEarnedFor returns the loyalty points earned by spending an amount
+// WHAT: Business rule - 1 point per whole dollar (or other major unit)
+func PointsEarnedFor(spend shared.Money) int {
+    if spend.Amount() <= 0 {
+        return 0
+    }
+    return int(spend.Amount() / 100)
+}
+
+// PointsExchangeRate converts loyalty points into money
+// WHY: Points are worth a fixed amount at checkout, set by the business
+type PointsExchangeRate struct {
+    centsPerPoint int64
+}
+
+func NewPointsExchangeRate(centsPerPoint int64) (PointsExchangeRate, error) {
+    if centsPerPoint <= 0 {
+        return PointsExchangeRate{}, errors.New("points exchange rate must be positive")
+    }
+    return PointsExchangeRate{centsPerPoint: centsPerPoint}, nil
+}
+
+// Value returns what the given number of points is worth in a currency
+func (r PointsExchangeRate) Value(points int, currency string) (shared.Money, error) {
+    return shared.NewMoney(int64(points)*r.centsPerPoint, currency)
+}
+
+// CentsPerPoint returns the configured rate
+func (r PointsExchangeRate) CentsPerPoint() int64 { return r.centsPerPoint }
@@ -0,0 +1,67 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// DiscountType identifies why an order was discounted
+type DiscountType string
+
+const (
+    DiscountTypeLoyaltyTier DiscountType = "LOYALTY_TIER"
+    DiscountTypePromotion   DiscountType = "PROMOTION"
+)
+
+// Discount is a value object describing a price reduction on an order
+// WHY: Keeps each reduction visible on the order instead of silently lowering the total
+// WHAT: Percentage discounts are re-evaluated whenever the subtotal changes,
+//       fixed discounts keep their amount
+type Discount struct {
+    discountType DiscountType
+    description  string
+    rate         shared.PartsPerMillion
+    amount       shared.Money
+}
+
+// NewPercentageDiscount creates a discount worth a fraction of the subtotal
+func NewPercentageDiscount(discountType DiscountType, description string, rate float64) (Discount, error) {
+    parts := shared.PartsPerMillionFrom(rate)
+    if parts <= 0 || parts >= shared.PartsPerMillionFrom(1) {
+        return Discount{}, fmt.Errorf("discount rate must be between 0 and 1, got %v", rate)
+    }
+    
+    return Discount{
+        discountType: discountType,
+        description:  description,
+        rate:         parts,
+    }, nil
+}
+
+// NewFixedDiscount creates a discount worth a set amount
+func NewFixedDiscount(discountType DiscountType, description string, amount shared.Money) (Discount, error) {
+    if amount.Amount() <= 0 {
+        return Discount{}, fmt.Errorf("discount amount must be positive, got %s", amount)
+    }
+    
+    return Discount{
+        discountType: discountType,
+        description:  description,
+        amount:       amount,
+    }, nil
+}
+
+// applyTo returns the discount with its amount computed against a subtotal
+func (d Discount) applyTo(subtotal shared.Money) Discount {
+    if d.rate > 0 {
+        d.amount = subtotal.Percentage(d.rate)
+    }
+    return d
+}
+
+// Getters for encapsulation
+func (d Discount) Type() DiscountType   { return d.discountType }
+func (d Discount) Description() string  { return d.description }
+func (d Discount) Rate() float64        { return d.rate.Fraction() }
+func (d Discount) Amount() shared.Money { return d.amount }
@@ -0,0 +1,21 @@
+package order
+
+import "errors"
+
+// Domain-specific errors
+// WHY: Lets callers distinguish missing orders from illegal state changes
+var (
+    ErrOrderNotFound           = errors.New("order not found")
+    ErrInvalidStatusTransition = errors.New("invalid order status transition")
+    ErrPointsExceedTotal       = errors.New("points credit exceeds order total")
+    ErrPromotionAlreadyApplied = errors.New("order already has a promo code")
+    ErrOrderNotRefundable      = errors.New("only completed or delivered orders can be refunded")
+    ErrRefundExceedsPaid       = errors.New("refund exceeds amount paid")
+    ErrRefundExceedsQuantity   = errors.New("refund exceeds quantity ordered")
+    ErrInvalidPickupTime       = errors.New("invalid pickup time")
+    ErrUnknownOrderStatus      = errors.New("unknown order status")
+    ErrUnknownFulfillmentType  = errors.New("unknown fulfillment type")
+    ErrInvalidDeliveryAddress  = errors.New("invalid delivery address")
+    ErrNotDeliveryOrder        = errors.New("order is not for delivery")
+    ErrCourierNotAssigned      = errors.New("no courier assigned to order")
+)
@@ -8,11 +8,29 @@ import (
 
 // OrderItemSnapshot is an immutable representation of an order item for events
 type OrderItemSnapshot struct {
-    ProductID string       `json:"product_id"`
-    Name      string       `json:"name"`
-    Quantity  int          `json:"quantity"`
-    UnitPrice shared.Money `json:"unit_price"`
-    Total     shared.Money `json:"total"`
+    ProductID string             `json:"product_id"`
+    Name      string             `json:"name"`
+    Quantity  int                `json:"quantity"`
+    UnitPrice shared.Money       `json:"unit_price"`
+    Total     shared.Money       `json:"total"`
+    TaxExempt bool               `json:"tax_exempt"`
+    Modifiers []ModifierSnapshot `json:"modifiers,omitempty"`
+}
+
+// ModifierSnapshot is an immutable representation of a chosen modifier for events
+type ModifierSnapshot struct {
+    GroupName  string       `json:"group_name"`
+    OptionID   string       `json:"option_id"`
+    OptionName string       `json:"option_name"`
+    PriceDelta shared.Money `json:"price_delta"`
+}
+
+// TaxLineSnapshot is an immutable representation of a tax line for events
+type TaxLineSnapshot struct {
+    Name          string       `json:"name"`
+    Rate          float64      `json:"rate"`
+    TaxableAmount shared.Money `json:"taxable_amount"`
+    Amount        shared.Money `json:"amount"`
 }
 
 // OrderCreatedEvent is raised when a new order is created
@@ -30,29 +48,86 @@ func (e OrderCreatedEvent) AggregateType() string { return "order" }
 // OrderConfirmedEvent contains full order details for downstream processing
 type OrderConfirmedEvent struct {
     shared.BaseEvent
-    OrderID     string              `json:"order_id"`
-    CustomerID  string              `json:"customer_id"`
-    StoreID     string              `json:"store_id"`
-    TotalAmount shared.Money        `json:"total_amount"`
-    Items       []OrderItemSnapshot `json:"items"`
+    OrderID        string              `json:"order_id"`
+    CustomerID     string              `json:"customer_id"`
+    StoreID        string              `json:"store_id"`
+    Subtotal       shared.Money        `json:"subtotal"`
+    DiscountAmount shared.Money        `json:"discount_amount"`
+    PromoCode      string              `json:"promo_code,omitempty"`
+    PointsRedeemed int                 `json:"points_redeemed"`
+    TaxAmount      shared.Money        `json:"tax_amount"`
+    TaxLines       []TaxLineSnapshot   `json:"tax_lines"`
+    PointsCredit   shared.Money        `json:"points_credit"`
+    DeliveryFee    shared.Money        `json:"delivery_fee"`
+    TotalAmount    shared.Money        `json:"total_amount"`
+    Items          []OrderItemSnapshot `json:"items"`
 }
 
 func (e OrderConfirmedEvent) EventName() string     { return "order.confirmed" }
 func (e OrderConfirmedEvent) AggregateID() string   { return e.OrderID }
 func (e OrderConfirmedEvent) AggregateType() string { return "order" }
 
+// OrderScheduledEvent is raised when a pre-order is accepted for a later pickup
+type OrderScheduledEvent struct {
+    shared.BaseEvent
+    OrderID    string    `json:"order_id"`
+    CustomerID string    `json:"customer_id"`
+    StoreID    string    `json:"store_id"`
+    PickupAt   time.Time `json:"pickup_at"`
+    ReleaseAt  time.Time `json:"release_at"`
+}
+
+func (e OrderScheduledEvent) EventName() string     { return "order.scheduled" }
+func (e OrderScheduledEvent) AggregateID() string   { return e.OrderID }
+func (e OrderScheduledEvent) AggregateType() string { return "order" }
+
 // OrderCancelledEvent is raised when order is cancelled
 type OrderCancelledEvent struct {
     shared.BaseEvent
-    OrderID    string `json:"order_id"`
-    CustomerID string `json:"customer_id"`
-    Reason     string `json:"reason"`
+    OrderID        string       `json:"order_id"`
+    CustomerID     string       `json:"customer_id"`
+    Reason         string       `json:"reason"`
+    PointsRedeemed int          `json:"points_redeemed"`
+    Confirmed      bool         `json:"confirmed"` // Loyalty points were earned when it was confirmed
+    TotalAmount    shared.Money `json:"total_amount"`
+    TaxAmount      shared.Money `json:"tax_amount"`
+    DeliveryFee    shared.Money `json:"delivery_fee"`
 }
 
 func (e OrderCancelledEvent) EventName() string     { return "order.cancelled" }
 func (e OrderCancelledEvent) AggregateID() string   { return e.OrderID }
 func (e OrderCancelledEvent) AggregateType() string { return "order" }
 
+// RefundLineSnapshot is an immutable representation of a refund line for events
+type RefundLineSnapshot struct {
+    ItemID    string       `json:"item_id"`
+    ProductID string       `json:"product_id"`
+    Quantity  int          `json:"quantity"`
+    Amount    shared.Money `json:"amount"`
+}
+
+// OrderRefundedEvent is raised when money is given back on a completed order
+// WHAT: Carries the order totals so consumers can prorate without loading the order
+type OrderRefundedEvent struct {
+    shared.BaseEvent
+    OrderID        string               `json:"order_id"`
+    CustomerID     string               `json:"customer_id"`
+    StoreID        string               `json:"store_id"`
+    RefundID       string               `json:"refund_id"`
+    Reason         string               `json:"reason"`
+    Amount         shared.Money         `json:"amount"`
+    Points         int                  `json:"points"`          // Loyalty points given back with this refund
+    RefundedAmount shared.Money         `json:"refunded_amount"` // Running total across refunds
+    TotalAmount    shared.Money         `json:"total_amount"`
+    TaxAmount      shared.Money         `json:"tax_amount"`
+    DeliveryFee    shared.Money         `json:"delivery_fee"`
+    Lines          []RefundLineSnapshot `json:"lines"`
+}
+
+func (e OrderRefundedEvent) EventName() string     { return "order.refunded" }
+func (e OrderRefundedEvent) AggregateID() string   { return e.OrderID }
+func (e OrderRefundedEvent) AggregateType() string { return "order" }
+
 // OrderPreparationStartedEvent indicates order preparation has begun
 type OrderPreparationStartedEvent struct {
     shared.BaseEvent
@@ -85,3 +160,67 @@ type OrderCompletedEvent struct {
 func (e OrderCompletedEvent) EventName() string     { return "order.completed" }
 func (e OrderCompletedEvent) AggregateID() string   { return e.OrderID }
 func (e OrderCompletedEvent) AggregateType() string { return "order" }
+
+// CourierAssignedEvent is raised when a courier is given a delivery order
+type CourierAssignedEvent struct {
+    shared.BaseEvent
+    OrderID           string `json:"order_id"`
+    CourierID         string `json:"courier_id"`
+    PreviousCourierID string `json:"previous_courier_id,omitempty"`
+}
+
+func (e CourierAssignedEvent) EventName() string     { return "order.courier_assigned" }
+func (e CourierAssignedEvent) AggregateID() string   { return e.OrderID }
+func (e CourierAssignedEvent) AggregateType() string { return "order" }
+
+// OrderOutForDeliveryEvent is raised when a courier leaves with an order
+type OrderOutForDeliveryEvent struct {
+    shared.BaseEvent
+    OrderID    string `json:"order_id"`
+    CustomerID string `json:"customer_id"`
+    CourierID  string `json:"courier_id"`
+}
+
+func (e OrderOutForDeliveryEvent) EventName() string     { return "order.out_for_delivery" }
+func (e OrderOutForDeliveryEvent) AggregateID() string   { return e.OrderID }
+func (e OrderOutForDeliveryEvent) AggregateType() string { return "order" }
+
+// OrderDeliveredEvent marks a delivery order handed to the customer
+// WHAT: Ends a delivery order the way OrderCompletedEvent ends a pickup order
+type OrderDeliveredEvent struct {
+    shared.BaseEvent
+    OrderID     string    `json:"order_id"`
+    CustomerID  string    `json:"customer_id"`
+    CourierID   string    `json:"courier_id"`
+    DeliveredAt time.Time `json:"delivered_at"`
+}
+
+func (e OrderDeliveredEvent) EventName() string     { return "order.delivered" }
+func (e OrderDeliveredEvent) AggregateID() string   { return e.OrderID }
+func (e OrderDeliveredEvent) AggregateType() string { return "order" }
+
+// OrderRecalledEvent marks a ready order sent back to be remade
+// WHERE: Puts the order back on the kitchen display
+type OrderRecalledEvent struct {
+    shared.BaseEvent
+    OrderID string `json:"order_id"`
+    Reason  string `json:"reason"`
+}
+
+func (e OrderRecalledEvent) EventName() string     { return "order.recalled" }
+func (e OrderRecalledEvent) AggregateID() string   { return e.OrderID }
+func (e OrderRecalledEvent) AggregateType() string { return "order" }
+
+// OrderReadyEstimateUpdatedEvent marks a change to when the customer can expect their order
+// WHERE: Pushed to order trackers, raised as the store's kitchen gets busier or quieter
+type OrderReadyEstimateUpdatedEvent struct {
+    shared.BaseEvent
+    OrderID          string    `json:"order_id"`
+    StoreID          string    `json:"store_id"`
+    Status           string    `json:"status"`
+    EstimatedReadyAt time.Time `json:"estimated_ready_at"`
+}
+
+func (e OrderReadyEstimateUpdatedEvent) EventName() string     { return "order.ready_estimate_updated" }
+func (e OrderReadyEstimateUpdatedEvent) AggregateID() string   { return e.OrderID }
+func (e OrderReadyEstimateUpdatedEvent) AggregateType() string { return "order" }
@@ -0,0 +1,23 @@
+package order
+
+import "fmt"
+
+// FulfillmentType says how an order reaches the customer
+type FulfillmentType string
+
+const (
+    FulfillmentPickup   FulfillmentType = "PICKUP"   // Collected at the stand
+    FulfillmentDelivery FulfillmentType = "DELIVERY" // Taken to the customer by a courier
+)
+
+// ParseFulfillmentType converts user input to a FulfillmentType
+// WHAT: Empty input means pickup, as every order was before delivery existed
+func ParseFulfillmentType(value string) (FulfillmentType, error) {
+    switch FulfillmentType(value) {
+    case "", FulfillmentPickup:
+        return FulfillmentPickup, nil
+    case FulfillmentDelivery:
+        return FulfillmentDelivery, nil
+    }
+    return "", fmt.Errorf("%w: %q", ErrUnknownFulfillmentType, value)
+}
@@ -0,0 +1,36 @@
+package order
+
+// KitchenPriority ranks orders waiting on the kitchen display, lowest goes first
+type KitchenPriority int
+
+const (
+    KitchenPriorityScheduled KitchenPriority = iota // Pre-orders promised for a pickup time
+    KitchenPriorityRush                             // Quick to make, so they don't wait behind big orders
+    KitchenPriorityNormal
+)
+
+func (p KitchenPriority) String() string {
+    switch p {
+    case KitchenPriorityScheduled:
+        return "SCHEDULED"
+    case KitchenPriorityRush:
+        return "RUSH"
+    default:
+        return "NORMAL"
+    }
+}
+
+// KitchenPriorityOf ranks an order for the kitchen display
+// WHY: A customer collecting at a set time shouldn't be kept waiting, and a
+// single lemonade shouldn't sit behind a party order
+// WHERE: rushSpec is usually a RushOrderSpec
+func KitchenPriorityOf(o *Order, rushSpec Specification) KitchenPriority {
+    switch {
+    case o.IsPreOrder():
+        return KitchenPriorityScheduled
+    case rushSpec.IsSatisfiedBy(o):
+        return KitchenPriorityRush
+    default:
+        return KitchenPriorityNormal
+    }
+}
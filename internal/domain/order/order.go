@@ -2,11 +2,13 @@ package order
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/tax"
 )
 
 // Order is the aggregate root for order management
@@ -14,26 +16,53 @@ import (
 // WHAT: Represents a customer's purchase transaction
 type Order struct {
     shared.AggregateRoot
-    id          OrderID
-    customerID  customer.CustomerID
-    storeID     store.StoreID
-    items       []*OrderItem
-    status      OrderStatus
-    totalAmount shared.Money
-    placedAt    time.Time
-    notes       string
+    id             OrderID
+    customerID     customer.CustomerID
+    storeID        store.StoreID
+    items          []*OrderItem
+    status         OrderStatus
+    subtotal       shared.Money
+    discounts      []Discount
+    promoCode      string
+    taxLines       []tax.Line
+    pointsRedeemed int          // Loyalty points spent as payment
+    pointsCredit   shared.Money // What the redeemed points were worth
+    totalAmount    shared.Money
+    refunds        []Refund
+    placedAt       time.Time
+    notes          string
+    history        []StatusChange // Every transition, oldest first
+    pickupAt       time.Time      // Requested pickup for a pre-order, zero for as soon as possible
+    releaseAt      time.Time      // When a pre-order goes to the kitchen
+    readyEstimate  time.Time      // When the customer is told to expect it, zero until estimated
+    
+    // How the order reaches the customer
+    fulfillment     FulfillmentType
+    deliveryAddress shared.Address // Set for delivery orders only
+    deliveryFee     shared.Money   // Charged on top of tax, zero for pickup
+    courierID       string         // Courier taking a delivery order out
 }
 
 // NewOrder creates a new order
 // WHERE: Called when customer initiates a purchase
 func NewOrder(customerID customer.CustomerID, storeID store.StoreID) *Order {
+    placedAt := time.Now()
     order := &Order{
-        id:         NewOrderID(),
-        customerID: customerID,
-        storeID:    storeID,
-        items:      make([]*OrderItem, 0),
-        status:     OrderStatusPending,
-        placedAt:   time.Now(),
+        id:          NewOrderID(),
+        customerID:  customerID,
+        storeID:     storeID,
+        items:       make([]*OrderItem, 0),
+        discounts:   make([]Discount, 0),
+        taxLines:    make([]tax.Line, 0),
+        refunds:     make([]Refund, 0),
+        status:      OrderStatusPending,
+        placedAt:    placedAt,
+        fulfillment: FulfillmentPickup,
+        history: []StatusChange{{
+            to:    OrderStatusPending,
+            at:    placedAt,
+            actor: CustomerActor(string(customerID)),
+        }},
     }
     
     // Raise domain event
@@ -49,20 +78,32 @@ func NewOrder(customerID customer.CustomerID, storeID store.StoreID) *Order {
 
 // AddItem adds a product to the order
 // WHY: Orders can only be modified through aggregate methods
-func (o *Order) AddItem(productID store.ProductID, name string, quantity int, unitPrice shared.Money) error {
+func (o *Order) AddItem(
+    productID store.ProductID,
+    name string,
+    quantity int,
+    unitPrice shared.Money,
+    taxExempt bool,
+    modifiers []store.SelectedModifier,
+) error {
     if o.status != OrderStatusPending {
         return errors.New("can only add items to pending orders")
     }
     
-    // Check if item already exists
+    // Check if the same product with the same modifiers is already on the order
     for _, item := range o.items {
-        if item.ProductID() == productID {
+        if item.Matches(productID, modifiers) {
             // Update quantity instead of adding duplicate
-            return item.UpdateQuantity(item.Quantity() + quantity)
+            err := item.UpdateQuantity(item.Quantity() + quantity)
+            if err != nil {
+                return err
+            }
+            o.recalculateTotal()
+            return nil
         }
     }
     
-    item, err := NewOrderItem(productID, name, quantity, unitPrice)
+    item, err := NewOrderItem(productID, name, quantity, unitPrice, taxExempt, modifiers)
     if err != nil {
         return err
     }
@@ -90,18 +131,207 @@ func (o *Order) RemoveItem(itemID string) error {
     return errors.New("item not found in order")
 }
 
+// ApplyTierDiscount prices the order with the customer's loyalty tier discount
+// WHY: Premium and VIP customers pay less, and the order must show by how much
+// WHERE: Called right before confirmation so the tier in effect at that moment is used
+func (o *Order) ApplyTierDiscount(tier customer.CustomerType, rate float64) error {
+    if o.status != OrderStatusPending {
+        return errors.New("can only apply discounts to pending orders")
+    }
+    
+    // A customer has exactly one tier, replace any earlier tier discount
+    discounts := make([]Discount, 0, len(o.discounts)+1)
+    for _, discount := range o.discounts {
+        if discount.Type() != DiscountTypeLoyaltyTier {
+            discounts = append(discounts, discount)
+        }
+    }
+    
+    if rate > 0 {
+        description := fmt.Sprintf("%s tier discount (%.0f%%)", tier, rate*100)
+        discount, err := NewPercentageDiscount(DiscountTypeLoyaltyTier, description, rate)
+        if err != nil {
+            return err
+        }
+        discounts = append(discounts, discount)
+    }
+    
+    o.discounts = discounts
+    o.recalculateTotal()
+    
+    return nil
+}
+
+// ApplyPromotion adds a promo code discount to the order
+// WHY: Only one promo code may be used per order
+// WHERE: Called once the promotion has checked the order is eligible
+func (o *Order) ApplyPromotion(code string, discount Discount) error {
+    if o.status != OrderStatusPending {
+        return errors.New("can only apply discounts to pending orders")
+    }
+    
+    if o.promoCode != "" {
+        return ErrPromotionAlreadyApplied
+    }
+    
+    if discount.Type() != DiscountTypePromotion {
+        return errors.New("discount is not a promotion")
+    }
+    
+    if !discount.Amount().IsZero() && discount.Amount().Currency() != o.subtotal.Currency() {
+        return fmt.Errorf("cannot apply %s discount to %s order", discount.Amount().Currency(), o.subtotal.Currency())
+    }
+    
+    o.promoCode = code
+    o.discounts = append(o.discounts, discount)
+    o.recalculateTotal()
+    
+    return nil
+}
+
+// ApplyTax records the sales tax charged on the order
+// WHY: Tax is owed on the discounted price, so it is applied after all discounts
+// WHERE: Called with lines from the tax calculator, before points are applied
+func (o *Order) ApplyTax(lines []tax.Line) error {
+    if o.status != OrderStatusPending {
+        return errors.New("can only apply tax to pending orders")
+    }
+    
+    for _, line := range lines {
+        if line.Amount().Currency() != o.subtotal.Currency() {
+            return fmt.Errorf("cannot apply %s tax to %s order", line.Amount().Currency(), o.subtotal.Currency())
+        }
+    }
+    
+    o.taxLines = lines
+    o.recalculateTotal()
+    
+    return nil
+}
+
+// TaxableItems describes the order lines for the tax calculator
+func (o *Order) TaxableItems() []tax.TaxableItem {
+    items := make([]tax.TaxableItem, len(o.items))
+    for i, item := range o.items {
+        items[i] = tax.TaxableItem{
+            Amount: item.Total(),
+            Exempt: item.IsTaxExempt(),
+        }
+    }
+    return items
+}
+
+// ApplyPointsCredit pays part of the order with loyalty points
+// WHY: Points are a form of payment, so they reduce what is owed after discounts and tax
+// WHERE: Called after discounts and tax are applied, once the customer's points are redeemed
+func (o *Order) ApplyPointsCredit(points int, credit shared.Money) error {
+    if o.status != OrderStatusPending {
+        return errors.New("can only apply points to pending orders")
+    }
+    
+    if points <= 0 {
+        return errors.New("points must be positive")
+    }
+    
+    // Points can cover at most what is owed after discounts and tax
+    due := o.amountBeforePoints()
+    if credit.Currency() != due.Currency() {
+        return fmt.Errorf("cannot apply %s credit to %s order", credit.Currency(), due.Currency())
+    }
+    if credit.Amount() > due.Amount() {
+        return ErrPointsExceedTotal
+    }
+    
+    o.pointsRedeemed = points
+    o.pointsCredit = credit
+    o.recalculateTotal()
+    
+    return nil
+}
+
+// transition moves the order to a new status and records who moved it
+func (o *Order) transition(to OrderStatus, actor string, reason string) {
+    o.history = append(o.history, StatusChange{
+        from:   o.status,
+        to:     to,
+        at:     time.Now(),
+        actor:  actor,
+        reason: reason,
+    })
+    o.status = to
+}
+
 // Confirm moves order to confirmed state
 // WHERE: Called after payment is processed
-func (o *Order) Confirm() error {
+func (o *Order) Confirm(actor string) error {
+    return o.confirm(actor, "")
+}
+
+// Schedule holds a pre-order until it is time to start making it
+// WHY: A pickup booked the night before shouldn't reach the kitchen until shortly before pickup
+// WHAT: The order is released prepTime ahead of pickup. Payment is already held,
+// the order is confirmed, and earns its points, once released.
+func (o *Order) Schedule(actor string, pickupAt time.Time, prepTime time.Duration) error {
+    if !o.status.IsValidTransition(OrderStatusScheduled) {
+        return fmt.Errorf("%w: cannot schedule order in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    
+    if len(o.items) == 0 {
+        return errors.New("cannot schedule empty order")
+    }
+    
+    releaseAt := pickupAt.Add(-prepTime)
+    if releaseAt.Before(o.placedAt) {
+        return fmt.Errorf("%w: pickup at %s leaves less than %s to make the order",
+            ErrInvalidPickupTime, pickupAt.Format(time.RFC3339), prepTime)
+    }
+    
+    o.pickupAt = pickupAt
+    o.releaseAt = releaseAt
+    o.transition(OrderStatusScheduled, actor, "pickup at "+pickupAt.Format(time.RFC3339))
+    
+    o.Raise(OrderScheduledEvent{
+        BaseEvent:  shared.NewBaseEvent(),
+        OrderID:    string(o.id),
+        CustomerID: string(o.customerID),
+        StoreID:    string(o.storeID),
+        PickupAt:   pickupAt,
+        ReleaseAt:  releaseAt,
+    })
+    
+    return nil
+}
+
+// IsDueForRelease reports whether a scheduled order should go to the kitchen
+func (o *Order) IsDueForRelease(now time.Time) bool {
+    return o.status == OrderStatusScheduled && !now.Before(o.releaseAt)
+}
+
+// Release confirms a scheduled order once its release time has come
+// WHERE: Called by the pre-order scheduler
+func (o *Order) Release(now time.Time) error {
+    if o.status != OrderStatusScheduled {
+        return fmt.Errorf("%w: cannot release order in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    if !o.IsDueForRelease(now) {
+        return fmt.Errorf("%w: order is not due until %s",
+            ErrInvalidStatusTransition, o.releaseAt.Format(time.RFC3339))
+    }
+    
+    return o.confirm(ActorSystem, "released for scheduled pickup")
+}
+
+// confirm moves the order to confirmed and hands its snapshot to downstream contexts
+func (o *Order) confirm(actor string, reason string) error {
     if !o.status.IsValidTransition(OrderStatusConfirmed) {
-        return errors.New("cannot confirm order in current status")
+        return fmt.Errorf("%w: cannot confirm order in %s status", ErrInvalidStatusTransition, o.status)
     }
     
     if len(o.items) == 0 {
         return errors.New("cannot confirm empty order")
     }
     
-    o.status = OrderStatusConfirmed
+    o.transition(OrderStatusConfirmed, actor, reason)
     
     // Raise domain event with order snapshot
     o.Raise(OrderConfirmedEvent{
@@ -109,8 +339,16 @@ func (o *Order) Confirm() error {
         OrderID:     string(o.id),
         CustomerID:  string(o.customerID),
         StoreID:     string(o.storeID),
-        TotalAmount: o.totalAmount,
-        Items:       o.createItemSnapshots(),
+        Subtotal:       o.subtotal,
+        DiscountAmount: o.DiscountAmount(),
+        PromoCode:      o.promoCode,
+        TaxAmount:      o.TaxAmount(),
+        TaxLines:       o.createTaxLineSnapshots(),
+        PointsRedeemed: o.pointsRedeemed,
+        PointsCredit:   o.pointsCredit,
+        DeliveryFee:    o.deliveryFee,
+        TotalAmount:    o.totalAmount,
+        Items:          o.createItemSnapshots(),
     })
     
     return nil
@@ -118,31 +356,49 @@ func (o *Order) Confirm() error {
 
 // Cancel cancels the order
 // WHY: Orders can be cancelled before completion
-func (o *Order) Cancel(reason string) error {
+func (o *Order) Cancel(actor string, reason string) error {
     if !o.status.IsValidTransition(OrderStatusCancelled) {
-        return errors.New("cannot cancel order in current status")
+        return fmt.Errorf("%w: cannot cancel order in %s status", ErrInvalidStatusTransition, o.status)
     }
     
-    o.status = OrderStatusCancelled
+    // Scheduled pre-orders are only confirmed once released to the kitchen
+    confirmed := o.status != OrderStatusPending && o.status != OrderStatusScheduled
+    o.transition(OrderStatusCancelled, actor, reason)
     
     // Raise domain event
     o.Raise(OrderCancelledEvent{
-        BaseEvent:  shared.NewBaseEvent(),
-        OrderID:    string(o.id),
-        CustomerID: string(o.customerID),
-        Reason:     reason,
+        BaseEvent:      shared.NewBaseEvent(),
+        OrderID:        string(o.id),
+        CustomerID:     string(o.customerID),
+        Reason:         reason,
+        PointsRedeemed: o.pointsRedeemed,
+        Confirmed:      confirmed,
+        TotalAmount:    o.totalAmount,
+        TaxAmount:      o.TaxAmount(),
+        DeliveryFee:    o.deliveryFee,
     })
     
     return nil
 }
 
+// CanStartPreparing checks that the order could move into preparation
+// WHERE: Checked before the store holds the order's stock
+func (o *Order) CanStartPreparing() error {
+    if o.status == OrderStatusReady || !o.status.IsValidTransition(OrderStatusPreparing) {
+        return fmt.Errorf("%w: cannot start preparing order in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    return nil
+}
+
 // StartPreparing moves order to preparing state
-func (o *Order) StartPreparing() error {
-    if !o.status.IsValidTransition(OrderStatusPreparing) {
-        return errors.New("cannot start preparing order in current status")
+// WHAT: A ready order goes back to preparing through Recall, which records why
+func (o *Order) StartPreparing(actor string) error {
+    err := o.CanStartPreparing()
+    if err != nil {
+        return err
     }
     
-    o.status = OrderStatusPreparing
+    o.transition(OrderStatusPreparing, actor, "")
     
     o.Raise(OrderPreparationStartedEvent{
         BaseEvent: shared.NewBaseEvent(),
@@ -153,12 +409,12 @@ func (o *Order) StartPreparing() error {
 }
 
 // MarkReady indicates order is ready for pickup
-func (o *Order) MarkReady() error {
+func (o *Order) MarkReady(actor string) error {
     if !o.status.IsValidTransition(OrderStatusReady) {
-        return errors.New("cannot mark order ready in current status")
+        return fmt.Errorf("%w: cannot mark order ready in %s status", ErrInvalidStatusTransition, o.status)
     }
     
-    o.status = OrderStatusReady
+    o.transition(OrderStatusReady, actor, "")
     
     o.Raise(OrderReadyEvent{
         BaseEvent:  shared.NewBaseEvent(),
@@ -169,13 +425,45 @@ func (o *Order) MarkReady() error {
     return nil
 }
 
-// Complete marks order as completed
-func (o *Order) Complete() error {
+// Recall sends a ready order back to the kitchen, e.g. a drink was made wrong
+// WHY: The order is remade rather than cancelled, so the customer keeps their place
+func (o *Order) Recall(actor string, reason string) error {
+    if o.status != OrderStatusReady {
+        return fmt.Errorf("%w: cannot recall order in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    
+    o.transition(OrderStatusPreparing, actor, reason)
+    
+    o.Raise(OrderRecalledEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        OrderID:   string(o.id),
+        Reason:    reason,
+    })
+    
+    return nil
+}
+
+// CanComplete checks that the order could be handed over at the counter
+// WHERE: Checked before the store takes the order's stock off hand
+func (o *Order) CanComplete() error {
     if !o.status.IsValidTransition(OrderStatusCompleted) {
-        return errors.New("cannot complete order in current status")
+        return fmt.Errorf("%w: cannot complete order in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    if o.IsDelivery() {
+        return fmt.Errorf("%w: delivery orders are finished by marking them delivered", ErrInvalidStatusTransition)
+    }
+    return nil
+}
+
+// Complete marks order as completed
+// WHAT: Pickup orders only, a delivery order is finished by MarkDelivered
+func (o *Order) Complete(actor string) error {
+    err := o.CanComplete()
+    if err != nil {
+        return err
     }
     
-    o.status = OrderStatusCompleted
+    o.transition(OrderStatusCompleted, actor, "")
     
     o.Raise(OrderCompletedEvent{
         BaseEvent:   shared.NewBaseEvent(),
@@ -187,22 +475,416 @@ func (o *Order) Complete() error {
     return nil
 }
 
-// recalculateTotal updates the order total
+// ArrangeDelivery turns the order into a delivery to the given address
+// WHERE: Called while the order is being placed, once its items are added
+func (o *Order) ArrangeDelivery(address shared.Address, fee shared.Money) error {
+    if o.status != OrderStatusPending {
+        return fmt.Errorf("%w: delivery can only be arranged while placing the order", ErrInvalidStatusTransition)
+    }
+    if address.Street() == "" {
+        return fmt.Errorf("%w: address is required", ErrInvalidDeliveryAddress)
+    }
+    if !fee.IsZero() && fee.Currency() != o.subtotal.Currency() {
+        return fmt.Errorf("cannot charge %s delivery on %s order", fee.Currency(), o.subtotal.Currency())
+    }
+    
+    o.fulfillment = FulfillmentDelivery
+    o.deliveryAddress = address
+    o.deliveryFee = fee
+    o.recalculateTotal()
+    
+    return nil
+}
+
+// AssignCourier gives a delivery order to a courier, replacing any earlier assignment
+// WHY: Dispatch can line up a courier while the order is still being made
+func (o *Order) AssignCourier(courierID string) error {
+    if !o.IsDelivery() {
+        return ErrNotDeliveryOrder
+    }
+    if courierID == "" {
+        return errors.New("courier ID is required")
+    }
+    switch o.status {
+    case OrderStatusScheduled, OrderStatusConfirmed, OrderStatusPreparing, OrderStatusReady:
+    default:
+        return fmt.Errorf("%w: cannot assign a courier to order in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    
+    previous := o.courierID
+    o.courierID = courierID
+    
+    o.Raise(CourierAssignedEvent{
+        BaseEvent:         shared.NewBaseEvent(),
+        OrderID:           string(o.id),
+        CourierID:         courierID,
+        PreviousCourierID: previous,
+    })
+    
+    return nil
+}
+
+// CanDispatchForDelivery checks that the order could leave with its courier
+// WHERE: Checked before the store takes the order's stock off hand
+func (o *Order) CanDispatchForDelivery() error {
+    if !o.IsDelivery() {
+        return ErrNotDeliveryOrder
+    }
+    if !o.status.IsValidTransition(OrderStatusOutForDelivery) {
+        return fmt.Errorf("%w: cannot dispatch order in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    if o.courierID == "" {
+        return ErrCourierNotAssigned
+    }
+    return nil
+}
+
+// DispatchForDelivery hands a ready delivery order to its courier
+func (o *Order) DispatchForDelivery(actor string) error {
+    err := o.CanDispatchForDelivery()
+    if err != nil {
+        return err
+    }
+    
+    o.transition(OrderStatusOutForDelivery, actor, "courier "+o.courierID)
+    
+    o.Raise(OrderOutForDeliveryEvent{
+        BaseEvent:  shared.NewBaseEvent(),
+        OrderID:    string(o.id),
+        CustomerID: string(o.customerID),
+        CourierID:  o.courierID,
+    })
+    
+    return nil
+}
+
+// MarkDelivered records that the courier handed the order over
+func (o *Order) MarkDelivered(actor string) error {
+    if !o.status.IsValidTransition(OrderStatusDelivered) {
+        return fmt.Errorf("%w: cannot mark order delivered in %s status", ErrInvalidStatusTransition, o.status)
+    }
+    
+    o.transition(OrderStatusDelivered, actor, "")
+    
+    o.Raise(OrderDeliveredEvent{
+        BaseEvent:   shared.NewBaseEvent(),
+        OrderID:     string(o.id),
+        CustomerID:  string(o.customerID),
+        CourierID:   o.courierID,
+        DeliveredAt: time.Now(),
+    })
+    
+    return nil
+}
+
+// QuoteItemRefund prices a refund of some units of the order's items
+// WHY: Customers get back what they actually paid for those units, after discounts and tax
+// WHAT: Each line is worth its share of the order total, and of the loyalty points
+// spent on it. Refunding the last units pays out whatever is left so rounding never
+// strands a cent or a point. Nothing is recorded until the quote is passed to ApplyRefund.
+func (o *Order) QuoteItemRefund(requests []ItemRefund, reason string) (Refund, error) {
+    if !o.status.IsFulfilled() {
+        return Refund{}, ErrOrderNotRefundable
+    }
+    
+    if len(requests) == 0 {
+        return Refund{}, errors.New("at least one item must be refunded")
+    }
+    
+    refunded := o.refundedQuantities()
+    lines := make([]RefundLine, 0, len(requests))
+    amount, _ := shared.NewMoney(0, o.totalAmount.Currency())
+    points := 0
+    for _, request := range requests {
+        item, err := o.findItem(request.ItemID)
+        if err != nil {
+            return Refund{}, err
+        }
+        
+        if request.Quantity <= 0 {
+            return Refund{}, errors.New("refund quantity must be positive")
+        }
+        
+        if refunded[item.ID()]+request.Quantity > item.Quantity() {
+            return Refund{}, ErrRefundExceedsQuantity
+        }
+        refunded[item.ID()] += request.Quantity
+        
+        gross := item.UnitPrice().Multiply(request.Quantity)
+        lineAmount := o.paidShare(gross)
+        lines = append(lines, RefundLine{
+            itemID:    item.ID(),
+            productID: item.ProductID(),
+            quantity:  request.Quantity,
+            amount:    lineAmount,
+        })
+        amount, _ = amount.Add(lineAmount)
+        points += o.pointsShare(gross)
+    }
+    
+    // Settle rounding on the last line
+    remaining := o.RefundableAmount()
+    allRefunded := o.allUnitsRefunded(refunded)
+    if allRefunded || amount.Amount() > remaining.Amount() {
+        last := &lines[len(lines)-1]
+        last.amount, _ = shared.NewMoney(last.amount.Amount()+remaining.Amount()-amount.Amount(), amount.Currency())
+        amount = remaining
+    }
+    if remainingPoints := o.RefundablePoints(); allRefunded || points > remainingPoints {
+        points = remainingPoints
+    }
+    
+    // Nothing left to give back, e.g. the money was already refunded as a goodwill amount
+    if amount.IsZero() && points == 0 && !o.amountBeforePoints().IsZero() {
+        return Refund{}, ErrRefundExceedsPaid
+    }
+    
+    return newRefund(lines, amount, points, reason), nil
+}
+
+// QuoteAmountRefund prices a refund of an arbitrary amount without returning any items
+// WHERE: Goodwill gestures, e.g. a drink that was made wrong but not returned
+func (o *Order) QuoteAmountRefund(amount shared.Money, reason string) (Refund, error) {
+    if !o.status.IsFulfilled() {
+        return Refund{}, ErrOrderNotRefundable
+    }
+    
+    if amount.Amount() <= 0 {
+        return Refund{}, errors.New("refund amount must be greater than zero")
+    }
+    
+    if amount.Currency() != o.totalAmount.Currency() {
+        return Refund{}, fmt.Errorf("cannot refund %s on %s order", amount.Currency(), o.totalAmount.Currency())
+    }
+    
+    if amount.Amount() > o.RefundableAmount().Amount() {
+        return Refund{}, ErrRefundExceedsPaid
+    }
+    
+    return newRefund([]RefundLine{}, amount, 0, reason), nil
+}
+
+// ApplyRefund records a refund quoted by QuoteItemRefund or QuoteAmountRefund
+// WHY: Quoting first lets the money go back before anything on the order changes
+// WHAT: Re-checks the quote so it can't refund the same units or cents twice
+func (o *Order) ApplyRefund(refund Refund) error {
+    if !o.status.IsFulfilled() {
+        return ErrOrderNotRefundable
+    }
+    
+    if refund.Amount().Amount() > o.RefundableAmount().Amount() || refund.Points() > o.RefundablePoints() {
+        return ErrRefundExceedsPaid
+    }
+    
+    refunded := o.refundedQuantities()
+    for _, line := range refund.Lines() {
+        item, err := o.findItem(line.ItemID())
+        if err != nil {
+            return err
+        }
+        
+        if refunded[item.ID()]+line.Quantity() > item.Quantity() {
+            return ErrRefundExceedsQuantity
+        }
+        refunded[item.ID()] += line.Quantity()
+    }
+    
+    o.recordRefund(refund)
+    
+    return nil
+}
+
+// recordRefund keeps the refund and raises the domain event
+func (o *Order) recordRefund(refund Refund) {
+    o.refunds = append(o.refunds, refund)
+    
+    lines := make([]RefundLineSnapshot, len(refund.Lines()))
+    for i, line := range refund.Lines() {
+        lines[i] = RefundLineSnapshot{
+            ItemID:    line.ItemID(),
+            ProductID: string(line.ProductID()),
+            Quantity:  line.Quantity(),
+            Amount:    line.Amount(),
+        }
+    }
+    
+    o.Raise(OrderRefundedEvent{
+        BaseEvent:      shared.NewBaseEvent(),
+        OrderID:        string(o.id),
+        CustomerID:     string(o.customerID),
+        StoreID:        string(o.storeID),
+        RefundID:       refund.ID(),
+        Reason:         refund.Reason(),
+        Amount:         refund.Amount(),
+        Points:         refund.Points(),
+        RefundedAmount: o.RefundedAmount(),
+        TotalAmount:    o.totalAmount,
+        TaxAmount:      o.TaxAmount(),
+        DeliveryFee:    o.deliveryFee,
+        Lines:          lines,
+    })
+}
+
+// paidShare returns what the customer paid for part of the subtotal
+// WHAT: Spreads discounts, tax, delivery fee and points credit evenly, rounding half up
+func (o *Order) paidShare(gross shared.Money) shared.Money {
+    subtotal := o.subtotal.Amount()
+    if subtotal == 0 {
+        return shared.Money{}
+    }
+    share := (gross.Amount()*o.totalAmount.Amount()*2 + subtotal) / (subtotal * 2)
+    amount, _ := shared.NewMoney(share, o.totalAmount.Currency())
+    return amount
+}
+
+// pointsShare returns the loyalty points spent on part of the subtotal, rounding half up
+func (o *Order) pointsShare(gross shared.Money) int {
+    subtotal := o.subtotal.Amount()
+    if subtotal == 0 || o.pointsRedeemed == 0 {
+        return 0
+    }
+    return int((gross.Amount()*int64(o.pointsRedeemed)*2 + subtotal) / (subtotal * 2))
+}
+
+// refundedQuantities returns how many units of each item were already refunded
+func (o *Order) refundedQuantities() map[string]int {
+    quantities := make(map[string]int)
+    for _, refund := range o.refunds {
+        for _, line := range refund.Lines() {
+            quantities[line.ItemID()] += line.Quantity()
+        }
+    }
+    return quantities
+}
+
+// allUnitsRefunded reports whether every unit on the order has been refunded
+func (o *Order) allUnitsRefunded(refunded map[string]int) bool {
+    for _, item := range o.items {
+        if refunded[item.ID()] < item.Quantity() {
+            return false
+        }
+    }
+    return true
+}
+
+// findItem returns the order item with the given ID
+func (o *Order) findItem(itemID string) (*OrderItem, error) {
+    for _, item := range o.items {
+        if item.ID() == itemID {
+            return item, nil
+        }
+    }
+    return nil, errors.New("item not found in order")
+}
+
+// recalculateTotal updates the subtotal, discount amounts and total
 // WHAT: Private method that maintains total consistency
 func (o *Order) recalculateTotal() {
     if len(o.items) == 0 {
-        o.totalAmount = shared.Money{} // Zero value
+        o.subtotal = shared.Money{} // Zero value
+        o.totalAmount = shared.Money{}
         return
     }
     
-    total := o.items[0].Total()
+    subtotal := o.items[0].Total()
     for i := 1; i < len(o.items); i++ {
         itemTotal := o.items[i].Total()
-        total, _ = total.Add(itemTotal)
+        subtotal, _ = subtotal.Add(itemTotal)
+    }
+    o.subtotal = subtotal
+    
+    // Discounts never take the total below zero
+    total := subtotal
+    for i, discount := range o.discounts {
+        discount = discount.applyTo(subtotal)
+        if discount.Amount().Amount() > total.Amount() {
+            discount.amount = total
+        }
+        o.discounts[i] = discount
+        total, _ = total.Subtract(discount.Amount())
+    }
+    
+    // Tax is charged on top of the discounted price
+    for _, line := range o.taxLines {
+        total, _ = total.Add(line.Amount())
+    }
+    
+    // Delivery is neither discounted nor taxed
+    if !o.deliveryFee.IsZero() {
+        total, _ = total.Add(o.deliveryFee)
+    }
+    
+    // Points pay for whatever is left after discounts and tax
+    if !o.pointsCredit.IsZero() {
+        if o.pointsCredit.Amount() > total.Amount() {
+            o.pointsCredit = total
+        }
+        total, _ = total.Subtract(o.pointsCredit)
     }
     o.totalAmount = total
 }
 
+// PromotionDiscount returns the amount taken off by the promo code, if any
+func (o *Order) PromotionDiscount() shared.Money {
+    total, _ := shared.NewMoney(0, o.subtotal.Currency())
+    for _, discount := range o.discounts {
+        if discount.Type() == DiscountTypePromotion {
+            total, _ = total.Add(discount.Amount())
+        }
+    }
+    return total
+}
+
+// TaxAmount returns the sum of all tax lines on the order
+func (o *Order) TaxAmount() shared.Money {
+    total, _ := shared.NewMoney(0, o.subtotal.Currency())
+    for _, line := range o.taxLines {
+        total, _ = total.Add(line.Amount())
+    }
+    return total
+}
+
+// RefundedAmount returns the sum of all refunds on the order
+func (o *Order) RefundedAmount() shared.Money {
+    total, _ := shared.NewMoney(0, o.totalAmount.Currency())
+    for _, refund := range o.refunds {
+        total, _ = total.Add(refund.Amount())
+    }
+    return total
+}
+
+// RefundableAmount returns how much of the amount paid can still be refunded
+func (o *Order) RefundableAmount() shared.Money {
+    remaining, err := o.totalAmount.Subtract(o.RefundedAmount())
+    if err != nil {
+        remaining, _ = shared.NewMoney(0, o.totalAmount.Currency())
+    }
+    return remaining
+}
+
+// RefundedPoints returns the loyalty points already given back across all refunds
+func (o *Order) RefundedPoints() int {
+    points := 0
+    for _, refund := range o.refunds {
+        points += refund.Points()
+    }
+    return points
+}
+
+// RefundablePoints returns how many of the points paid can still be given back
+func (o *Order) RefundablePoints() int {
+    return max(o.pointsRedeemed-o.RefundedPoints(), 0)
+}
+
+// amountBeforePoints returns the total after discounts and tax but before points credit
+func (o *Order) amountBeforePoints() shared.Money {
+    if o.pointsCredit.IsZero() {
+        return o.totalAmount
+    }
+    due, _ := o.totalAmount.Add(o.pointsCredit)
+    return due
+}
+
 // createItemSnapshots creates immutable snapshots for events
 func (o *Order) createItemSnapshots() []OrderItemSnapshot {
     snapshots := make([]OrderItemSnapshot, len(o.items))
@@ -213,6 +895,36 @@ func (o *Order) createItemSnapshots() []OrderItemSnapshot {
             Quantity:  item.Quantity(),
             UnitPrice: item.UnitPrice(),
             Total:     item.Total(),
+            TaxExempt: item.IsTaxExempt(),
+            Modifiers: createModifierSnapshots(item.Modifiers()),
+        }
+    }
+    return snapshots
+}
+
+// createModifierSnapshots creates immutable modifier snapshots for events
+func createModifierSnapshots(modifiers []store.SelectedModifier) []ModifierSnapshot {
+    snapshots := make([]ModifierSnapshot, len(modifiers))
+    for i, modifier := range modifiers {
+        snapshots[i] = ModifierSnapshot{
+            GroupName:  modifier.GroupName(),
+            OptionID:   modifier.OptionID(),
+            OptionName: modifier.OptionName(),
+            PriceDelta: modifier.PriceDelta(),
+        }
+    }
+    return snapshots
+}
+
+// createTaxLineSnapshots creates immutable tax line snapshots for events
+func (o *Order) createTaxLineSnapshots() []TaxLineSnapshot {
+    snapshots := make([]TaxLineSnapshot, len(o.taxLines))
+    for i, line := range o.taxLines {
+        snapshots[i] = TaxLineSnapshot{
+            Name:          line.Name(),
+            Rate:          line.Rate(),
+            TaxableAmount: line.TaxableAmount(),
+            Amount:        line.Amount(),
         }
     }
     return snapshots
@@ -224,5 +936,78 @@ func (o *Order) CustomerID() customer.CustomerID { return o.customerID }
 func (o *Order) StoreID() store.StoreID          { return o.storeID }
 func (o *Order) Items() []*OrderItem             { return o.items }
 func (o *Order) Status() OrderStatus             { return o.status }
+func (o *Order) Subtotal() shared.Money          { return o.subtotal }
+func (o *Order) Discounts() []Discount           { return o.discounts }
+func (o *Order) PromoCode() string               { return o.promoCode }
+func (o *Order) TaxLines() []tax.Line            { return o.taxLines }
+func (o *Order) PointsRedeemed() int             { return o.pointsRedeemed }
+func (o *Order) PointsCredit() shared.Money      { return o.pointsCredit }
 func (o *Order) TotalAmount() shared.Money       { return o.totalAmount }
+func (o *Order) Refunds() []Refund               { return o.refunds }
 func (o *Order) PlacedAt() time.Time             { return o.placedAt }
+func (o *Order) StatusHistory() []StatusChange   { return o.history }
+func (o *Order) PickupAt() time.Time             { return o.pickupAt }
+func (o *Order) ReleaseAt() time.Time            { return o.releaseAt }
+func (o *Order) Fulfillment() FulfillmentType    { return o.fulfillment }
+func (o *Order) DeliveryAddress() shared.Address { return o.deliveryAddress }
+func (o *Order) DeliveryFee() shared.Money       { return o.deliveryFee }
+func (o *Order) CourierID() string               { return o.courierID }
+func (o *Order) EstimatedReadyAt() time.Time     { return o.readyEstimate }
+
+// IsDelivery reports whether a courier takes the order to the customer
+func (o *Order) IsDelivery() bool {
+    return o.fulfillment == FulfillmentDelivery
+}
+
+// IsPreOrder reports whether the customer asked for a pickup time rather than as soon as possible
+func (o *Order) IsPreOrder() bool {
+    return !o.pickupAt.IsZero()
+}
+
+// UpdateReadyEstimate records when the order is now expected to be ready
+// WHY: Customers are told a minute, so a change of seconds isn't worth pushing to them
+// WHAT: Rounds up to the minute, raising an event and returning true only when that minute moves
+func (o *Order) UpdateReadyEstimate(at time.Time) bool {
+    if rounded := at.Truncate(time.Minute); rounded.Before(at) {
+        at = rounded.Add(time.Minute)
+    }
+    if at.Equal(o.readyEstimate) {
+        return false
+    }
+    
+    o.readyEstimate = at
+    
+    o.Raise(OrderReadyEstimateUpdatedEvent{
+        BaseEvent:        shared.NewBaseEvent(),
+        OrderID:          string(o.id),
+        StoreID:          string(o.storeID),
+        Status:           string(o.status),
+        EstimatedReadyAt: at,
+    })
+    
+    return true
+}
+
+// EnteredStatusAt returns when the order last moved into the status, zero if it never has
+func (o *Order) EnteredStatusAt(status OrderStatus) time.Time {
+    for i := len(o.history) - 1; i >= 0; i-- {
+        if o.history[i].to == status {
+            return o.history[i].at
+        }
+    }
+    return time.Time{}
+}
+
+// StageTimings returns how long the order spent in each status, the current one measured up to now
+func (o *Order) StageTimings(now time.Time) []StageTiming {
+    return stageTimings(o.history, now)
+}
+
+// DiscountAmount returns the sum of all discounts on the order
+func (o *Order) DiscountAmount() shared.Money {
+    total, _ := shared.NewMoney(0, o.subtotal.Currency())
+    for _, discount := range o.discounts {
+        total, _ = total.Add(discount.Amount())
+    }
+    return total
+}
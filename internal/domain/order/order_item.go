@@ -17,11 +17,21 @@ type OrderItem struct {
     name      string
     quantity  int
     unitPrice shared.Money
+    taxExempt bool // Captured at order time so later product changes don't alter tax
+    modifiers []store.SelectedModifier
 }
 
 // NewOrderItem creates a new order item
 // WHERE: Created when adding items to an order
-func NewOrderItem(productID store.ProductID, name string, quantity int, unitPrice shared.Money) (*OrderItem, error) {
+// WHAT: unitPrice already includes the price of any modifiers
+func NewOrderItem(
+    productID store.ProductID,
+    name string,
+    quantity int,
+    unitPrice shared.Money,
+    taxExempt bool,
+    modifiers []store.SelectedModifier,
+) (*OrderItem, error) {
     if quantity <= 0 {
         return nil, errors.New("quantity must be positive")
     }
@@ -32,9 +42,26 @@ func NewOrderItem(productID store.ProductID, name string, quantity int, unitPric
         name:      name,
         quantity:  quantity,
         unitPrice: unitPrice,
+        taxExempt: taxExempt,
+        modifiers: modifiers,
     }, nil
 }
 
+// Matches reports whether this line is the same product with the same modifiers
+// WHY: "Large, less ice" and "Small" lemonade are different lines on the order
+func (i *OrderItem) Matches(productID store.ProductID, modifiers []store.SelectedModifier) bool {
+    if i.productID != productID || len(i.modifiers) != len(modifiers) {
+        return false
+    }
+    // Selections come back from the product in menu order, so compare pairwise
+    for n, modifier := range i.modifiers {
+        if modifier.OptionID() != modifiers[n].OptionID() {
+            return false
+        }
+    }
+    return true
+}
+
 // Total calculates the total price for this item
 func (i *OrderItem) Total() shared.Money {
     return i.unitPrice.Multiply(i.quantity)
@@ -51,8 +78,10 @@ func (i *OrderItem) UpdateQuantity(newQuantity int) error {
 }
 
 // Getters
-func (i *OrderItem) ID() string                 { return i.id }
-func (i *OrderItem) ProductID() store.ProductID { return i.productID }
-func (i *OrderItem) Name() string               { return i.name }
-func (i *OrderItem) Quantity() int              { return i.quantity }
-func (i *OrderItem) UnitPrice() shared.Money    { return i.unitPrice }
+func (i *OrderItem) ID() string                          { return i.id }
+func (i *OrderItem) ProductID() store.ProductID          { return i.productID }
+func (i *OrderItem) Name() string                        { return i.name }
+func (i *OrderItem) Quantity() int                       { return i.quantity }
+func (i *OrderItem) UnitPrice() shared.Money             { return i.unitPrice }
+func (i *OrderItem) IsTaxExempt() bool                   { return i.taxExempt }
+func (i *OrderItem) Modifiers() []store.SelectedModifier { return i.modifiers }
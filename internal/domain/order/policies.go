@@ -1,35 +1,119 @@
 package order
 
-import "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+import (
+	"fmt"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
 
 // OrderPolicy defines business policies for orders
 // WHY: Centralizes business rules that involve multiple factors
 type OrderPolicy interface {
+    CanAcceptOrder(storeAgg *store.Store, at time.Time) error
+    CanSchedulePickup(storeAgg *store.Store, order *Order, pickupAt time.Time) error
     CanBeCancelled(order *Order) bool
-    GetPreparationTime(order *Order) int // minutes
+    GetPreparationTime(storeAgg *store.Store, order *Order) int // minutes
+    EstimateReadyAt(storeAgg *store.Store, order *Order, ordersAhead int, now time.Time) time.Time
+}
+
+// OrderPolicyProvider looks up the policy a store's orders follow
+// WHY: Domain defines the interface, infrastructure decides which store gets which policy
+// WHAT: Every store has a policy, stores without their own get the default
+type OrderPolicyProvider interface {
+    PolicyFor(storeID store.StoreID) OrderPolicy
 }
 
+// MaxPreOrderLead is how far ahead a pickup can be booked
+// WHY: Prices, menu and opening hours further out are too likely to change
+const MaxPreOrderLead = 7 * 24 * time.Hour
+
 // StandardOrderPolicy implements default business policies
 type StandardOrderPolicy struct{}
 
+// CanAcceptOrder checks the store is open and ordering isn't paused
+// WHY: An order placed while the stand is shut would sit there unmade
+func (p *StandardOrderPolicy) CanAcceptOrder(storeAgg *store.Store, at time.Time) error {
+    status := storeAgg.OpenStatusAt(at)
+    if status.IsOpen() {
+        return nil
+    }
+    
+    if status.NextOpening().IsZero() {
+        return fmt.Errorf("%w: %s", store.ErrStoreClosed, status.Reason())
+    }
+    return fmt.Errorf("%w: %s, opens again %s",
+        store.ErrStoreClosed, status.Reason(), status.NextOpening().Format("Mon Jan 2 15:04 MST"))
+}
+
+// CanSchedulePickup checks a pre-order can be made and collected at the requested time
+// WHAT: Leaves enough time to make the order, and the store must be open both
+// when making starts and at pickup
+func (p *StandardOrderPolicy) CanSchedulePickup(storeAgg *store.Store, order *Order, pickupAt time.Time) error {
+    prepTime := time.Duration(p.GetPreparationTime(storeAgg, order)) * time.Minute
+    releaseAt := pickupAt.Add(-prepTime)
+    if releaseAt.Before(order.PlacedAt()) {
+        return fmt.Errorf("%w: this order takes %s to make, pick it up %s or later",
+            ErrInvalidPickupTime, prepTime, order.PlacedAt().Add(prepTime).Format("Mon Jan 2 15:04 MST"))
+    }
+    if pickupAt.Sub(order.PlacedAt()) > MaxPreOrderLead {
+        return fmt.Errorf("%w: pickups can be booked at most %d days ahead",
+            ErrInvalidPickupTime, int(MaxPreOrderLead/(24*time.Hour)))
+    }
+    
+    for _, at := range []time.Time{releaseAt, pickupAt} {
+        status := storeAgg.OpenStatusAt(at)
+        if !status.IsOpen() {
+            return fmt.Errorf("%w: %s at %s", store.ErrStoreClosed, status.Reason(), at.Format("Mon Jan 2 15:04 MST"))
+        }
+    }
+    
+    return nil
+}
+
 // CanBeCancelled determines if order can be cancelled
 // WHAT: Business rule - orders can only be cancelled before preparation starts
 func (p *StandardOrderPolicy) CanBeCancelled(order *Order) bool {
     return order.Status() == OrderStatusPending || 
+           order.Status() == OrderStatusScheduled ||
            order.Status() == OrderStatusConfirmed
 }
 
 // GetPreparationTime estimates preparation time based on order complexity
-func (p *StandardOrderPolicy) GetPreparationTime(order *Order) int {
-    baseTime := 5 // 5 minutes base
-    itemTime := len(order.Items()) * 2 // 2 minutes per item
+// WHAT: The minutes come from the store's prep times, so each store can be tuned
+func (p *StandardOrderPolicy) GetPreparationTime(storeAgg *store.Store, order *Order) int {
+    times := storeAgg.PrepTimes()
+    baseTime := times.BaseMinutes()
+    itemTime := len(order.Items()) * times.MinutesPerItem()
     
     // Large orders take longer
-    largeOrderThreshold, _ := shared.NewMoney(5000, "USD") // $50
-    largeOrderSpec := NewLargeOrderSpec(largeOrderThreshold)
+    largeOrderSpec := NewLargeOrderSpec(times.LargeOrderAmount())
     if largeOrderSpec.IsSatisfiedBy(order) {
-        itemTime += 10 // Extra 10 minutes for large orders
+        itemTime += times.LargeOrderMinutes()
     }
     
     return baseTime + itemTime
 }
+
+// EstimateReadyAt works out when the customer can expect the order
+// WHAT: An order being made is due its preparation time after it was started. One still
+// waiting also waits behind the orders ahead of it, and a pre-order is never promised
+// before its pickup time
+func (p *StandardOrderPolicy) EstimateReadyAt(storeAgg *store.Store, order *Order, ordersAhead int, now time.Time) time.Time {
+    prepTime := time.Duration(p.GetPreparationTime(storeAgg, order)) * time.Minute
+    
+    if order.Status() == OrderStatusPreparing {
+        readyAt := order.EnteredStatusAt(OrderStatusPreparing).Add(prepTime)
+        if readyAt.Before(now) {
+            return now // Running late, but it is still coming
+        }
+        return readyAt
+    }
+    
+    wait := time.Duration(ordersAhead*storeAgg.PrepTimes().MinutesPerQueued()) * time.Minute
+    readyAt := now.Add(wait + prepTime)
+    if order.IsPreOrder() && order.PickupAt().After(readyAt) {
+        return order.PickupAt()
+    }
+    return readyAt
+}
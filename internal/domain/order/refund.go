@@ -0,0 +1,58 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// ItemRefund asks for some units of an order item to be refunded
+type ItemRefund struct {
+    ItemID   string
+    Quantity int
+}
+
+// RefundLine is a value object for the refunded units of one order item
+type RefundLine struct {
+    itemID    string
+    productID store.ProductID
+    quantity  int
+    amount    shared.Money
+}
+
+func (l RefundLine) ItemID() string             { return l.itemID }
+func (l RefundLine) ProductID() store.ProductID { return l.productID }
+func (l RefundLine) Quantity() int              { return l.quantity }
+func (l RefundLine) Amount() shared.Money       { return l.amount }
+
+// Refund records money given back on a completed order
+// WHY: Orders keep every refund so the amount paid can always be reconciled
+// WHAT: Amount-only refunds (goodwill, complaints) have no lines
+type Refund struct {
+    id         string
+    lines      []RefundLine
+    amount     shared.Money
+    points     int // Loyalty points given back for the refunded items' share of the points paid
+    reason     string
+    refundedAt time.Time
+}
+
+func newRefund(lines []RefundLine, amount shared.Money, points int, reason string) Refund {
+    return Refund{
+        id:         uuid.New().String(),
+        lines:      lines,
+        amount:     amount,
+        points:     points,
+        reason:     reason,
+        refundedAt: time.Now(),
+    }
+}
+
+func (r Refund) ID() string            { return r.id }
+func (r Refund) Lines() []RefundLine   { return r.lines }
+func (r Refund) Amount() shared.Money  { return r.amount }
+func (r Refund) Points() int           { return r.points }
+func (r Refund) Reason() string        { return r.reason }
+func (r Refund) RefundedAt() time.Time { return r.refundedAt }
@@ -0,0 +1,134 @@
+package order
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+func usd(t *testing.T, cents int64) shared.Money {
+    t.Helper()
+    money, err := shared.NewMoney(cents, "USD")
+    if err != nil {
+        t.Fatalf("NewMoney(%d): %v", cents, err)
+    }
+    return money
+}
+
+// newCompletedOrder returns a picked-up order for quantity lemonades at unitPrice,
+// partly or fully paid with points worth a cent each
+func newCompletedOrder(t *testing.T, quantity int, unitPrice int64, points int) *Order {
+    t.Helper()
+    orderAgg := NewOrder("customer-1", "store-1")
+    
+    err := orderAgg.AddItem("lemonade", "Lemonade", quantity, usd(t, unitPrice), false, nil)
+    if err != nil {
+        t.Fatalf("AddItem: %v", err)
+    }
+    if points > 0 {
+        err = orderAgg.ApplyPointsCredit(points, usd(t, int64(points)))
+        if err != nil {
+            t.Fatalf("ApplyPointsCredit: %v", err)
+        }
+    }
+    
+    steps := []func(string) error{orderAgg.Confirm, orderAgg.StartPreparing, orderAgg.MarkReady, orderAgg.Complete}
+    for _, step := range steps {
+        err = step("staff")
+        if err != nil {
+            t.Fatalf("moving order to completed: %v", err)
+        }
+    }
+    return orderAgg
+}
+
+func refundUnits(t *testing.T, orderAgg *Order, quantity int) Refund {
+    t.Helper()
+    itemID := orderAgg.Items()[0].ID()
+    
+    refund, err := orderAgg.QuoteItemRefund([]ItemRefund{{ItemID: itemID, Quantity: quantity}}, "returned")
+    if err != nil {
+        t.Fatalf("QuoteItemRefund: %v", err)
+    }
+    err = orderAgg.ApplyRefund(refund)
+    if err != nil {
+        t.Fatalf("ApplyRefund: %v", err)
+    }
+    return refund
+}
+
+func TestItemRefundReturnsPointsShare(t *testing.T) {
+    // 800¢ of lemonade, half paid with points
+    orderAgg := newCompletedOrder(t, 2, 400, 400)
+    
+    refund := refundUnits(t, orderAgg, 1)
+    
+    if got := refund.Amount().Amount(); got != 200 {
+        t.Errorf("money refunded = %d¢, want 200¢", got)
+    }
+    if got := refund.Points(); got != 200 {
+        t.Errorf("points refunded = %d, want 200", got)
+    }
+    if got := orderAgg.RefundablePoints(); got != 200 {
+        t.Errorf("refundable points = %d, want 200", got)
+    }
+}
+
+func TestPointsOnlyOrderRefundsPoints(t *testing.T) {
+    orderAgg := newCompletedOrder(t, 3, 300, 900)
+    
+    refund := refundUnits(t, orderAgg, 1)
+    
+    if !refund.Amount().IsZero() {
+        t.Errorf("money refunded = %s, want nothing", refund.Amount())
+    }
+    if got := refund.Points(); got != 300 {
+        t.Errorf("points refunded = %d, want 300", got)
+    }
+}
+
+func TestLastItemRefundSettlesPointsRounding(t *testing.T) {
+    // 100 points over three units is 33 each, the last unit takes what is left
+    orderAgg := newCompletedOrder(t, 3, 300, 100)
+    
+    first := refundUnits(t, orderAgg, 1)
+    second := refundUnits(t, orderAgg, 1)
+    last := refundUnits(t, orderAgg, 1)
+    
+    got := []int{first.Points(), second.Points(), last.Points()}
+    want := []int{33, 33, 34}
+    for i := range want {
+        if got[i] != want[i] {
+            t.Errorf("refund %d returned %d points, want %d", i+1, got[i], want[i])
+        }
+    }
+    if orderAgg.RefundedPoints() != 100 {
+        t.Errorf("refunded points = %d, want 100", orderAgg.RefundedPoints())
+    }
+}
+
+func TestApplyRefundRejectsPointsAlreadyReturned(t *testing.T) {
+    orderAgg := newCompletedOrder(t, 2, 300, 600)
+    itemID := orderAgg.Items()[0].ID()
+    
+    // Two quotes for the same last unit, only one may be applied
+    refundUnits(t, orderAgg, 1)
+    first, err := orderAgg.QuoteItemRefund([]ItemRefund{{ItemID: itemID, Quantity: 1}}, "returned")
+    if err != nil {
+        t.Fatalf("QuoteItemRefund: %v", err)
+    }
+    second, err := orderAgg.QuoteItemRefund([]ItemRefund{{ItemID: itemID, Quantity: 1}}, "returned")
+    if err != nil {
+        t.Fatalf("QuoteItemRefund: %v", err)
+    }
+    
+    err = orderAgg.ApplyRefund(first)
+    if err != nil {
+        t.Fatalf("ApplyRefund: %v", err)
+    }
+    err = orderAgg.ApplyRefund(second)
+    if !errors.Is(err, ErrRefundExceedsPaid) {
+        t.Errorf("second ApplyRefund = %v, want %v", err, ErrRefundExceedsPaid)
+    }
+}
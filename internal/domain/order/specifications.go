@@ -1,6 +1,9 @@
 package order
 
-import "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+import (
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
 
 // Specification pattern for complex business rules
 // WHY: Encapsulates business rules in reusable, composable units
@@ -26,6 +29,50 @@ func (s *LargeOrderSpec) IsSatisfiedBy(candidate interface{}) bool {
     return order.TotalAmount().Amount() >= s.minAmount.Amount()
 }
 
+// MinimumSpendSpec identifies orders whose items add up to at least an amount
+// WHY: Unlike LargeOrderSpec it ignores discounts, so promotions can't disqualify each other
+type MinimumSpendSpec struct {
+    minAmount shared.Money
+}
+
+func NewMinimumSpendSpec(minAmount shared.Money) *MinimumSpendSpec {
+    return &MinimumSpendSpec{minAmount: minAmount}
+}
+
+func (s *MinimumSpendSpec) IsSatisfiedBy(candidate interface{}) bool {
+    order, ok := candidate.(*Order)
+    if !ok {
+        return false
+    }
+    return order.Subtotal().Currency() == s.minAmount.Currency() &&
+        order.Subtotal().Amount() >= s.minAmount.Amount()
+}
+
+// ContainsProductSpec identifies orders with at least a quantity of one product
+// WHERE: Used by product-specific promotions such as buy-X-get-Y
+type ContainsProductSpec struct {
+    productID   store.ProductID
+    minQuantity int
+}
+
+func NewContainsProductSpec(productID store.ProductID, minQuantity int) *ContainsProductSpec {
+    return &ContainsProductSpec{productID: productID, minQuantity: minQuantity}
+}
+
+func (s *ContainsProductSpec) IsSatisfiedBy(candidate interface{}) bool {
+    order, ok := candidate.(*Order)
+    if !ok {
+        return false
+    }
+    quantity := 0
+    for _, item := range order.Items() {
+        if item.ProductID() == s.productID {
+            quantity += item.Quantity()
+        }
+    }
+    return quantity >= s.minQuantity
+}
+
 // RushOrderSpec identifies orders needing quick preparation
 type RushOrderSpec struct {
     maxItems int
@@ -49,6 +96,10 @@ type AndSpec struct {
     spec2 Specification
 }
 
+func NewAndSpec(spec1 Specification, spec2 Specification) *AndSpec {
+    return &AndSpec{spec1: spec1, spec2: spec2}
+}
+
 func (s *AndSpec) IsSatisfiedBy(candidate interface{}) bool {
     return s.spec1.IsSatisfiedBy(candidate) && s.spec2.IsSatisfiedBy(candidate)
 }
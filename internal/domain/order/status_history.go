@@ -0,0 +1,63 @@
+package order
+
+import "time"
+
+// ActorSystem records transitions nobody asked for, such as an expired stock hold
+const ActorSystem = "system"
+
+// CustomerActor records a transition made by the customer themselves
+func CustomerActor(customerID string) string {
+    return "customer:" + customerID
+}
+
+// StatusChange records one step in an order's lifecycle
+// WHY: When a customer says their order was slow we need to see which stage took the time
+type StatusChange struct {
+    from   OrderStatus // Empty for the order being placed
+    to     OrderStatus
+    at     time.Time
+    actor  string // Who made the change, e.g. a staff ID, CustomerActor or ActorSystem
+    reason string
+}
+
+func (c StatusChange) From() OrderStatus { return c.from }
+func (c StatusChange) To() OrderStatus   { return c.to }
+func (c StatusChange) At() time.Time     { return c.at }
+func (c StatusChange) Actor() string     { return c.actor }
+func (c StatusChange) Reason() string    { return c.reason }
+
+// StageTiming is how long an order spent in one status
+type StageTiming struct {
+    status    OrderStatus
+    enteredAt time.Time
+    leftAt    time.Time // Zero while the order is still in this status
+    duration  time.Duration
+}
+
+func (t StageTiming) Status() OrderStatus     { return t.status }
+func (t StageTiming) EnteredAt() time.Time    { return t.enteredAt }
+func (t StageTiming) LeftAt() time.Time       { return t.leftAt }
+func (t StageTiming) Duration() time.Duration { return t.duration }
+
+// IsCurrent reports whether the order is still in this stage
+func (t StageTiming) IsCurrent() bool { return t.leftAt.IsZero() }
+
+// stageTimings works out time spent per status from the history
+// WHAT: The current stage runs up to now, terminal statuses have no duration
+func stageTimings(history []StatusChange, now time.Time) []StageTiming {
+    stages := make([]StageTiming, 0, len(history))
+    for i, change := range history {
+        stage := StageTiming{status: change.to, enteredAt: change.at}
+        switch {
+        case i+1 < len(history):
+            stage.leftAt = history[i+1].at
+            stage.duration = stage.leftAt.Sub(stage.enteredAt)
+        case change.to.IsTerminal():
+            stage.leftAt = change.at
+        default:
+            stage.duration = now.Sub(change.at)
+        }
+        stages = append(stages, stage)
+    }
+    return stages
+}
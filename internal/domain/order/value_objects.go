@@ -1,6 +1,8 @@
 package order
 
 import (
+    "fmt"
+    
     "github.com/google/uuid"
 )
 
@@ -17,23 +19,32 @@ type OrderStatus string
 
 const (
     OrderStatusPending   OrderStatus = "PENDING"
+    OrderStatusScheduled OrderStatus = "SCHEDULED" // Pre-order waiting for its release time
     OrderStatusConfirmed OrderStatus = "CONFIRMED"
     OrderStatusPreparing OrderStatus = "PREPARING"
     OrderStatusReady     OrderStatus = "READY"
     OrderStatusCompleted OrderStatus = "COMPLETED"
     OrderStatusCancelled OrderStatus = "CANCELLED"
+    
+    // Delivery orders leave the stand with a courier instead of being collected
+    OrderStatusOutForDelivery OrderStatus = "OUT_FOR_DELIVERY"
+    OrderStatusDelivered      OrderStatus = "DELIVERED"
 )
 
 // IsValidTransition checks if status transition is allowed
 // WHAT: Implements business rules for order state machine
 func (s OrderStatus) IsValidTransition(newStatus OrderStatus) bool {
     validTransitions := map[OrderStatus][]OrderStatus{
-        OrderStatusPending:   {OrderStatusConfirmed, OrderStatusCancelled},
+        OrderStatusPending:   {OrderStatusConfirmed, OrderStatusScheduled, OrderStatusCancelled},
+        OrderStatusScheduled: {OrderStatusConfirmed, OrderStatusCancelled},
         OrderStatusConfirmed: {OrderStatusPreparing, OrderStatusCancelled},
         OrderStatusPreparing: {OrderStatusReady, OrderStatusCancelled},
-        OrderStatusReady:     {OrderStatusCompleted},
+        OrderStatusReady:     {OrderStatusCompleted, OrderStatusOutForDelivery, OrderStatusPreparing},
         OrderStatusCompleted: {},
         OrderStatusCancelled: {},
+        
+        OrderStatusOutForDelivery: {OrderStatusDelivered},
+        OrderStatusDelivered:      {},
     }
     
     allowed := validTransitions[s]
@@ -44,3 +55,27 @@ func (s OrderStatus) IsValidTransition(newStatus OrderStatus) bool {
     }
     return false
 }
+
+// IsTerminal reports whether no further transitions are possible
+// WHERE: Used by order tracking to know when to stop listening for updates
+func (s OrderStatus) IsTerminal() bool {
+    return s == OrderStatusCompleted || s == OrderStatusCancelled || s == OrderStatusDelivered
+}
+
+// IsFulfilled reports whether the customer has the order, collected or delivered
+// WHERE: Payment is captured and refunds become possible once an order is fulfilled
+func (s OrderStatus) IsFulfilled() bool {
+    return s == OrderStatusCompleted || s == OrderStatusDelivered
+}
+
+// ParseOrderStatus converts user input to an OrderStatus
+func ParseOrderStatus(value string) (OrderStatus, error) {
+    status := OrderStatus(value)
+    switch status {
+    case OrderStatusPending, OrderStatusScheduled, OrderStatusConfirmed, OrderStatusPreparing,
+        OrderStatusReady, OrderStatusCompleted, OrderStatusCancelled,
+        OrderStatusOutForDelivery, OrderStatusDelivered:
+        return status, nil
+    }
+    return "", fmt.Errorf("%w: %q", ErrUnknownOrderStatus, value)
+}
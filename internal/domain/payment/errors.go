@@ -0,0 +1,13 @@
+package payment
+
+import "errors"
+
+// Domain-specific errors
+// WHY: Domain errors express business rule violations
+var (
+    ErrPaymentNotFound          = errors.New("payment not found")
+    ErrInvalidPaymentTransition = errors.New("invalid payment status transition")
+    ErrPaymentDeclined          = errors.New("payment declined")
+    ErrRefundExceedsCaptured    = errors.New("refund exceeds captured amount")
+    ErrPaymentNotCaptured       = errors.New("payment has not been captured yet")
+)
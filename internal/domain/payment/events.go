@@ -0,0 +1,64 @@
+package payment
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+
+// PaymentAuthorizedEvent is raised when the gateway holds funds for an order
+type PaymentAuthorizedEvent struct {
+    shared.BaseEvent
+    PaymentID       string       `json:"payment_id"`
+    OrderID         string       `json:"order_id"`
+    AuthorizationID string       `json:"authorization_id"`
+    Amount          shared.Money `json:"amount"`
+}
+
+func (e PaymentAuthorizedEvent) EventName() string     { return "payment.authorized" }
+func (e PaymentAuthorizedEvent) AggregateID() string   { return e.PaymentID }
+func (e PaymentAuthorizedEvent) AggregateType() string { return "payment" }
+
+// PaymentFailedEvent is raised when the gateway declines a payment
+type PaymentFailedEvent struct {
+    shared.BaseEvent
+    PaymentID string `json:"payment_id"`
+    OrderID   string `json:"order_id"`
+    Reason    string `json:"reason"`
+}
+
+func (e PaymentFailedEvent) EventName() string     { return "payment.failed" }
+func (e PaymentFailedEvent) AggregateID() string   { return e.PaymentID }
+func (e PaymentFailedEvent) AggregateType() string { return "payment" }
+
+// PaymentCapturedEvent is raised when held funds are collected
+type PaymentCapturedEvent struct {
+    shared.BaseEvent
+    PaymentID string       `json:"payment_id"`
+    OrderID   string       `json:"order_id"`
+    Amount    shared.Money `json:"amount"`
+}
+
+func (e PaymentCapturedEvent) EventName() string     { return "payment.captured" }
+func (e PaymentCapturedEvent) AggregateID() string   { return e.PaymentID }
+func (e PaymentCapturedEvent) AggregateType() string { return "payment" }
+
+// PaymentVoidedEvent is raised when held funds are released without collecting them
+type PaymentVoidedEvent struct {
+    shared.BaseEvent
+    PaymentID string `json:"payment_id"`
+    OrderID   string `json:"order_id"`
+}
+
+func (e PaymentVoidedEvent) EventName() string     { return "payment.voided" }
+func (e PaymentVoidedEvent) AggregateID() string   { return e.PaymentID }
+func (e PaymentVoidedEvent) AggregateType() string { return "payment" }
+
+// PaymentRefundedEvent is raised when collected money is returned to the customer
+type PaymentRefundedEvent struct {
+    shared.BaseEvent
+    PaymentID      string       `json:"payment_id"`
+    OrderID        string       `json:"order_id"`
+    Amount         shared.Money `json:"amount"`
+    RefundedAmount shared.Money `json:"refunded_amount"` // Running total across refunds
+}
+
+func (e PaymentRefundedEvent) EventName() string     { return "payment.refunded" }
+func (e PaymentRefundedEvent) AggregateID() string   { return e.PaymentID }
+func (e PaymentRefundedEvent) AggregateType() string { return "payment" }
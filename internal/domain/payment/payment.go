@@ -0,0 +1,197 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// Payment is the aggregate root for collecting money for an order
+// WHY: Money movement has its own lifecycle and must not be lost if the order changes
+// WHAT: Tracks the gateway authorization from hold through capture, void or refund
+type Payment struct {
+    shared.AggregateRoot
+    id              PaymentID
+    orderID         order.OrderID
+    amount          shared.Money
+    refundedAmount  shared.Money
+    status          PaymentStatus
+    authorizationID string // Reference issued by the payment gateway
+    failureReason   string
+    createdAt       time.Time
+}
+
+// NewPayment creates a pending payment for an order
+// WHERE: Called right before asking the gateway to authorize
+func NewPayment(orderID order.OrderID, amount shared.Money) (*Payment, error) {
+    if amount.Amount() <= 0 {
+        return nil, errors.New("payment amount must be greater than zero")
+    }
+    
+    refunded, err := shared.NewMoney(0, amount.Currency())
+    if err != nil {
+        return nil, err
+    }
+    
+    return &Payment{
+        id:             NewPaymentID(),
+        orderID:        orderID,
+        amount:         amount,
+        refundedAmount: refunded,
+        status:         PaymentStatusPending,
+        createdAt:      time.Now(),
+    }, nil
+}
+
+// Authorize records that the gateway is holding the funds
+func (p *Payment) Authorize(authorizationID string) error {
+    if !p.status.IsValidTransition(PaymentStatusAuthorized) {
+        return fmt.Errorf("%w: cannot authorize payment in %s status", ErrInvalidPaymentTransition, p.status)
+    }
+    
+    if authorizationID == "" {
+        return errors.New("authorization id is required")
+    }
+    
+    p.status = PaymentStatusAuthorized
+    p.authorizationID = authorizationID
+    
+    p.Raise(PaymentAuthorizedEvent{
+        BaseEvent:       shared.NewBaseEvent(),
+        PaymentID:       string(p.id),
+        OrderID:         string(p.orderID),
+        AuthorizationID: authorizationID,
+        Amount:          p.amount,
+    })
+    
+    return nil
+}
+
+// Fail records that the gateway declined the payment
+func (p *Payment) Fail(reason string) error {
+    if !p.status.IsValidTransition(PaymentStatusFailed) {
+        return fmt.Errorf("%w: cannot fail payment in %s status", ErrInvalidPaymentTransition, p.status)
+    }
+    
+    p.status = PaymentStatusFailed
+    p.failureReason = reason
+    
+    p.Raise(PaymentFailedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        PaymentID: string(p.id),
+        OrderID:   string(p.orderID),
+        Reason:    reason,
+    })
+    
+    return nil
+}
+
+// Capture collects the authorized funds
+// WHERE: Called when the customer picks up the order
+func (p *Payment) Capture() error {
+    if !p.status.IsValidTransition(PaymentStatusCaptured) {
+        return fmt.Errorf("%w: cannot capture payment in %s status", ErrInvalidPaymentTransition, p.status)
+    }
+    
+    p.status = PaymentStatusCaptured
+    
+    p.Raise(PaymentCapturedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        PaymentID: string(p.id),
+        OrderID:   string(p.orderID),
+        Amount:    p.amount,
+    })
+    
+    return nil
+}
+
+// Void releases the authorized funds without collecting them
+// WHERE: Called when the order is cancelled or could not be saved
+func (p *Payment) Void() error {
+    if !p.status.IsValidTransition(PaymentStatusVoided) {
+        return fmt.Errorf("%w: cannot void payment in %s status", ErrInvalidPaymentTransition, p.status)
+    }
+    
+    p.status = PaymentStatusVoided
+    
+    p.Raise(PaymentVoidedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        PaymentID: string(p.id),
+        OrderID:   string(p.orderID),
+    })
+    
+    return nil
+}
+
+// CanRefund checks that the amount could be refunded without refunding it
+// WHY: The gateway is asked for the money back before the payment is changed
+// WHAT: Capture runs after pickup, so an authorized payment is reported as not captured yet
+func (p *Payment) CanRefund(amount shared.Money) error {
+    if amount.Amount() <= 0 {
+        return errors.New("refund amount must be greater than zero")
+    }
+    
+    if p.status == PaymentStatusAuthorized {
+        return ErrPaymentNotCaptured
+    }
+    
+    refunded, err := p.refundedAmount.Add(amount)
+    if err != nil {
+        return err
+    }
+    
+    if refunded.Amount() > p.amount.Amount() {
+        return ErrRefundExceedsCaptured
+    }
+    
+    if !p.status.IsValidTransition(p.refundStatus(refunded)) {
+        return fmt.Errorf("%w: cannot refund payment in %s status", ErrInvalidPaymentTransition, p.status)
+    }
+    
+    return nil
+}
+
+// Refund returns part or all of the captured amount
+// WHY: Refunds can be issued in several steps but never exceed what was collected
+func (p *Payment) Refund(amount shared.Money) error {
+    err := p.CanRefund(amount)
+    if err != nil {
+        return err
+    }
+    
+    refunded, _ := p.refundedAmount.Add(amount)
+    
+    p.status = p.refundStatus(refunded)
+    p.refundedAmount = refunded
+    
+    p.Raise(PaymentRefundedEvent{
+        BaseEvent:      shared.NewBaseEvent(),
+        PaymentID:      string(p.id),
+        OrderID:        string(p.orderID),
+        Amount:         amount,
+        RefundedAmount: refunded,
+    })
+    
+    return nil
+}
+
+// refundStatus returns the status once the given total has been refunded
+func (p *Payment) refundStatus(refunded shared.Money) PaymentStatus {
+    if refunded.Amount() == p.amount.Amount() {
+        return PaymentStatusRefunded
+    }
+    return PaymentStatusPartiallyRefunded
+}
+
+// Getters
+func (p *Payment) ID() PaymentID                { return p.id }
+func (p *Payment) OrderID() order.OrderID       { return p.orderID }
+func (p *Payment) Amount() shared.Money         { return p.amount }
+func (p *Payment) RefundedAmount() shared.Money { return p.refundedAmount }
+func (p *Payment) Status() PaymentStatus        { return p.status }
+func (p *Payment) AuthorizationID() string      { return p.authorizationID }
+func (p *Payment) FailureReason() string        { return p.failureReason }
+func (p *Payment) CreatedAt() time.Time         { return p.createdAt }
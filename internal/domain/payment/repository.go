@@ -0,0 +1,10 @@
+package payment
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+
+// PaymentRepository defines persistence operations for Payment aggregate
+type PaymentRepository interface {
+    Save(payment *Payment) error
+    FindByID(id PaymentID) (*Payment, error)
+    FindByOrderID(orderID order.OrderID) (*Payment, error)
+}
@@ -0,0 +1,49 @@
+package payment
+
+import "github.com/google/uuid"
+
+// PaymentID uniquely identifies a payment
+type PaymentID string
+
+func NewPaymentID() PaymentID {
+    return PaymentID(uuid.New().String())
+}
+
+// PaymentStatus represents where the money is in its lifecycle
+type PaymentStatus string
+
+const (
+    PaymentStatusPending           PaymentStatus = "PENDING"
+    PaymentStatusAuthorized        PaymentStatus = "AUTHORIZED"
+    PaymentStatusCaptured          PaymentStatus = "CAPTURED"
+    PaymentStatusVoided            PaymentStatus = "VOIDED"
+    PaymentStatusPartiallyRefunded PaymentStatus = "PARTIALLY_REFUNDED"
+    PaymentStatusRefunded          PaymentStatus = "REFUNDED"
+    PaymentStatusFailed            PaymentStatus = "FAILED"
+)
+
+// IsValidTransition checks if status transition is allowed
+// WHY: Encodes the card payment state machine, e.g. only authorized funds can be captured
+func (s PaymentStatus) IsValidTransition(to PaymentStatus) bool {
+    validTransitions := map[PaymentStatus][]PaymentStatus{
+        PaymentStatusPending:           {PaymentStatusAuthorized, PaymentStatusFailed},
+        PaymentStatusAuthorized:        {PaymentStatusCaptured, PaymentStatusVoided},
+        PaymentStatusCaptured:          {PaymentStatusPartiallyRefunded, PaymentStatusRefunded},
+        PaymentStatusPartiallyRefunded: {PaymentStatusPartiallyRefunded, PaymentStatusRefunded},
+        PaymentStatusVoided:            {},
+        PaymentStatusRefunded:          {},
+        PaymentStatusFailed:            {},
+    }
+    
+    allowed, exists := validTransitions[s]
+    if !exists {
+        return false
+    }
+    
+    for _, status := range allowed {
+        if status == to {
+            return true
+        }
+    }
+    return false
+}
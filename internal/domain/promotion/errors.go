@@ -0,0 +1,17 @@
+package promotion
+
+import "errors"
+
+// Domain-specific errors
+// WHY: Domain errors express business rule violations
+var (
+    ErrPromotionNotFound   = errors.New("promotion not found")
+    ErrDuplicatePromoCode  = errors.New("promo code already exists")
+    ErrPromotionInactive   = errors.New("promotion is not active")
+    ErrPromotionNotStarted = errors.New("promotion has not started yet")
+    ErrPromotionExpired    = errors.New("promotion has expired")
+    ErrUsageLimitReached   = errors.New("promotion usage limit reached")
+    ErrNotApplicable       = errors.New("order does not qualify for promotion")
+    ErrInvalidRewardType   = errors.New("invalid promotion reward type")
+    ErrRedemptionNotFound  = errors.New("promotion was not redeemed by order")
+)
@@ -0,0 +1,61 @@
+package promotion
+
+import (
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// PromotionCreatedEvent is raised when a new promo code is set up
+type PromotionCreatedEvent struct {
+    shared.BaseEvent
+    PromotionID string    `json:"promotion_id"`
+    Code        string    `json:"code"`
+    RewardType  string    `json:"reward_type"`
+    ValidFrom   time.Time `json:"valid_from"`
+    ValidUntil  time.Time `json:"valid_until"`
+}
+
+func (e PromotionCreatedEvent) EventName() string     { return "promotion.created" }
+func (e PromotionCreatedEvent) AggregateID() string   { return e.PromotionID }
+func (e PromotionCreatedEvent) AggregateType() string { return "promotion" }
+
+// PromotionRedeemedEvent tracks each use of a promo code
+type PromotionRedeemedEvent struct {
+    shared.BaseEvent
+    PromotionID    string       `json:"promotion_id"`
+    Code           string       `json:"code"`
+    CustomerID     string       `json:"customer_id"`
+    OrderID        string       `json:"order_id"`
+    DiscountAmount shared.Money `json:"discount_amount"`
+    TotalUses      int          `json:"total_uses"`
+}
+
+func (e PromotionRedeemedEvent) EventName() string     { return "promotion.redeemed" }
+func (e PromotionRedeemedEvent) AggregateID() string   { return e.PromotionID }
+func (e PromotionRedeemedEvent) AggregateType() string { return "promotion" }
+
+// PromotionRedemptionReleasedEvent gives back a use when the order is cancelled
+type PromotionRedemptionReleasedEvent struct {
+    shared.BaseEvent
+    PromotionID string `json:"promotion_id"`
+    Code        string `json:"code"`
+    CustomerID  string `json:"customer_id"`
+    OrderID     string `json:"order_id"`
+    TotalUses   int    `json:"total_uses"`
+}
+
+func (e PromotionRedemptionReleasedEvent) EventName() string     { return "promotion.redemption_released" }
+func (e PromotionRedemptionReleasedEvent) AggregateID() string   { return e.PromotionID }
+func (e PromotionRedemptionReleasedEvent) AggregateType() string { return "promotion" }
+
+// PromotionDisabledEvent is raised when a promo code is switched off
+type PromotionDisabledEvent struct {
+    shared.BaseEvent
+    PromotionID string `json:"promotion_id"`
+    Code        string `json:"code"`
+}
+
+func (e PromotionDisabledEvent) EventName() string     { return "promotion.disabled" }
+func (e PromotionDisabledEvent) AggregateID() string   { return e.PromotionID }
+func (e PromotionDisabledEvent) AggregateType() string { return "promotion" }
@@ -0,0 +1,275 @@
+package promotion
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// Promotion is the aggregate root for promo codes and coupons
+// WHY: Usage limits must be enforced across all orders, so promotions own their counters
+// WHAT: Decides whether an order qualifies and how much it saves
+type Promotion struct {
+    shared.AggregateRoot
+    id             PromotionID
+    code           PromoCode
+    description    string
+    reward         Reward
+    minimumSpend   shared.Money // Zero value means no minimum
+    validFrom      time.Time
+    validUntil     time.Time
+    limits         UsageLimits
+    totalUses      int
+    usesByCustomer map[customer.CustomerID]int
+    redemptions    map[order.OrderID]customer.CustomerID // Who placed each order that used the code
+    isActive       bool
+}
+
+// NewPromotion creates a new promotion
+// WHERE: Called when back-office sets up a weekend promotion
+func NewPromotion(
+    code string,
+    description string,
+    reward Reward,
+    validFrom time.Time,
+    validUntil time.Time,
+    limits UsageLimits,
+) (*Promotion, error) {
+    promoCode, err := NewPromoCode(code)
+    if err != nil {
+        return nil, err
+    }
+    
+    if reward.Type() == "" {
+        return nil, errors.New("reward is required")
+    }
+    
+    if !validUntil.After(validFrom) {
+        return nil, errors.New("promotion must end after it starts")
+    }
+    
+    promotion := &Promotion{
+        id:             NewPromotionID(),
+        code:           promoCode,
+        description:    description,
+        reward:         reward,
+        validFrom:      validFrom,
+        validUntil:     validUntil,
+        limits:         limits,
+        usesByCustomer: make(map[customer.CustomerID]int),
+        redemptions:    make(map[order.OrderID]customer.CustomerID),
+        isActive:       true,
+    }
+    
+    // Raise domain event
+    promotion.Raise(PromotionCreatedEvent{
+        BaseEvent:   shared.NewBaseEvent(),
+        PromotionID: string(promotion.id),
+        Code:        string(promoCode),
+        RewardType:  string(reward.Type()),
+        ValidFrom:   validFrom,
+        ValidUntil:  validUntil,
+    })
+    
+    return promotion, nil
+}
+
+// RequireMinimumSpend only lets orders whose items add up to amount qualify
+func (p *Promotion) RequireMinimumSpend(amount shared.Money) error {
+    if amount.Amount() <= 0 {
+        return errors.New("minimum spend must be positive")
+    }
+    p.minimumSpend = amount
+    return nil
+}
+
+// DiscountFor checks an order qualifies and returns the discount it earns
+// WHY: Eligibility rules are specifications so they compose like other order rules
+// WHERE: Called while pricing an order, before Redeem counts the use
+func (p *Promotion) DiscountFor(orderAgg *order.Order, now time.Time) (order.Discount, error) {
+    err := p.checkAvailable(orderAgg.CustomerID(), now)
+    if err != nil {
+        return order.Discount{}, err
+    }
+    
+    if !p.eligibility().IsSatisfiedBy(orderAgg) {
+        return order.Discount{}, ErrNotApplicable
+    }
+    
+    description := p.description
+    if description == "" {
+        description = "Promo " + string(p.code)
+    }
+    
+    switch p.reward.Type() {
+    case RewardTypePercentOff:
+        return order.NewPercentageDiscount(order.DiscountTypePromotion, description, p.reward.Rate())
+    case RewardTypeFixedAmountOff:
+        if p.reward.Amount().Currency() != orderAgg.Subtotal().Currency() {
+            return order.Discount{}, ErrNotApplicable
+        }
+        return order.NewFixedDiscount(order.DiscountTypePromotion, description, p.reward.Amount())
+    case RewardTypeBuyXGetY:
+        amount, err := p.freeItemsValue(orderAgg)
+        if err != nil {
+            return order.Discount{}, err
+        }
+        return order.NewFixedDiscount(order.DiscountTypePromotion, description, amount)
+    default:
+        return order.Discount{}, fmt.Errorf("unknown reward type %s", p.reward.Type())
+    }
+}
+
+// Redeem counts one use of the promotion by a customer
+// WHAT: Re-checks limits so concurrent orders cannot exceed them
+func (p *Promotion) Redeem(
+    customerID customer.CustomerID,
+    orderID order.OrderID,
+    discountAmount shared.Money,
+    now time.Time,
+) error {
+    err := p.checkAvailable(customerID, now)
+    if err != nil {
+        return err
+    }
+    
+    p.totalUses++
+    p.usesByCustomer[customerID]++
+    p.redemptions[orderID] = customerID
+    
+    p.Raise(PromotionRedeemedEvent{
+        BaseEvent:      shared.NewBaseEvent(),
+        PromotionID:    string(p.id),
+        Code:           string(p.code),
+        CustomerID:     string(customerID),
+        OrderID:        string(orderID),
+        DiscountAmount: discountAmount,
+        TotalUses:      p.totalUses,
+    })
+    
+    return nil
+}
+
+// ReleaseRedemption gives back the use counted for an order
+// WHY: A cancelled order never got its discount, so it shouldn't use up the limits
+// WHERE: Called when the order is cancelled
+func (p *Promotion) ReleaseRedemption(orderID order.OrderID) error {
+    customerID, exists := p.redemptions[orderID]
+    if !exists {
+        return ErrRedemptionNotFound
+    }
+    
+    delete(p.redemptions, orderID)
+    p.totalUses--
+    p.usesByCustomer[customerID]--
+    
+    p.Raise(PromotionRedemptionReleasedEvent{
+        BaseEvent:   shared.NewBaseEvent(),
+        PromotionID: string(p.id),
+        Code:        string(p.code),
+        CustomerID:  string(customerID),
+        OrderID:     string(orderID),
+        TotalUses:   p.totalUses,
+    })
+    
+    return nil
+}
+
+// Disable stops the code from being accepted
+// WHAT: Soft delete - usage history is kept
+func (p *Promotion) Disable() error {
+    if !p.isActive {
+        return ErrPromotionInactive
+    }
+    
+    p.isActive = false
+    
+    p.Raise(PromotionDisabledEvent{
+        BaseEvent:   shared.NewBaseEvent(),
+        PromotionID: string(p.id),
+        Code:        string(p.code),
+    })
+    
+    return nil
+}
+
+// checkAvailable enforces active flag, validity window and usage limits
+func (p *Promotion) checkAvailable(customerID customer.CustomerID, now time.Time) error {
+    switch {
+    case !p.isActive:
+        return ErrPromotionInactive
+    case now.Before(p.validFrom):
+        return ErrPromotionNotStarted
+    case !now.Before(p.validUntil):
+        return ErrPromotionExpired
+    case p.limits.MaxUses() > 0 && p.totalUses >= p.limits.MaxUses():
+        return ErrUsageLimitReached
+    case p.limits.MaxUsesPerCustomer() > 0 && p.usesByCustomer[customerID] >= p.limits.MaxUsesPerCustomer():
+        return ErrUsageLimitReached
+    }
+    return nil
+}
+
+// eligibility builds the specification an order must satisfy
+func (p *Promotion) eligibility() order.Specification {
+    var spec order.Specification = anyOrderSpec{}
+    
+    if !p.minimumSpend.IsZero() {
+        spec = order.NewAndSpec(spec, order.NewMinimumSpendSpec(p.minimumSpend))
+    }
+    
+    if p.reward.Type() == RewardTypeBuyXGetY {
+        groupSize := p.reward.BuyQuantity() + p.reward.FreeQuantity()
+        spec = order.NewAndSpec(spec, order.NewContainsProductSpec(p.reward.ProductID(), groupSize))
+    }
+    
+    return spec
+}
+
+// freeItemsValue prices the free units of a buy-X-get-Y deal
+// WHAT: The cheapest matching unit price is used when a product appears on several lines
+func (p *Promotion) freeItemsValue(orderAgg *order.Order) (shared.Money, error) {
+    quantity := 0
+    var unitPrice shared.Money
+    for _, item := range orderAgg.Items() {
+        if item.ProductID() != p.reward.ProductID() {
+            continue
+        }
+        quantity += item.Quantity()
+        if unitPrice.IsZero() || item.UnitPrice().Amount() < unitPrice.Amount() {
+            unitPrice = item.UnitPrice()
+        }
+    }
+    
+    groupSize := p.reward.BuyQuantity() + p.reward.FreeQuantity()
+    freeUnits := (quantity / groupSize) * p.reward.FreeQuantity()
+    if freeUnits == 0 {
+        return shared.Money{}, ErrNotApplicable
+    }
+    
+    return unitPrice.Multiply(freeUnits), nil
+}
+
+// anyOrderSpec is satisfied by every order, the starting point for eligibility
+type anyOrderSpec struct{}
+
+func (anyOrderSpec) IsSatisfiedBy(candidate interface{}) bool {
+    _, ok := candidate.(*order.Order)
+    return ok
+}
+
+// Getters
+func (p *Promotion) ID() PromotionID            { return p.id }
+func (p *Promotion) Code() PromoCode            { return p.code }
+func (p *Promotion) Description() string        { return p.description }
+func (p *Promotion) Reward() Reward             { return p.reward }
+func (p *Promotion) MinimumSpend() shared.Money { return p.minimumSpend }
+func (p *Promotion) ValidFrom() time.Time       { return p.validFrom }
+func (p *Promotion) ValidUntil() time.Time      { return p.validUntil }
+func (p *Promotion) Limits() UsageLimits        { return p.limits }
+func (p *Promotion) TotalUses() int             { return p.totalUses }
+func (p *Promotion) IsActive() bool             { return p.isActive }
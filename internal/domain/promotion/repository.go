@@ -0,0 +1,9 @@
+package promotion
+
+// PromotionRepository defines persistence operations for Promotion aggregate
+type PromotionRepository interface {
+    Save(promotion *Promotion) error
+    FindByID(id PromotionID) (*Promotion, error)
+    FindByCode(code PromoCode) (*Promotion, error)
+    FindAll() ([]*Promotion, error)
+}
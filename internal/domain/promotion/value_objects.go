@@ -0,0 +1,109 @@
+package promotion
+
+import (
+    "errors"
+    "regexp"
+    "strings"
+
+    "github.com/google/uuid"
+    "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+    "github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// PromotionID uniquely identifies a promotion
+type PromotionID string
+
+func NewPromotionID() PromotionID {
+    return PromotionID(uuid.New().String())
+}
+
+// PromoCode is the code customers type at checkout
+// WHY: Codes are case-insensitive for customers, so they are stored upper-cased
+type PromoCode string
+
+var promoCodeRegex = regexp.MustCompile(`^[A-Z0-9_-]{3,20}$`)
+
+func NewPromoCode(code string) (PromoCode, error) {
+    normalized := strings.ToUpper(strings.TrimSpace(code))
+    if !promoCodeRegex.MatchString(normalized) {
+        return "", errors.New("promo code must be 3-20 letters, digits, '-' or '_'")
+    }
+    return PromoCode(normalized), nil
+}
+
+// RewardType identifies how a promotion discounts an order
+type RewardType string
+
+const (
+    RewardTypePercentOff     RewardType = "PERCENT_OFF"
+    RewardTypeFixedAmountOff RewardType = "FIXED_AMOUNT_OFF"
+    RewardTypeBuyXGetY       RewardType = "BUY_X_GET_Y"
+)
+
+// Reward is a value object describing what a promotion gives the customer
+// WHAT: Only the fields relevant to the reward type are set
+type Reward struct {
+    rewardType   RewardType
+    rate         float64         // PERCENT_OFF: fraction of subtotal
+    amount       shared.Money    // FIXED_AMOUNT_OFF: amount taken off
+    productID    store.ProductID // BUY_X_GET_Y: product the deal applies to
+    buyQuantity  int             // BUY_X_GET_Y: units that must be paid for
+    freeQuantity int             // BUY_X_GET_Y: units given free per paid group
+}
+
+// NewPercentOffReward takes a fraction of the subtotal off, e.g. 0.15 for 15%
+func NewPercentOffReward(rate float64) (Reward, error) {
+    if rate <= 0 || rate >= 1 {
+        return Reward{}, errors.New("percent off must be between 0 and 100")
+    }
+    return Reward{rewardType: RewardTypePercentOff, rate: rate}, nil
+}
+
+// NewFixedAmountOffReward takes a set amount off the order
+func NewFixedAmountOffReward(amount shared.Money) (Reward, error) {
+    if amount.Amount() <= 0 {
+        return Reward{}, errors.New("amount off must be positive")
+    }
+    return Reward{rewardType: RewardTypeFixedAmountOff, amount: amount}, nil
+}
+
+// NewBuyXGetYReward gives freeQuantity units of a product for every buyQuantity bought
+func NewBuyXGetYReward(productID store.ProductID, buyQuantity int, freeQuantity int) (Reward, error) {
+    if productID == "" {
+        return Reward{}, errors.New("product is required for buy-X-get-Y")
+    }
+    if buyQuantity <= 0 || freeQuantity <= 0 {
+        return Reward{}, errors.New("buy and free quantities must be positive")
+    }
+    return Reward{
+        rewardType:   RewardTypeBuyXGetY,
+        productID:    productID,
+        buyQuantity:  buyQuantity,
+        freeQuantity: freeQuantity,
+    }, nil
+}
+
+// Getters for encapsulation
+func (r Reward) Type() RewardType           { return r.rewardType }
+func (r Reward) Rate() float64              { return r.rate }
+func (r Reward) Amount() shared.Money       { return r.amount }
+func (r Reward) ProductID() store.ProductID { return r.productID }
+func (r Reward) BuyQuantity() int           { return r.buyQuantity }
+func (r Reward) FreeQuantity() int          { return r.freeQuantity }
+
+// UsageLimits caps how often a promotion can be redeemed
+// WHAT: Zero means unlimited
+type UsageLimits struct {
+    maxUses            int
+    maxUsesPerCustomer int
+}
+
+func NewUsageLimits(maxUses int, maxUsesPerCustomer int) (UsageLimits, error) {
+    if maxUses < 0 || maxUsesPerCustomer < 0 {
+        return UsageLimits{}, errors.New("usage limits cannot be negative")
+    }
+    return UsageLimits{maxUses: maxUses, maxUsesPerCustomer: maxUsesPerCustomer}, nil
+}
+
+func (l UsageLimits) MaxUses() int            { return l.maxUses }
+func (l UsageLimits) MaxUsesPerCustomer() int { return l.maxUsesPerCustomer }
@@ -0,0 +1,16 @@
+package purchasing
+
+import "errors"
+
+// Domain-specific errors
+// WHY: Domain errors express business rule violations
+var (
+    ErrSupplierNotFound         = errors.New("supplier not found")
+    ErrSupplierInactive         = errors.New("supplier is not active")
+    ErrPurchaseOrderNotFound    = errors.New("purchase order not found")
+    ErrInvalidStatusTransition  = errors.New("invalid purchase order status transition")
+    ErrPurchaseOrderNotEditable = errors.New("purchase order can only be changed while in draft")
+    ErrEmptyPurchaseOrder       = errors.New("purchase order has no lines")
+    ErrReceiptExceedsOrdered    = errors.New("received quantity exceeds quantity outstanding")
+    ErrLineNotFound             = errors.New("purchase order line not found")
+)
@@ -0,0 +1,65 @@
+package purchasing
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+
+// SupplierRegisteredEvent is raised when a new supplier is onboarded
+type SupplierRegisteredEvent struct {
+	shared.BaseEvent
+	SupplierID   string `json:"supplier_id"`
+	SupplierName string `json:"supplier_name"`
+}
+
+func (e SupplierRegisteredEvent) EventName() string     { return "supplier.registered" }
+func (e SupplierRegisteredEvent) AggregateID() string   { return e.SupplierID }
+func (e SupplierRegisteredEvent) AggregateType() string { return "supplier" }
+
+// PurchaseOrderLineSnapshot captures a purchase order line for events
+type PurchaseOrderLineSnapshot struct {
+	LineID   string       `json:"line_id"`
+	ItemType string       `json:"item_type"`
+	ItemID   string       `json:"item_id"`
+	ItemName string       `json:"item_name"`
+	Quantity int          `json:"quantity"`
+	UnitCost shared.Money `json:"unit_cost"`
+}
+
+// PurchaseOrderSubmittedEvent is raised when a purchase order is sent to the supplier
+// WHERE: Used to email the order to the supplier
+type PurchaseOrderSubmittedEvent struct {
+	shared.BaseEvent
+	PurchaseOrderID string                      `json:"purchase_order_id"`
+	SupplierID      string                      `json:"supplier_id"`
+	StoreID         string                      `json:"store_id"`
+	Lines           []PurchaseOrderLineSnapshot `json:"lines"`
+	TotalCost       shared.Money                `json:"total_cost"`
+}
+
+func (e PurchaseOrderSubmittedEvent) EventName() string     { return "purchase_order.submitted" }
+func (e PurchaseOrderSubmittedEvent) AggregateID() string   { return e.PurchaseOrderID }
+func (e PurchaseOrderSubmittedEvent) AggregateType() string { return "purchase_order" }
+
+// GoodsReceivedEvent is raised when a delivery is booked against a purchase order
+// WHERE: Used by accounts payable to match supplier invoices
+type GoodsReceivedEvent struct {
+	shared.BaseEvent
+	PurchaseOrderID string                      `json:"purchase_order_id"`
+	StoreID         string                      `json:"store_id"`
+	Lines           []PurchaseOrderLineSnapshot `json:"lines"` // Quantities received in this delivery
+	ReceivedCost    shared.Money                `json:"received_cost"`
+	Status          string                      `json:"status"`
+}
+
+func (e GoodsReceivedEvent) EventName() string     { return "purchase_order.goods_received" }
+func (e GoodsReceivedEvent) AggregateID() string   { return e.PurchaseOrderID }
+func (e GoodsReceivedEvent) AggregateType() string { return "purchase_order" }
+
+// PurchaseOrderCancelledEvent is raised when the rest of a purchase order won't be delivered
+type PurchaseOrderCancelledEvent struct {
+	shared.BaseEvent
+	PurchaseOrderID string `json:"purchase_order_id"`
+	Reason          string `json:"reason"`
+}
+
+func (e PurchaseOrderCancelledEvent) EventName() string     { return "purchase_order.cancelled" }
+func (e PurchaseOrderCancelledEvent) AggregateID() string   { return e.PurchaseOrderID }
+func (e PurchaseOrderCancelledEvent) AggregateType() string { return "purchase_order" }
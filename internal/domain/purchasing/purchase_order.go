@@ -0,0 +1,259 @@
+package purchasing
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// PurchaseOrderLine is an entity for one item ordered from a supplier
+type PurchaseOrderLine struct {
+    id               string
+    item             store.StockItem
+    itemName         string
+    quantityOrdered  int
+    quantityReceived int
+    unitCost         shared.Money
+}
+
+func (l *PurchaseOrderLine) ID() string               { return l.id }
+func (l *PurchaseOrderLine) Item() store.StockItem    { return l.item }
+func (l *PurchaseOrderLine) ItemName() string         { return l.itemName }
+func (l *PurchaseOrderLine) QuantityOrdered() int     { return l.quantityOrdered }
+func (l *PurchaseOrderLine) QuantityReceived() int    { return l.quantityReceived }
+func (l *PurchaseOrderLine) QuantityOutstanding() int { return l.quantityOrdered - l.quantityReceived }
+func (l *PurchaseOrderLine) UnitCost() shared.Money   { return l.unitCost }
+func (l *PurchaseOrderLine) LineCost() shared.Money   { return l.unitCost.Multiply(l.quantityOrdered) }
+
+// LineReceipt records how much of a line arrived in a delivery
+type LineReceipt struct {
+    LineID   string
+    Quantity int
+}
+
+// ReceivedLine is what a delivery adds to the store's stock
+type ReceivedLine struct {
+    Item     store.StockItem
+    Quantity int
+}
+
+// PurchaseOrder is the aggregate root for stock bought from a supplier
+// WHY: Restocking goes through an order so every delivery is matched to what was bought and paid for
+// WHAT: Tracks ordered vs received quantities through draft, submission and delivery
+type PurchaseOrder struct {
+    shared.AggregateRoot
+    id          PurchaseOrderID
+    supplierID  SupplierID
+    storeID     store.StoreID
+    status      PurchaseOrderStatus
+    lines       []*PurchaseOrderLine
+    currency    string
+    createdAt   time.Time
+    submittedAt time.Time
+}
+
+// NewPurchaseOrder starts a draft purchase order for a store
+// WHERE: Called when the ops team plans a restock
+func NewPurchaseOrder(supplierID SupplierID, storeID store.StoreID, currency string) (*PurchaseOrder, error) {
+    if currency == "" {
+        return nil, errors.New("currency is required")
+    }
+    
+    return &PurchaseOrder{
+        id:         NewPurchaseOrderID(),
+        supplierID: supplierID,
+        storeID:    storeID,
+        status:     PurchaseOrderStatusDraft,
+        currency:   currency,
+        createdAt:  time.Now(),
+    }, nil
+}
+
+// AddLine adds an item to a draft purchase order
+func (po *PurchaseOrder) AddLine(item store.StockItem, itemName string, quantity int, unitCost shared.Money) error {
+    if po.status != PurchaseOrderStatusDraft {
+        return ErrPurchaseOrderNotEditable
+    }
+    
+    if quantity <= 0 {
+        return errors.New("quantity must be positive")
+    }
+    
+    if unitCost.Currency() != po.currency {
+        return fmt.Errorf("unit cost must be in %s", po.currency)
+    }
+    
+    for _, line := range po.lines {
+        if line.item == item {
+            return fmt.Errorf("%s is already on this purchase order", itemName)
+        }
+    }
+    
+    po.lines = append(po.lines, &PurchaseOrderLine{
+        id:              uuid.New().String(),
+        item:            item,
+        itemName:        itemName,
+        quantityOrdered: quantity,
+        unitCost:        unitCost,
+    })
+    
+    return nil
+}
+
+// Submit sends the purchase order to the supplier
+func (po *PurchaseOrder) Submit() error {
+    if !po.status.IsValidTransition(PurchaseOrderStatusSubmitted) {
+        return fmt.Errorf("%w: cannot submit purchase order in %s status", ErrInvalidStatusTransition, po.status)
+    }
+    
+    if len(po.lines) == 0 {
+        return ErrEmptyPurchaseOrder
+    }
+    
+    po.status = PurchaseOrderStatusSubmitted
+    po.submittedAt = time.Now()
+    
+    lines := make([]PurchaseOrderLineSnapshot, len(po.lines))
+    for i, line := range po.lines {
+        lines[i] = snapshotLine(line, line.quantityOrdered)
+    }
+    
+    // Raise domain event
+    po.Raise(PurchaseOrderSubmittedEvent{
+        BaseEvent:       shared.NewBaseEvent(),
+        PurchaseOrderID: string(po.id),
+        SupplierID:      string(po.supplierID),
+        StoreID:         string(po.storeID),
+        Lines:           lines,
+        TotalCost:       po.TotalCost(),
+    })
+    
+    return nil
+}
+
+// Receive books a delivery against the purchase order
+// WHY: Short deliveries leave the order partially received so the rest can still arrive
+// WHAT: Returns what to add to the store's stock
+func (po *PurchaseOrder) Receive(receipts []LineReceipt) ([]ReceivedLine, error) {
+    if len(receipts) == 0 {
+        return nil, errors.New("nothing to receive")
+    }
+    
+    // Validate every receipt before changing anything
+    quantities := make(map[string]int, len(receipts))
+    for _, receipt := range receipts {
+        line, err := po.Line(receipt.LineID)
+        if err != nil {
+            return nil, err
+        }
+        if receipt.Quantity <= 0 {
+            return nil, errors.New("received quantity must be positive")
+        }
+        quantities[line.id] += receipt.Quantity
+        if quantities[line.id] > line.QuantityOutstanding() {
+            return nil, fmt.Errorf("%w: %d of %s outstanding",
+                ErrReceiptExceedsOrdered, line.QuantityOutstanding(), line.itemName)
+        }
+    }
+    
+    status := PurchaseOrderStatusReceived
+    for _, line := range po.lines {
+        if line.QuantityOutstanding() > quantities[line.id] {
+            status = PurchaseOrderStatusPartiallyReceived
+        }
+    }
+    
+    if !po.status.IsValidTransition(status) {
+        return nil, fmt.Errorf("%w: cannot receive goods in %s status", ErrInvalidStatusTransition, po.status)
+    }
+    
+    var received []ReceivedLine
+    var snapshots []PurchaseOrderLineSnapshot
+    receivedCost, _ := shared.NewMoney(0, po.currency)
+    for _, line := range po.lines {
+        quantity := quantities[line.id]
+        if quantity == 0 {
+            continue
+        }
+        
+        line.quantityReceived += quantity
+        received = append(received, ReceivedLine{Item: line.item, Quantity: quantity})
+        snapshots = append(snapshots, snapshotLine(line, quantity))
+        receivedCost, _ = receivedCost.Add(line.unitCost.Multiply(quantity))
+    }
+    po.status = status
+    
+    // Raise domain event
+    po.Raise(GoodsReceivedEvent{
+        BaseEvent:       shared.NewBaseEvent(),
+        PurchaseOrderID: string(po.id),
+        StoreID:         string(po.storeID),
+        Lines:           snapshots,
+        ReceivedCost:    receivedCost,
+        Status:          string(status),
+    })
+    
+    return received, nil
+}
+
+// Cancel closes the purchase order, anything not yet delivered won't be
+func (po *PurchaseOrder) Cancel(reason string) error {
+    if !po.status.IsValidTransition(PurchaseOrderStatusCancelled) {
+        return fmt.Errorf("%w: cannot cancel purchase order in %s status", ErrInvalidStatusTransition, po.status)
+    }
+    
+    po.status = PurchaseOrderStatusCancelled
+    
+    // Raise domain event
+    po.Raise(PurchaseOrderCancelledEvent{
+        BaseEvent:       shared.NewBaseEvent(),
+        PurchaseOrderID: string(po.id),
+        Reason:          reason,
+    })
+    
+    return nil
+}
+
+// TotalCost is what the whole purchase order costs
+func (po *PurchaseOrder) TotalCost() shared.Money {
+    total, _ := shared.NewMoney(0, po.currency)
+    for _, line := range po.lines {
+        total, _ = total.Add(line.LineCost())
+    }
+    return total
+}
+
+// Line returns the purchase order line with the given ID
+func (po *PurchaseOrder) Line(lineID string) (*PurchaseOrderLine, error) {
+    for _, line := range po.lines {
+        if line.id == lineID {
+            return line, nil
+        }
+    }
+    return nil, fmt.Errorf("%w: %s", ErrLineNotFound, lineID)
+}
+
+func snapshotLine(line *PurchaseOrderLine, quantity int) PurchaseOrderLineSnapshot {
+    return PurchaseOrderLineSnapshot{
+        LineID:   line.id,
+        ItemType: string(line.item.Type()),
+        ItemID:   line.item.ID(),
+        ItemName: line.itemName,
+        Quantity: quantity,
+        UnitCost: line.unitCost,
+    }
+}
+
+// Getters
+func (po *PurchaseOrder) ID() PurchaseOrderID          { return po.id }
+func (po *PurchaseOrder) SupplierID() SupplierID       { return po.supplierID }
+func (po *PurchaseOrder) StoreID() store.StoreID       { return po.storeID }
+func (po *PurchaseOrder) Status() PurchaseOrderStatus  { return po.status }
+func (po *PurchaseOrder) Lines() []*PurchaseOrderLine  { return po.lines }
+func (po *PurchaseOrder) Currency() string             { return po.currency }
+func (po *PurchaseOrder) CreatedAt() time.Time         { return po.createdAt }
+func (po *PurchaseOrder) SubmittedAt() time.Time       { return po.submittedAt }
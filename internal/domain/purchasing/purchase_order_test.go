@@ -0,0 +1,128 @@
+package purchasing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// newSubmittedOrder creates a submitted purchase order for 10 lemons and 4 bags of sugar
+func newSubmittedOrder(t *testing.T) (*PurchaseOrder, []*PurchaseOrderLine) {
+    t.Helper()
+    purchaseOrder, err := NewPurchaseOrder(NewSupplierID(), store.NewStoreID(), "USD")
+    if err != nil {
+        t.Fatalf("NewPurchaseOrder: %v", err)
+    }
+    
+    unitCost, _ := shared.NewMoney(50, "USD")
+    if err := purchaseOrder.AddLine(store.IngredientStockItem(store.NewIngredientID()), "Lemons", 10, unitCost); err != nil {
+        t.Fatalf("AddLine: %v", err)
+    }
+    if err := purchaseOrder.AddLine(store.IngredientStockItem(store.NewIngredientID()), "Sugar", 4, unitCost); err != nil {
+        t.Fatalf("AddLine: %v", err)
+    }
+    if err := purchaseOrder.Submit(); err != nil {
+        t.Fatalf("Submit: %v", err)
+    }
+    purchaseOrder.PullEvents()
+    
+    return purchaseOrder, purchaseOrder.Lines()
+}
+
+func TestReceiveShortDeliveryLeavesOrderOpen(t *testing.T) {
+    purchaseOrder, lines := newSubmittedOrder(t)
+    
+    received, err := purchaseOrder.Receive([]LineReceipt{{LineID: lines[0].ID(), Quantity: 6}})
+    if err != nil {
+        t.Fatalf("Receive: %v", err)
+    }
+    
+    if len(received) != 1 || received[0].Quantity != 6 || received[0].Item != lines[0].Item() {
+        t.Errorf("received = %+v, want 6 of the first line", received)
+    }
+    if purchaseOrder.Status() != PurchaseOrderStatusPartiallyReceived {
+        t.Errorf("status = %s, want %s", purchaseOrder.Status(), PurchaseOrderStatusPartiallyReceived)
+    }
+    if got := lines[0].QuantityOutstanding(); got != 4 {
+        t.Errorf("outstanding = %d, want 4", got)
+    }
+    
+    _, err = purchaseOrder.Receive([]LineReceipt{
+        {LineID: lines[0].ID(), Quantity: 4},
+        {LineID: lines[1].ID(), Quantity: 4},
+    })
+    if err != nil {
+        t.Fatalf("Receive rest: %v", err)
+    }
+    if purchaseOrder.Status() != PurchaseOrderStatusReceived {
+        t.Errorf("status = %s, want %s", purchaseOrder.Status(), PurchaseOrderStatusReceived)
+    }
+}
+
+func TestReceiveRejectsWholeDeliveryOnOneBadLine(t *testing.T) {
+    purchaseOrder, lines := newSubmittedOrder(t)
+    
+    _, err := purchaseOrder.Receive([]LineReceipt{
+        {LineID: lines[0].ID(), Quantity: 5},
+        {LineID: lines[1].ID(), Quantity: 5},
+    })
+    if !errors.Is(err, ErrReceiptExceedsOrdered) {
+        t.Fatalf("err = %v, want ErrReceiptExceedsOrdered", err)
+    }
+    
+    if got := lines[0].QuantityReceived(); got != 0 {
+        t.Errorf("first line received %d, want 0", got)
+    }
+    if purchaseOrder.Status() != PurchaseOrderStatusSubmitted {
+        t.Errorf("status = %s, want %s", purchaseOrder.Status(), PurchaseOrderStatusSubmitted)
+    }
+}
+
+func TestReceiveAgainstClosedOrderFails(t *testing.T) {
+    purchaseOrder, lines := newSubmittedOrder(t)
+    
+    _, err := purchaseOrder.Receive([]LineReceipt{
+        {LineID: lines[0].ID(), Quantity: 10},
+        {LineID: lines[1].ID(), Quantity: 4},
+    })
+    if err != nil {
+        t.Fatalf("Receive: %v", err)
+    }
+    
+    _, err = purchaseOrder.Receive([]LineReceipt{{LineID: lines[0].ID(), Quantity: 1}})
+    if !errors.Is(err, ErrReceiptExceedsOrdered) {
+        t.Errorf("err = %v, want ErrReceiptExceedsOrdered", err)
+    }
+}
+
+func TestLineLooksUpByID(t *testing.T) {
+    purchaseOrder, lines := newSubmittedOrder(t)
+    
+    line, err := purchaseOrder.Line(lines[1].ID())
+    if err != nil || line != lines[1] {
+        t.Errorf("Line(%s) = %v, %v, want the second line", lines[1].ID(), line, err)
+    }
+    
+    _, err = purchaseOrder.Line("missing")
+    if !errors.Is(err, ErrLineNotFound) {
+        t.Errorf("Line(missing) err = %v, want ErrLineNotFound", err)
+    }
+}
+
+func TestReceiveBeforeSubmitFails(t *testing.T) {
+    purchaseOrder, err := NewPurchaseOrder(NewSupplierID(), store.NewStoreID(), "USD")
+    if err != nil {
+        t.Fatalf("NewPurchaseOrder: %v", err)
+    }
+    unitCost, _ := shared.NewMoney(50, "USD")
+    if err := purchaseOrder.AddLine(store.IngredientStockItem(store.NewIngredientID()), "Cups", 100, unitCost); err != nil {
+        t.Fatalf("AddLine: %v", err)
+    }
+    
+    _, err = purchaseOrder.Receive([]LineReceipt{{LineID: purchaseOrder.Lines()[0].ID(), Quantity: 100}})
+    if !errors.Is(err, ErrInvalidStatusTransition) {
+        t.Errorf("err = %v, want ErrInvalidStatusTransition", err)
+    }
+}
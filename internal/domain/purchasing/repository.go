@@ -0,0 +1,17 @@
+package purchasing
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+
+// SupplierRepository defines persistence operations for Supplier aggregate
+type SupplierRepository interface {
+    Save(supplier *Supplier) error
+    FindByID(id SupplierID) (*Supplier, error)
+    FindAll() ([]*Supplier, error)
+}
+
+// PurchaseOrderRepository defines persistence operations for PurchaseOrder aggregate
+type PurchaseOrderRepository interface {
+    Save(purchaseOrder *PurchaseOrder) error
+    FindByID(id PurchaseOrderID) (*PurchaseOrder, error)
+    FindByStoreID(storeID store.StoreID) ([]*PurchaseOrder, error)
+}
@@ -0,0 +1,57 @@
+package purchasing
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// Supplier is the aggregate root for a business we buy stock from
+// WHY: Purchase orders need a known, active supplier to send to
+type Supplier struct {
+    shared.AggregateRoot
+    id           SupplierID
+    name         string
+    contactEmail string
+    phone        string
+    isActive     bool
+}
+
+// NewSupplier registers a supplier
+// WHERE: Called when the ops team onboards a new vendor
+func NewSupplier(name string, contactEmail string, phone string) (*Supplier, error) {
+    name = strings.TrimSpace(name)
+    if name == "" {
+        return nil, errors.New("supplier name is required")
+    }
+    
+    supplier := &Supplier{
+        id:           NewSupplierID(),
+        name:         name,
+        contactEmail: contactEmail,
+        phone:        phone,
+        isActive:     true,
+    }
+    
+    // Raise domain event
+    supplier.Raise(SupplierRegisteredEvent{
+        BaseEvent:    shared.NewBaseEvent(),
+        SupplierID:   string(supplier.id),
+        SupplierName: name,
+    })
+    
+    return supplier, nil
+}
+
+// Deactivate stops new purchase orders going to this supplier
+func (s *Supplier) Deactivate() {
+    s.isActive = false
+}
+
+// Getters
+func (s *Supplier) ID() SupplierID       { return s.id }
+func (s *Supplier) Name() string         { return s.name }
+func (s *Supplier) ContactEmail() string { return s.contactEmail }
+func (s *Supplier) Phone() string        { return s.phone }
+func (s *Supplier) IsActive() bool       { return s.isActive }
@@ -0,0 +1,55 @@
+package purchasing
+
+import "github.com/google/uuid"
+
+// SupplierID uniquely identifies a supplier
+type SupplierID string
+
+func NewSupplierID() SupplierID {
+    return SupplierID(uuid.New().String())
+}
+
+// PurchaseOrderID uniquely identifies a purchase order
+type PurchaseOrderID string
+
+func NewPurchaseOrderID() PurchaseOrderID {
+    return PurchaseOrderID(uuid.New().String())
+}
+
+// PurchaseOrderStatus represents where a purchase order is in its lifecycle
+type PurchaseOrderStatus string
+
+const (
+    PurchaseOrderStatusDraft             PurchaseOrderStatus = "DRAFT"
+    PurchaseOrderStatusSubmitted         PurchaseOrderStatus = "SUBMITTED"
+    PurchaseOrderStatusPartiallyReceived PurchaseOrderStatus = "PARTIALLY_RECEIVED"
+    PurchaseOrderStatusReceived          PurchaseOrderStatus = "RECEIVED"
+    PurchaseOrderStatusCancelled         PurchaseOrderStatus = "CANCELLED"
+)
+
+// IsValidTransition checks if status transition is allowed
+// WHY: Goods can only arrive against a submitted order, and a received order is closed
+func (s PurchaseOrderStatus) IsValidTransition(to PurchaseOrderStatus) bool {
+    validTransitions := map[PurchaseOrderStatus][]PurchaseOrderStatus{
+        PurchaseOrderStatusDraft: {PurchaseOrderStatusSubmitted, PurchaseOrderStatusCancelled},
+        PurchaseOrderStatusSubmitted: {
+            PurchaseOrderStatusPartiallyReceived,
+            PurchaseOrderStatusReceived,
+            PurchaseOrderStatusCancelled,
+        },
+        PurchaseOrderStatusPartiallyReceived: {
+            PurchaseOrderStatusPartiallyReceived,
+            PurchaseOrderStatusReceived,
+            PurchaseOrderStatusCancelled,
+        },
+        PurchaseOrderStatusReceived:  {},
+        PurchaseOrderStatusCancelled: {},
+    }
+    
+    for _, status := range validTransitions[s] {
+        if status == to {
+            return true
+        }
+    }
+    return false
+}
@@ -3,6 +3,7 @@ package shared
 import (
     "errors"
     "fmt"
+    "math"
 )
 
 // Money is a value object representing monetary amounts
@@ -46,6 +47,33 @@ func (m Money) Multiply(factor int) Money {
     }
 }
 
+// Subtract performs money subtraction with currency validation
+// WHY: Money can never go negative, callers must cap deductions themselves
+func (m Money) Subtract(other Money) (Money, error) {
+    if m.currency != other.currency {
+        return Money{}, fmt.Errorf("cannot subtract different currencies: %s and %s", m.currency, other.currency)
+    }
+    if other.amount > m.amount {
+        return Money{}, errors.New("money amount cannot be negative")
+    }
+    return Money{
+        amount:   m.amount - other.amount,
+        currency: m.currency,
+    }, nil
+}
+
+// Percentage returns the given rate of the amount rounded half up to the nearest cent
+// WHERE: Used for tax and percentage discounts, e.g. Percentage(100000) for 10% off
+func (m Money) Percentage(rate PartsPerMillion) Money {
+    return Money{
+        amount:   (m.amount*int64(rate)*2 + partsPerUnit) / (partsPerUnit * 2),
+        currency: m.currency,
+    }
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool { return m.amount == 0 }
+
 // Getters for encapsulation
 func (m Money) Amount() int64    { return m.amount }
 func (m Money) Currency() string { return m.currency }
@@ -55,3 +83,20 @@ func (m Money) String() string {
     dollars := float64(m.amount) / 100
     return fmt.Sprintf("%.2f %s", dollars, m.currency)
 }
+
+// partsPerUnit is how many parts per million make up the whole amount
+const partsPerUnit = 1000000
+
+// PartsPerMillion is a rate in millionths, e.g. 72500 for 7.25%
+// WHY: Floats can't hold most decimal rates exactly - 7.25% of 200¢ came out
+// as 14.4999¢ and rounded down, so rates are kept as whole numbers instead.
+// Millionths rather than basis points so sales taxes like 8.875% fit exactly
+type PartsPerMillion int64
+
+// PartsPerMillionFrom converts a fractional rate such as 0.0725 to parts per million
+func PartsPerMillionFrom(rate float64) PartsPerMillion {
+    return PartsPerMillion(math.Round(rate * partsPerUnit))
+}
+
+// Fraction returns the rate as a fraction, e.g. 0.0725 for 72500 parts per million
+func (p PartsPerMillion) Fraction() float64 { return float64(p) / partsPerUnit }
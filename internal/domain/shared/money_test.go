@@ -0,0 +1,59 @@
+package shared
+
+import "testing"
+
+func TestPercentageRoundsHalfUp(t *testing.T) {
+    tests := []struct {
+        name   string
+        amount int64
+        rate   PartsPerMillion
+        want   int64
+    }{
+        {name: "exact", amount: 1000, rate: 100000, want: 100},
+        {name: "half cent rounds up", amount: 200, rate: 72500, want: 15},
+        {name: "below half rounds down", amount: 199, rate: 72500, want: 14},
+        {name: "finer than a basis point", amount: 1000, rate: 88750, want: 89},
+        {name: "one part per million", amount: 500000, rate: 1, want: 1},
+        {name: "zero amount", amount: 0, rate: 72500, want: 0},
+    }
+    
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            money, err := NewMoney(tt.amount, "USD")
+            if err != nil {
+                t.Fatalf("NewMoney: %v", err)
+            }
+            
+            got := money.Percentage(tt.rate)
+            if got.Amount() != tt.want {
+                t.Errorf("%d¢ at %d ppm = %d¢, want %d¢", tt.amount, tt.rate, got.Amount(), tt.want)
+            }
+            if got.Currency() != "USD" {
+                t.Errorf("currency = %q, want USD", got.Currency())
+            }
+        })
+    }
+}
+
+func TestPartsPerMillionFrom(t *testing.T) {
+    tests := []struct {
+        rate float64
+        want PartsPerMillion
+    }{
+        {rate: 0.0725, want: 72500},
+        {rate: 0.08875, want: 88750},
+        {rate: 0.10, want: 100000},
+        {rate: 0.01, want: 10000},
+        {rate: 0.000001, want: 1},
+    }
+    
+    for _, tt := range tests {
+        got := PartsPerMillionFrom(tt.rate)
+        if got != tt.want {
+            t.Errorf("PartsPerMillionFrom(%v) = %d, want %d", tt.rate, got, tt.want)
+        }
+        if got.Fraction() != tt.rate {
+            t.Errorf("PartsPerMillionFrom(%v).Fraction() = %v", tt.rate, got.Fraction())
+        }
+    }
+}
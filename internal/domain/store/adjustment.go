@@ -0,0 +1,109 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StockItemType says whether an adjustment counts finished products or ingredients
+type StockItemType string
+
+const (
+    StockItemProduct    StockItemType = "PRODUCT"
+    StockItemIngredient StockItemType = "INGREDIENT"
+)
+
+// StockItem identifies something the store keeps stock of
+// WHY: Lemons spoil and bottles break, so both ingredients and finished units get adjusted
+type StockItem struct {
+    itemType StockItemType
+    id       string
+}
+
+func ProductStockItem(productID ProductID) StockItem {
+    return StockItem{itemType: StockItemProduct, id: string(productID)}
+}
+
+func IngredientStockItem(ingredientID IngredientID) StockItem {
+    return StockItem{itemType: StockItemIngredient, id: string(ingredientID)}
+}
+
+// NewStockItem parses a stock item type and ID from a request
+func NewStockItem(itemType string, id string) (StockItem, error) {
+    switch StockItemType(strings.ToUpper(itemType)) {
+    case StockItemProduct:
+        return ProductStockItem(ProductID(id)), nil
+    case StockItemIngredient:
+        return IngredientStockItem(IngredientID(id)), nil
+    default:
+        return StockItem{}, fmt.Errorf("%w: unknown item type %q", ErrInvalidAdjustment, itemType)
+    }
+}
+
+func (i StockItem) Type() StockItemType { return i.itemType }
+func (i StockItem) ID() string          { return i.id }
+
+// AdjustmentReason explains a stock change that isn't a delivery or a sale
+type AdjustmentReason string
+
+const (
+    AdjustmentSpoilage        AdjustmentReason = "SPOILAGE"
+    AdjustmentBreakage        AdjustmentReason = "BREAKAGE"
+    AdjustmentTheft           AdjustmentReason = "THEFT"
+    AdjustmentCountCorrection AdjustmentReason = "COUNT_CORRECTION"
+    AdjustmentOther           AdjustmentReason = "OTHER"
+)
+
+// NewAdjustmentReason validates a reason code
+func NewAdjustmentReason(reason string) (AdjustmentReason, error) {
+    switch AdjustmentReason(strings.ToUpper(reason)) {
+    case AdjustmentSpoilage, AdjustmentBreakage, AdjustmentTheft, AdjustmentCountCorrection, AdjustmentOther:
+        return AdjustmentReason(strings.ToUpper(reason)), nil
+    default:
+        return "", fmt.Errorf("%w: unknown reason %q", ErrInvalidAdjustment, reason)
+    }
+}
+
+// IsLoss reports whether the reason can only take stock away
+func (r AdjustmentReason) IsLoss() bool {
+    return r == AdjustmentSpoilage || r == AdjustmentBreakage || r == AdjustmentTheft
+}
+
+// Adjustment records one manual change to stock on hand
+// WHY: The store keeps every adjustment so shrinkage can be reported and audited
+type Adjustment struct {
+    id             string
+    item           StockItem
+    itemName       string
+    delta          int
+    quantityBefore int
+    reason         AdjustmentReason
+    note           string
+    recordedAt     time.Time
+}
+
+func newAdjustment(item StockItem, itemName string, delta int, before int, reason AdjustmentReason, note string) Adjustment {
+    return Adjustment{
+        id:             uuid.New().String(),
+        item:           item,
+        itemName:       itemName,
+        delta:          delta,
+        quantityBefore: before,
+        reason:         reason,
+        note:           note,
+        recordedAt:     time.Now(),
+    }
+}
+
+func (a Adjustment) ID() string               { return a.id }
+func (a Adjustment) Item() StockItem          { return a.item }
+func (a Adjustment) ItemName() string         { return a.itemName }
+func (a Adjustment) Delta() int               { return a.delta }
+func (a Adjustment) QuantityBefore() int      { return a.quantityBefore }
+func (a Adjustment) QuantityAfter() int       { return a.quantityBefore + a.delta }
+func (a Adjustment) Reason() AdjustmentReason { return a.reason }
+func (a Adjustment) Note() string             { return a.note }
+func (a Adjustment) RecordedAt() time.Time    { return a.recordedAt }
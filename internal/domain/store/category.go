@@ -0,0 +1,41 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// CategoryID uniquely identifies a menu category within a store
+type CategoryID string
+
+func NewCategoryID() CategoryID {
+    return CategoryID(uuid.New().String())
+}
+
+// Category groups products on the menu, e.g. "Classics" or "Seasonal"
+// WHY: Menus are read by section, so the catalog needs an order both between and within sections
+// WHERE: Owned by the Store aggregate alongside its products
+type Category struct {
+    id           CategoryID
+    name         string
+    displayOrder int // Lower comes first
+}
+
+func newCategory(name string, displayOrder int) (*Category, error) {
+    name = strings.TrimSpace(name)
+    if name == "" {
+        return nil, fmt.Errorf("%w: name is required", ErrInvalidCategory)
+    }
+    
+    return &Category{
+        id:           NewCategoryID(),
+        name:         name,
+        displayOrder: displayOrder,
+    }, nil
+}
+
+func (c *Category) ID() CategoryID    { return c.id }
+func (c *Category) Name() string      { return c.name }
+func (c *Category) DisplayOrder() int { return c.displayOrder }
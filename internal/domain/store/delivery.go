@@ -0,0 +1,107 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// DeliveryBand prices delivery to a group of zip codes
+// WHY: There is no geocoding, so distance from the stand is banded by zip code,
+// e.g. "Within 2 miles" covers the stand's own and neighbouring zip codes
+type DeliveryBand struct {
+    name     string
+    zipCodes []string
+    fee      shared.Money
+}
+
+func NewDeliveryBand(name string, zipCodes []string, fee shared.Money) (DeliveryBand, error) {
+    if strings.TrimSpace(name) == "" {
+        return DeliveryBand{}, fmt.Errorf("%w: name is required", ErrInvalidDeliveryBand)
+    }
+    if len(zipCodes) == 0 {
+        return DeliveryBand{}, fmt.Errorf("%w: band %q covers no zip codes", ErrInvalidDeliveryBand, name)
+    }
+    
+    normalized := make([]string, len(zipCodes))
+    for i, zipCode := range zipCodes {
+        normalized[i] = strings.TrimSpace(zipCode)
+        if normalized[i] == "" {
+            return DeliveryBand{}, fmt.Errorf("%w: band %q has an empty zip code", ErrInvalidDeliveryBand, name)
+        }
+    }
+    
+    return DeliveryBand{
+        name:     name,
+        zipCodes: normalized,
+        fee:      fee,
+    }, nil
+}
+
+func (b DeliveryBand) Name() string       { return b.name }
+func (b DeliveryBand) ZipCodes() []string { return b.zipCodes }
+func (b DeliveryBand) Fee() shared.Money  { return b.fee }
+
+// covers reports whether the band delivers to the zip code
+func (b DeliveryBand) covers(zipCode string) bool {
+    for _, covered := range b.zipCodes {
+        if covered == zipCode {
+            return true
+        }
+    }
+    return false
+}
+
+// SetDeliveryBands replaces where the store delivers and what it charges
+// WHAT: An empty list stops delivery, each zip code may only be in one band
+func (s *Store) SetDeliveryBands(bands []DeliveryBand) error {
+    seen := make(map[string]string)
+    for _, band := range bands {
+        for _, zipCode := range band.zipCodes {
+            if other, exists := seen[zipCode]; exists {
+                return fmt.Errorf("%w: zip code %s is in both %q and %q",
+                    ErrInvalidDeliveryBand, zipCode, other, band.name)
+            }
+            seen[zipCode] = band.name
+        }
+    }
+    
+    s.deliveryBands = append([]DeliveryBand(nil), bands...)
+    
+    // Raise domain event
+    s.Raise(DeliveryBandsSetEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        BandCount: len(bands),
+        ZipCount:  len(seen),
+    })
+    
+    return nil
+}
+
+// DeliveryBands returns the store's delivery bands in the order they were set
+func (s *Store) DeliveryBands() []DeliveryBand {
+    return s.deliveryBands
+}
+
+// OffersDelivery reports whether the store delivers anywhere
+func (s *Store) OffersDelivery() bool {
+    return len(s.deliveryBands) > 0
+}
+
+// DeliveryFeeFor prices delivery to an address
+// WHERE: Called when a delivery order is placed
+func (s *Store) DeliveryFeeFor(address shared.Address) (shared.Money, error) {
+    if !s.OffersDelivery() {
+        return shared.Money{}, fmt.Errorf("%w: %s does not deliver", ErrOutsideDeliveryArea, s.name)
+    }
+    
+    zipCode := strings.TrimSpace(address.ZipCode())
+    for _, band := range s.deliveryBands {
+        if band.covers(zipCode) {
+            return band.fee, nil
+        }
+    }
+    return shared.Money{}, fmt.Errorf("%w: %s does not deliver to %s", ErrOutsideDeliveryArea, s.name, zipCode)
+}
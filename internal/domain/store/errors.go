@@ -5,9 +5,27 @@ import "errors"
 // Domain-specific errors
 // WHY: Domain errors express business rule violations
 var (
-    ErrStoreNotFound       = errors.New("store not found")
-    ErrProductNotFound     = errors.New("product not found")
-    ErrInsufficientStock   = errors.New("insufficient stock")
-    ErrInvalidPrice        = errors.New("invalid price")
-    ErrDuplicateProduct    = errors.New("duplicate product name")
+    ErrStoreNotFound        = errors.New("store not found")
+    ErrProductNotFound      = errors.New("product not found")
+    ErrInsufficientStock    = errors.New("insufficient stock")
+    ErrInvalidPrice         = errors.New("invalid price")
+    ErrDuplicateProduct     = errors.New("duplicate product name")
+    ErrInvalidModifiers     = errors.New("invalid modifier selection")
+    ErrIngredientNotFound   = errors.New("ingredient not found")
+    ErrDuplicateIngredient  = errors.New("duplicate ingredient name")
+    ErrReservationNotFound  = errors.New("inventory reservation not found")
+    ErrInvalidAdjustment    = errors.New("invalid inventory adjustment")
+    ErrTransferNotFound     = errors.New("transfer not found")
+    ErrInvalidTransfer      = errors.New("invalid inventory transfer")
+    ErrTransferNotInTransit = errors.New("transfer is no longer in transit")
+    ErrInvalidOpeningHours  = errors.New("invalid opening hours")
+    ErrStoreClosed          = errors.New("store is not taking orders")
+    ErrInvalidPriceRule     = errors.New("invalid price rule")
+    ErrPriceRuleNotFound    = errors.New("price rule not found")
+    ErrPriceChangeNotFound  = errors.New("scheduled price change not found")
+    ErrCategoryNotFound     = errors.New("category not found")
+    ErrInvalidCategory      = errors.New("invalid category")
+    ErrInvalidDeliveryBand  = errors.New("invalid delivery band")
+    ErrOutsideDeliveryArea  = errors.New("address is outside the delivery area")
+    ErrInvalidPrepTimes     = errors.New("invalid preparation times")
 )
@@ -1,6 +1,10 @@
 package store
 
-import "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+import (
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
 
 // StoreCreatedEvent is raised when a new store is created
 // WHY: Other parts of the system need to know when stores are created
@@ -42,15 +46,475 @@ func (e InventoryAddedEvent) EventName() string     { return "inventory.added" }
 func (e InventoryAddedEvent) AggregateID() string   { return e.StoreID }
 func (e InventoryAddedEvent) AggregateType() string { return "store" }
 
-// InventoryReservedEvent tracks inventory reservations
+// InventoryReservedEvent tracks stock held for an open order
 type InventoryReservedEvent struct {
 	shared.BaseEvent
-	StoreID          string `json:"store_id"`
-	ProductID        string `json:"product_id"`
-	QuantityReserved int    `json:"quantity_reserved"`
-	RemainingQty     int    `json:"remaining_qty"`
+	StoreID          string         `json:"store_id"`
+	OrderID          string         `json:"order_id"`
+	ProductID        string         `json:"product_id"`
+	QuantityReserved int            `json:"quantity_reserved"`
+	RemainingQty     int            `json:"remaining_qty"`
+	Ingredients      map[string]int `json:"ingredients,omitempty"` // Ingredient ID to amount held
+	ExpiresAt        time.Time      `json:"expires_at"`
 }
 
 func (e InventoryReservedEvent) EventName() string     { return "inventory.reserved" }
 func (e InventoryReservedEvent) AggregateID() string   { return e.StoreID }
 func (e InventoryReservedEvent) AggregateType() string { return "store" }
+
+// ReservationLineSnapshot captures one line of a reservation for events
+type ReservationLineSnapshot struct {
+	ProductID   string         `json:"product_id"`
+	Quantity    int            `json:"quantity"`
+	Ingredients map[string]int `json:"ingredients,omitempty"`
+}
+
+// InventoryConsumedEvent is raised when a completed order's held stock leaves the shelf
+// WHERE: Used by stock reporting to track what was actually sold
+type InventoryConsumedEvent struct {
+	shared.BaseEvent
+	StoreID string                    `json:"store_id"`
+	OrderID string                    `json:"order_id"`
+	Lines   []ReservationLineSnapshot `json:"lines"`
+}
+
+func (e InventoryConsumedEvent) EventName() string     { return "inventory.consumed" }
+func (e InventoryConsumedEvent) AggregateID() string   { return e.StoreID }
+func (e InventoryConsumedEvent) AggregateType() string { return "store" }
+
+// InventoryReservationReleasedEvent is raised when held stock becomes available again
+// WHERE: Used by the order context to cancel orders whose reservation expired
+type InventoryReservationReleasedEvent struct {
+	shared.BaseEvent
+	StoreID string                    `json:"store_id"`
+	OrderID string                    `json:"order_id"`
+	Reason  string                    `json:"reason"` // CANCELLED or EXPIRED
+	Lines   []ReservationLineSnapshot `json:"lines"`
+}
+
+func (e InventoryReservationReleasedEvent) EventName() string     { return "inventory.reservation_released" }
+func (e InventoryReservationReleasedEvent) AggregateID() string   { return e.StoreID }
+func (e InventoryReservationReleasedEvent) AggregateType() string { return "store" }
+
+// ModifierGroupAddedEvent is raised when a product gains a customizable choice
+// WHERE: Used by read models to update menus with sizes and add-ons
+type ModifierGroupAddedEvent struct {
+	shared.BaseEvent
+	StoreID   string   `json:"store_id"`
+	ProductID string   `json:"product_id"`
+	GroupID   string   `json:"group_id"`
+	GroupName string   `json:"group_name"`
+	Required  bool     `json:"required"`
+	Options   []string `json:"options"`
+}
+
+func (e ModifierGroupAddedEvent) EventName() string     { return "product.modifier_group_added" }
+func (e ModifierGroupAddedEvent) AggregateID() string   { return e.StoreID }
+func (e ModifierGroupAddedEvent) AggregateType() string { return "store" }
+
+// IngredientAddedEvent is raised when an ingredient joins a store's catalog
+type IngredientAddedEvent struct {
+	shared.BaseEvent
+	StoreID        string `json:"store_id"`
+	IngredientID   string `json:"ingredient_id"`
+	IngredientName string `json:"ingredient_name"`
+	Unit           string `json:"unit"`
+}
+
+func (e IngredientAddedEvent) EventName() string     { return "ingredient.added" }
+func (e IngredientAddedEvent) AggregateID() string   { return e.StoreID }
+func (e IngredientAddedEvent) AggregateType() string { return "store" }
+
+// IngredientRestockedEvent tracks ingredient stock increases
+type IngredientRestockedEvent struct {
+	shared.BaseEvent
+	StoreID       string `json:"store_id"`
+	IngredientID  string `json:"ingredient_id"`
+	QuantityAdded int    `json:"quantity_added"`
+	NewTotal      int    `json:"new_total"`
+}
+
+func (e IngredientRestockedEvent) EventName() string     { return "ingredient.restocked" }
+func (e IngredientRestockedEvent) AggregateID() string   { return e.StoreID }
+func (e IngredientRestockedEvent) AggregateType() string { return "store" }
+
+// RecipeSetEvent is raised when a product's or modifier option's recipe changes
+type RecipeSetEvent struct {
+	shared.BaseEvent
+	StoreID    string         `json:"store_id"`
+	ProductID  string         `json:"product_id"`
+	OptionID   string         `json:"option_id,omitempty"` // Empty for the product's own recipe
+	Components map[string]int `json:"components"`
+}
+
+func (e RecipeSetEvent) EventName() string     { return "product.recipe_set" }
+func (e RecipeSetEvent) AggregateID() string   { return e.StoreID }
+func (e RecipeSetEvent) AggregateType() string { return "store" }
+
+// ReorderPointSetEvent is raised when a product's low-stock threshold changes
+type ReorderPointSetEvent struct {
+	shared.BaseEvent
+	StoreID      string `json:"store_id"`
+	ProductID    string `json:"product_id"`
+	ReorderPoint int    `json:"reorder_point"`
+}
+
+func (e ReorderPointSetEvent) EventName() string     { return "inventory.reorder_point_set" }
+func (e ReorderPointSetEvent) AggregateID() string   { return e.StoreID }
+func (e ReorderPointSetEvent) AggregateType() string { return "store" }
+
+// StockAlert holds the details shared by stock level events
+type StockAlert struct {
+	StoreID      string `json:"store_id"`
+	ProductID    string `json:"product_id"`
+	ProductName  string `json:"product_name"`
+	Available    int    `json:"available"`
+	ReorderPoint int    `json:"reorder_point"`
+}
+
+// LowStockEvent is raised when a product's available stock drops to its reorder point
+// WHERE: Used to alert staff to reorder before the product runs out
+type LowStockEvent struct {
+	shared.BaseEvent
+	StockAlert
+}
+
+func (e LowStockEvent) EventName() string     { return "inventory.low_stock" }
+func (e LowStockEvent) AggregateID() string   { return e.StoreID }
+func (e LowStockEvent) AggregateType() string { return "store" }
+
+// OutOfStockEvent is raised when a product can no longer be sold
+type OutOfStockEvent struct {
+	shared.BaseEvent
+	StockAlert
+}
+
+func (e OutOfStockEvent) EventName() string     { return "inventory.out_of_stock" }
+func (e OutOfStockEvent) AggregateID() string   { return e.StoreID }
+func (e OutOfStockEvent) AggregateType() string { return "store" }
+
+// StockReplenishedEvent is raised when a low or sold out product is back above its reorder point
+// WHERE: Used to clear stock alerts
+type StockReplenishedEvent struct {
+	shared.BaseEvent
+	StockAlert
+}
+
+func (e StockReplenishedEvent) EventName() string     { return "inventory.stock_replenished" }
+func (e StockReplenishedEvent) AggregateID() string   { return e.StoreID }
+func (e StockReplenishedEvent) AggregateType() string { return "store" }
+
+// StockAlertClearedEvent is raised when a low or sold out product is taken off the menu
+// WHY: Nobody will reorder a product that is no longer sold, so its alert should go away
+type StockAlertClearedEvent struct {
+	shared.BaseEvent
+	StockAlert
+}
+
+func (e StockAlertClearedEvent) EventName() string     { return "inventory.stock_alert_cleared" }
+func (e StockAlertClearedEvent) AggregateID() string   { return e.StoreID }
+func (e StockAlertClearedEvent) AggregateType() string { return "store" }
+
+// InventoryAdjustedEvent is raised when stock on hand is corrected by hand
+// WHERE: Used by shrinkage reporting to track spoilage, breakage and theft
+type InventoryAdjustedEvent struct {
+	shared.BaseEvent
+	StoreID        string `json:"store_id"`
+	AdjustmentID   string `json:"adjustment_id"`
+	ItemType       string `json:"item_type"` // PRODUCT or INGREDIENT
+	ItemID         string `json:"item_id"`
+	ItemName       string `json:"item_name"`
+	Delta          int    `json:"delta"`
+	QuantityBefore int    `json:"quantity_before"`
+	QuantityAfter  int    `json:"quantity_after"`
+	Reason         string `json:"reason"`
+	Note           string `json:"note,omitempty"`
+}
+
+func (e InventoryAdjustedEvent) EventName() string     { return "inventory.adjusted" }
+func (e InventoryAdjustedEvent) AggregateID() string   { return e.StoreID }
+func (e InventoryAdjustedEvent) AggregateType() string { return "store" }
+
+// InventoryTransferredOutEvent is raised when stock leaves a store for another store
+type InventoryTransferredOutEvent struct {
+	shared.BaseEvent
+	StoreID    string `json:"store_id"`
+	TransferID string `json:"transfer_id"`
+	ItemType   string `json:"item_type"`
+	ItemID     string `json:"item_id"`
+	ItemName   string `json:"item_name"`
+	Quantity   int    `json:"quantity"`
+	NewTotal   int    `json:"new_total"`
+}
+
+func (e InventoryTransferredOutEvent) EventName() string     { return "inventory.transferred_out" }
+func (e InventoryTransferredOutEvent) AggregateID() string   { return e.StoreID }
+func (e InventoryTransferredOutEvent) AggregateType() string { return "store" }
+
+// InventoryTransferredInEvent is raised when transferred stock is put on a store's shelf
+// WHAT: Also raised by the source store when a cancelled transfer's stock comes back
+type InventoryTransferredInEvent struct {
+	shared.BaseEvent
+	StoreID    string `json:"store_id"`
+	TransferID string `json:"transfer_id"`
+	ItemType   string `json:"item_type"`
+	ItemID     string `json:"item_id"`
+	ItemName   string `json:"item_name"`
+	Quantity   int    `json:"quantity"`
+	NewTotal   int    `json:"new_total"`
+}
+
+func (e InventoryTransferredInEvent) EventName() string     { return "inventory.transferred_in" }
+func (e InventoryTransferredInEvent) AggregateID() string   { return e.StoreID }
+func (e InventoryTransferredInEvent) AggregateType() string { return "store" }
+
+// TransferLineSnapshot captures a transfer line for events
+type TransferLineSnapshot struct {
+	ItemType          string `json:"item_type"`
+	SourceItemID      string `json:"source_item_id"`
+	DestinationItemID string `json:"destination_item_id"`
+	ItemName          string `json:"item_name"`
+	Quantity          int    `json:"quantity"`
+}
+
+// TransferDispatchedEvent is raised when stock is sent from one store to another
+type TransferDispatchedEvent struct {
+	shared.BaseEvent
+	TransferID         string                 `json:"transfer_id"`
+	SourceStoreID      string                 `json:"source_store_id"`
+	DestinationStoreID string                 `json:"destination_store_id"`
+	Lines              []TransferLineSnapshot `json:"lines"`
+	Note               string                 `json:"note,omitempty"`
+}
+
+func (e TransferDispatchedEvent) EventName() string     { return "transfer.dispatched" }
+func (e TransferDispatchedEvent) AggregateID() string   { return e.TransferID }
+func (e TransferDispatchedEvent) AggregateType() string { return "transfer" }
+
+// TransferReceivedEvent is raised when the destination store checks a transfer in
+type TransferReceivedEvent struct {
+	shared.BaseEvent
+	TransferID         string                 `json:"transfer_id"`
+	SourceStoreID      string                 `json:"source_store_id"`
+	DestinationStoreID string                 `json:"destination_store_id"`
+	Lines              []TransferLineSnapshot `json:"lines"`
+}
+
+func (e TransferReceivedEvent) EventName() string     { return "transfer.received" }
+func (e TransferReceivedEvent) AggregateID() string   { return e.TransferID }
+func (e TransferReceivedEvent) AggregateType() string { return "transfer" }
+
+// TransferCancelledEvent is raised when a transfer is called off and its stock returned
+type TransferCancelledEvent struct {
+	shared.BaseEvent
+	TransferID         string `json:"transfer_id"`
+	SourceStoreID      string `json:"source_store_id"`
+	DestinationStoreID string `json:"destination_store_id"`
+	Reason             string `json:"reason"`
+}
+
+func (e TransferCancelledEvent) EventName() string     { return "transfer.cancelled" }
+func (e TransferCancelledEvent) AggregateID() string   { return e.TransferID }
+func (e TransferCancelledEvent) AggregateType() string { return "transfer" }
+
+// OpeningHoursSetEvent is raised when a store's weekly schedule changes
+type OpeningHoursSetEvent struct {
+	shared.BaseEvent
+	StoreID      string `json:"store_id"`
+	Timezone     string `json:"timezone"`
+	PeriodCount  int    `json:"period_count"`
+	HolidayCount int    `json:"holiday_count"`
+}
+
+func (e OpeningHoursSetEvent) EventName() string     { return "store.opening_hours_set" }
+func (e OpeningHoursSetEvent) AggregateID() string   { return e.StoreID }
+func (e OpeningHoursSetEvent) AggregateType() string { return "store" }
+
+// DeliveryBandsSetEvent is raised when a store changes where it delivers
+type DeliveryBandsSetEvent struct {
+	shared.BaseEvent
+	StoreID   string `json:"store_id"`
+	BandCount int    `json:"band_count"`
+	ZipCount  int    `json:"zip_count"`
+}
+
+func (e DeliveryBandsSetEvent) EventName() string     { return "store.delivery_bands_set" }
+func (e DeliveryBandsSetEvent) AggregateID() string   { return e.StoreID }
+func (e DeliveryBandsSetEvent) AggregateType() string { return "store" }
+
+// PrepTimesSetEvent is raised when a store changes how long its orders are expected to take
+type PrepTimesSetEvent struct {
+	shared.BaseEvent
+	StoreID          string `json:"store_id"`
+	BaseMinutes      int    `json:"base_minutes"`
+	MinutesPerItem   int    `json:"minutes_per_item"`
+	MinutesPerQueued int    `json:"minutes_per_queued"`
+}
+
+func (e PrepTimesSetEvent) EventName() string     { return "store.prep_times_set" }
+func (e PrepTimesSetEvent) AggregateID() string   { return e.StoreID }
+func (e PrepTimesSetEvent) AggregateType() string { return "store" }
+
+// OrderingPausedEvent is raised when staff stop a store taking orders
+type OrderingPausedEvent struct {
+	shared.BaseEvent
+	StoreID string    `json:"store_id"`
+	Reason  string    `json:"reason"`
+	Until   time.Time `json:"until,omitempty"` // Zero until resumed by hand
+}
+
+func (e OrderingPausedEvent) EventName() string     { return "store.ordering_paused" }
+func (e OrderingPausedEvent) AggregateID() string   { return e.StoreID }
+func (e OrderingPausedEvent) AggregateType() string { return "store" }
+
+// OrderingResumedEvent is raised when staff lift a pause
+type OrderingResumedEvent struct {
+	shared.BaseEvent
+	StoreID string `json:"store_id"`
+}
+
+func (e OrderingResumedEvent) EventName() string     { return "store.ordering_resumed" }
+func (e OrderingResumedEvent) AggregateID() string   { return e.StoreID }
+func (e OrderingResumedEvent) AggregateType() string { return "store" }
+
+// ProductPriceChangedEvent is raised when a product's base price changes
+// WHERE: Used by menu boards and reporting to pick up the new price
+type ProductPriceChangedEvent struct {
+	shared.BaseEvent
+	StoreID     string       `json:"store_id"`
+	ProductID   string       `json:"product_id"`
+	OldPrice    shared.Money `json:"old_price"`
+	NewPrice    shared.Money `json:"new_price"`
+	Reason      string       `json:"reason"` // MANUAL or SCHEDULED
+	EffectiveAt time.Time    `json:"effective_at"`
+}
+
+func (e ProductPriceChangedEvent) EventName() string     { return "product.price_changed" }
+func (e ProductPriceChangedEvent) AggregateID() string   { return e.StoreID }
+func (e ProductPriceChangedEvent) AggregateType() string { return "store" }
+
+// PriceChangeScheduledEvent is raised when a future price change is queued
+type PriceChangeScheduledEvent struct {
+	shared.BaseEvent
+	StoreID     string       `json:"store_id"`
+	ProductID   string       `json:"product_id"`
+	ChangeID    string       `json:"change_id"`
+	NewPrice    shared.Money `json:"new_price"`
+	EffectiveAt time.Time    `json:"effective_at"`
+}
+
+func (e PriceChangeScheduledEvent) EventName() string     { return "product.price_change_scheduled" }
+func (e PriceChangeScheduledEvent) AggregateID() string   { return e.StoreID }
+func (e PriceChangeScheduledEvent) AggregateType() string { return "store" }
+
+// PriceChangeCancelledEvent is raised when a queued price change is dropped before it takes effect
+type PriceChangeCancelledEvent struct {
+	shared.BaseEvent
+	StoreID   string `json:"store_id"`
+	ProductID string `json:"product_id"`
+	ChangeID  string `json:"change_id"`
+}
+
+func (e PriceChangeCancelledEvent) EventName() string     { return "product.price_change_cancelled" }
+func (e PriceChangeCancelledEvent) AggregateID() string   { return e.StoreID }
+func (e PriceChangeCancelledEvent) AggregateType() string { return "store" }
+
+// PriceRuleAddedEvent is raised when a recurring discount window is set up
+type PriceRuleAddedEvent struct {
+	shared.BaseEvent
+	StoreID    string `json:"store_id"`
+	RuleID     string `json:"rule_id"`
+	Name       string `json:"name"`
+	PercentOff int    `json:"percent_off"`
+}
+
+func (e PriceRuleAddedEvent) EventName() string     { return "store.price_rule_added" }
+func (e PriceRuleAddedEvent) AggregateID() string   { return e.StoreID }
+func (e PriceRuleAddedEvent) AggregateType() string { return "store" }
+
+// PriceRuleRemovedEvent is raised when a recurring discount window is taken down
+type PriceRuleRemovedEvent struct {
+	shared.BaseEvent
+	StoreID string `json:"store_id"`
+	RuleID  string `json:"rule_id"`
+}
+
+func (e PriceRuleRemovedEvent) EventName() string     { return "store.price_rule_removed" }
+func (e PriceRuleRemovedEvent) AggregateID() string   { return e.StoreID }
+func (e PriceRuleRemovedEvent) AggregateType() string { return "store" }
+
+// CategoryAddedEvent is raised when a section is added to a store's menu
+type CategoryAddedEvent struct {
+	shared.BaseEvent
+	StoreID      string `json:"store_id"`
+	CategoryID   string `json:"category_id"`
+	Name         string `json:"name"`
+	DisplayOrder int    `json:"display_order"`
+}
+
+func (e CategoryAddedEvent) EventName() string     { return "category.added" }
+func (e CategoryAddedEvent) AggregateID() string   { return e.StoreID }
+func (e CategoryAddedEvent) AggregateType() string { return "store" }
+
+// CategoryUpdatedEvent is raised when a menu section is renamed or moved
+type CategoryUpdatedEvent struct {
+	shared.BaseEvent
+	StoreID      string `json:"store_id"`
+	CategoryID   string `json:"category_id"`
+	Name         string `json:"name"`
+	DisplayOrder int    `json:"display_order"`
+}
+
+func (e CategoryUpdatedEvent) EventName() string     { return "category.updated" }
+func (e CategoryUpdatedEvent) AggregateID() string   { return e.StoreID }
+func (e CategoryUpdatedEvent) AggregateType() string { return "store" }
+
+// ProductEditedEvent is raised when a product's menu details change
+// WHERE: Used by read models to update product catalogs
+type ProductEditedEvent struct {
+	shared.BaseEvent
+	StoreID      string `json:"store_id"`
+	ProductID    string `json:"product_id"`
+	ProductName  string `json:"product_name"`
+	Description  string `json:"description"`
+	CategoryID   string `json:"category_id,omitempty"`
+	DisplayOrder int    `json:"display_order"`
+}
+
+func (e ProductEditedEvent) EventName() string     { return "product.edited" }
+func (e ProductEditedEvent) AggregateID() string   { return e.StoreID }
+func (e ProductEditedEvent) AggregateType() string { return "store" }
+
+// ProductTaxExemptionSetEvent is raised when a product starts or stops being charged sales tax
+type ProductTaxExemptionSetEvent struct {
+	shared.BaseEvent
+	StoreID   string `json:"store_id"`
+	ProductID string `json:"product_id"`
+	TaxExempt bool   `json:"tax_exempt"`
+}
+
+func (e ProductTaxExemptionSetEvent) EventName() string     { return "product.tax_exemption_set" }
+func (e ProductTaxExemptionSetEvent) AggregateID() string   { return e.StoreID }
+func (e ProductTaxExemptionSetEvent) AggregateType() string { return "store" }
+
+// ProductDeactivatedEvent is raised when a product is taken off the menu
+type ProductDeactivatedEvent struct {
+	shared.BaseEvent
+	StoreID   string `json:"store_id"`
+	ProductID string `json:"product_id"`
+}
+
+func (e ProductDeactivatedEvent) EventName() string     { return "product.deactivated" }
+func (e ProductDeactivatedEvent) AggregateID() string   { return e.StoreID }
+func (e ProductDeactivatedEvent) AggregateType() string { return "store" }
+
+// ProductReactivatedEvent is raised when a product goes back on the menu
+type ProductReactivatedEvent struct {
+	shared.BaseEvent
+	StoreID   string `json:"store_id"`
+	ProductID string `json:"product_id"`
+}
+
+func (e ProductReactivatedEvent) EventName() string     { return "product.reactivated" }
+func (e ProductReactivatedEvent) AggregateID() string   { return e.StoreID }
+func (e ProductReactivatedEvent) AggregateType() string { return "store" }
@@ -0,0 +1,107 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// IngredientID uniquely identifies an ingredient in a store's catalog
+type IngredientID string
+
+func NewIngredientID() IngredientID {
+    return IngredientID(uuid.New().String())
+}
+
+// Unit is how an ingredient is counted
+// WHY: Stock is kept in whole base units so recipes never deal with fractions
+type Unit string
+
+const (
+    UnitEach       Unit = "EACH"
+    UnitGram       Unit = "GRAM"
+    UnitMilliliter Unit = "MILLILITER"
+)
+
+func NewUnit(unit string) (Unit, error) {
+    switch Unit(strings.ToUpper(unit)) {
+    case UnitEach, UnitGram, UnitMilliliter:
+        return Unit(strings.ToUpper(unit)), nil
+    default:
+        return "", fmt.Errorf("unknown unit %q", unit)
+    }
+}
+
+// Ingredient is an entity for something the store stocks to make products
+// WHAT: e.g. lemons (each), sugar (grams), cups (each)
+type Ingredient struct {
+    id   IngredientID
+    name string
+    unit Unit
+}
+
+// NewIngredient creates a new ingredient
+// WHERE: Used by Store aggregate when adding to its catalog
+func NewIngredient(name string, unit Unit) (*Ingredient, error) {
+    name = strings.TrimSpace(name)
+    if name == "" {
+        return nil, errors.New("ingredient name is required")
+    }
+    
+    return &Ingredient{
+        id:   NewIngredientID(),
+        name: name,
+        unit: unit,
+    }, nil
+}
+
+func (i *Ingredient) ID() IngredientID { return i.id }
+func (i *Ingredient) Name() string     { return i.name }
+func (i *Ingredient) Unit() Unit       { return i.unit }
+
+// RecipeComponent is a value object for one ingredient in a recipe
+type RecipeComponent struct {
+    ingredientID IngredientID
+    quantity     int
+}
+
+func NewRecipeComponent(ingredientID IngredientID, quantity int) (RecipeComponent, error) {
+    if quantity <= 0 {
+        return RecipeComponent{}, errors.New("recipe quantity must be positive")
+    }
+    return RecipeComponent{ingredientID: ingredientID, quantity: quantity}, nil
+}
+
+func (c RecipeComponent) IngredientID() IngredientID { return c.ingredientID }
+func (c RecipeComponent) Quantity() int              { return c.quantity }
+
+// Recipe is a value object listing what goes into one unit of a product
+// WHY: Stands stock lemons and sugar, not finished cups, so stock is checked per ingredient
+// WHERE: Set on products, and on modifier options for what they add (e.g. extra mint)
+type Recipe struct {
+    components []RecipeComponent
+}
+
+// NewRecipe creates a recipe, each ingredient may appear once
+func NewRecipe(components ...RecipeComponent) (Recipe, error) {
+    seen := make(map[IngredientID]bool, len(components))
+    for _, component := range components {
+        if seen[component.IngredientID()] {
+            return Recipe{}, errors.New("ingredient listed twice in recipe")
+        }
+        seen[component.IngredientID()] = true
+    }
+    return Recipe{components: components}, nil
+}
+
+func (r Recipe) Components() []RecipeComponent { return r.components }
+func (r Recipe) IsEmpty() bool                 { return len(r.components) == 0 }
+
+// addTo adds the ingredients for quantity units into requirements
+func (r Recipe) addTo(requirements map[IngredientID]int, quantity int) {
+    for _, component := range r.components {
+        requirements[component.IngredientID()] += component.Quantity() * quantity
+    }
+}
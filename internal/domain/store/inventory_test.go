@@ -0,0 +1,54 @@
+package store
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCanAddInventoryChecksTheProductCanBeStocked(t *testing.T) {
+    storeAgg, product := newTestStore(t, "Main St", 0)
+    
+    if err := storeAgg.CanAddInventory(product.ID(), 5); err != nil {
+        t.Fatalf("CanAddInventory: %v", err)
+    }
+    if err := storeAgg.CanAddInventory(NewProductID(), 5); err == nil {
+        t.Error("unknown product accepted")
+    }
+    
+    if err := storeAgg.DeactivateProduct(product.ID()); err != nil {
+        t.Fatalf("DeactivateProduct: %v", err)
+    }
+    if err := storeAgg.CanAddInventory(product.ID(), 5); err == nil {
+        t.Error("inactive product accepted")
+    }
+    
+    // The check alone never changes stock
+    if got := int(storeAgg.inventory[product.ID()]); got != 0 {
+        t.Errorf("on hand = %d, want 0", got)
+    }
+    if err := storeAgg.AddInventory(product.ID(), 5); err == nil {
+        t.Error("AddInventory accepted what CanAddInventory rejects")
+    }
+}
+
+func TestCanRestockIngredientChecksTheIngredientExists(t *testing.T) {
+    storeAgg, _ := newTestStore(t, "Main St", 0)
+    unit, err := NewUnit("each")
+    if err != nil {
+        t.Fatalf("NewUnit: %v", err)
+    }
+    lemons, err := storeAgg.AddIngredient("Lemons", unit)
+    if err != nil {
+        t.Fatalf("AddIngredient: %v", err)
+    }
+    
+    if err := storeAgg.CanRestockIngredient(lemons.ID(), 20); err != nil {
+        t.Errorf("CanRestockIngredient: %v", err)
+    }
+    if err := storeAgg.CanRestockIngredient(NewIngredientID(), 20); !errors.Is(err, ErrIngredientNotFound) {
+        t.Errorf("unknown ingredient err = %v, want ErrIngredientNotFound", err)
+    }
+    if err := storeAgg.CanRestockIngredient(lemons.ID(), -1); err == nil {
+        t.Error("negative quantity accepted")
+    }
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// ModifierGroup is an entity within Product describing one customizable choice
+// WHY: "Large, less ice, extra mint" is one product with several choices, not three products
+// WHAT: e.g. Size (required, pick 1), Ice level (optional, pick 1), Add-ons (optional, pick up to 3)
+type ModifierGroup struct {
+    id            string
+    name          string
+    required      bool
+    minSelections int
+    maxSelections int
+    options       []*ModifierOption
+}
+
+// NewModifierGroup creates a modifier group with no options yet
+// WHAT: A required group always needs at least one selection
+func NewModifierGroup(name string, required bool, minSelections, maxSelections int) (*ModifierGroup, error) {
+    name = strings.TrimSpace(name)
+    if name == "" {
+        return nil, errors.New("modifier group name is required")
+    }
+    
+    if minSelections < 0 || maxSelections < 1 {
+        return nil, errors.New("modifier group must allow at least one selection")
+    }
+    
+    if required && minSelections == 0 {
+        minSelections = 1
+    }
+    if !required {
+        minSelections = 0
+    }
+    
+    if minSelections > maxSelections {
+        return nil, errors.New("minimum selections cannot exceed maximum selections")
+    }
+    
+    return &ModifierGroup{
+        id:            uuid.New().String(),
+        name:          name,
+        required:      required,
+        minSelections: minSelections,
+        maxSelections: maxSelections,
+        options:       make([]*ModifierOption, 0),
+    }, nil
+}
+
+// AddOption adds a choice to the group
+// WHAT: priceDelta is added to the product price, zero for choices that cost nothing extra
+func (g *ModifierGroup) AddOption(name string, priceDelta shared.Money) (*ModifierOption, error) {
+    name = strings.TrimSpace(name)
+    if name == "" {
+        return nil, errors.New("modifier option name is required")
+    }
+    
+    for _, option := range g.options {
+        if strings.EqualFold(option.Name(), name) {
+            return nil, fmt.Errorf("option %q already exists in %s", name, g.name)
+        }
+    }
+    
+    option := &ModifierOption{
+        id:         uuid.New().String(),
+        name:       name,
+        priceDelta: priceDelta,
+    }
+    g.options = append(g.options, option)
+    
+    return option, nil
+}
+
+// findOption returns the option with the given ID
+func (g *ModifierGroup) findOption(optionID string) (*ModifierOption, bool) {
+    for _, option := range g.options {
+        if option.ID() == optionID {
+            return option, true
+        }
+    }
+    return nil, false
+}
+
+// Getters
+func (g *ModifierGroup) ID() string                 { return g.id }
+func (g *ModifierGroup) Name() string               { return g.name }
+func (g *ModifierGroup) IsRequired() bool           { return g.required }
+func (g *ModifierGroup) MinSelections() int         { return g.minSelections }
+func (g *ModifierGroup) MaxSelections() int         { return g.maxSelections }
+func (g *ModifierGroup) Options() []*ModifierOption { return g.options }
+
+// ModifierOption is an entity for one choice within a modifier group
+type ModifierOption struct {
+    id         string
+    name       string
+    priceDelta shared.Money
+    recipe     Recipe // Extra ingredients used when chosen, e.g. 5g mint
+}
+
+func (o *ModifierOption) ID() string               { return o.id }
+func (o *ModifierOption) Name() string             { return o.name }
+func (o *ModifierOption) PriceDelta() shared.Money { return o.priceDelta }
+func (o *ModifierOption) Recipe() Recipe           { return o.recipe }
+
+// SelectedModifier is a value object for an option the customer picked
+// WHERE: Copied onto order items so later menu changes don't alter past orders
+type SelectedModifier struct {
+    groupID    string
+    groupName  string
+    optionID   string
+    optionName string
+    priceDelta shared.Money
+}
+
+func (m SelectedModifier) GroupID() string          { return m.groupID }
+func (m SelectedModifier) GroupName() string        { return m.groupName }
+func (m SelectedModifier) OptionID() string         { return m.optionID }
+func (m SelectedModifier) OptionName() string       { return m.optionName }
+func (m SelectedModifier) PriceDelta() shared.Money { return m.priceDelta }
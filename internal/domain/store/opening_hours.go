@@ -0,0 +1,209 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimeOfDay is minutes after midnight in the store's local time
+type TimeOfDay int
+
+// ParseTimeOfDay parses "HH:MM", with "24:00" meaning end of day
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+    var hours, minutes int
+    if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil || len(value) != 5 {
+        return 0, fmt.Errorf("%w: time %q must be HH:MM", ErrInvalidOpeningHours, value)
+    }
+    
+    if hours < 0 || minutes < 0 || minutes > 59 || hours*60+minutes > 24*60 {
+        return 0, fmt.Errorf("%w: time %q is out of range", ErrInvalidOpeningHours, value)
+    }
+    
+    return TimeOfDay(hours*60 + minutes), nil
+}
+
+func (t TimeOfDay) String() string {
+    return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+// ParseWeekday parses a day name such as "MONDAY"
+func ParseWeekday(value string) (time.Weekday, error) {
+    for day := time.Sunday; day <= time.Saturday; day++ {
+        if strings.EqualFold(value, day.String()) {
+            return day, nil
+        }
+    }
+    return 0, fmt.Errorf("%w: unknown day %q", ErrInvalidOpeningHours, value)
+}
+
+// OpeningPeriod is one stretch of a weekday the store is open
+// WHAT: Hours past midnight are split across two days, e.g. 18:00-24:00 and 00:00-02:00
+type OpeningPeriod struct {
+    day    time.Weekday
+    opens  TimeOfDay
+    closes TimeOfDay
+}
+
+func NewOpeningPeriod(day time.Weekday, opens TimeOfDay, closes TimeOfDay) (OpeningPeriod, error) {
+    if closes <= opens {
+        return OpeningPeriod{}, fmt.Errorf("%w: %s closes at %s before it opens at %s",
+            ErrInvalidOpeningHours, day, closes, opens)
+    }
+    return OpeningPeriod{day: day, opens: opens, closes: closes}, nil
+}
+
+func (p OpeningPeriod) Day() time.Weekday { return p.day }
+func (p OpeningPeriod) Opens() TimeOfDay  { return p.opens }
+func (p OpeningPeriod) Closes() TimeOfDay { return p.closes }
+
+// HolidayClosure is a date the store stays shut whatever its weekly hours say
+type HolidayClosure struct {
+    date string // YYYY-MM-DD in the store's timezone
+    name string
+}
+
+func NewHolidayClosure(date string, name string) (HolidayClosure, error) {
+    if _, err := time.Parse(time.DateOnly, date); err != nil {
+        return HolidayClosure{}, fmt.Errorf("%w: date %q must be YYYY-MM-DD", ErrInvalidOpeningHours, date)
+    }
+    return HolidayClosure{date: date, name: name}, nil
+}
+
+func (c HolidayClosure) Date() string { return c.date }
+func (c HolidayClosure) Name() string { return c.name }
+
+// OpeningHours is a store's weekly schedule in its own timezone
+// WHY: A stand opens at 9am local time, whatever timezone the server runs in
+type OpeningHours struct {
+    location *time.Location
+    periods  []OpeningPeriod
+    holidays map[string]HolidayClosure
+}
+
+func NewOpeningHours(timezone string, periods []OpeningPeriod, holidays []HolidayClosure) (OpeningHours, error) {
+    location, err := time.LoadLocation(timezone)
+    if err != nil || timezone == "" {
+        return OpeningHours{}, fmt.Errorf("%w: unknown timezone %q", ErrInvalidOpeningHours, timezone)
+    }
+    
+    sorted := append([]OpeningPeriod(nil), periods...)
+    sort.Slice(sorted, func(i, j int) bool {
+        if sorted[i].day != sorted[j].day {
+            return sorted[i].day < sorted[j].day
+        }
+        return sorted[i].opens < sorted[j].opens
+    })
+    for i := 1; i < len(sorted); i++ {
+        if sorted[i].day == sorted[i-1].day && sorted[i].opens < sorted[i-1].closes {
+            return OpeningHours{}, fmt.Errorf("%w: %s has overlapping periods", ErrInvalidOpeningHours, sorted[i].day)
+        }
+    }
+    
+    closures := make(map[string]HolidayClosure, len(holidays))
+    for _, holiday := range holidays {
+        if _, exists := closures[holiday.date]; exists {
+            return OpeningHours{}, fmt.Errorf("%w: %s is listed twice", ErrInvalidOpeningHours, holiday.date)
+        }
+        closures[holiday.date] = holiday
+    }
+    
+    return OpeningHours{location: location, periods: sorted, holidays: closures}, nil
+}
+
+// IsSet reports whether the store has hours, stores without them are always open
+func (h OpeningHours) IsSet() bool {
+    return h.location != nil
+}
+
+func (h OpeningHours) Timezone() string {
+    if h.location == nil {
+        return ""
+    }
+    return h.location.String()
+}
+
+func (h OpeningHours) Periods() []OpeningPeriod { return h.periods }
+
+// Holidays returns holiday closures in date order
+func (h OpeningHours) Holidays() []HolidayClosure {
+    holidays := make([]HolidayClosure, 0, len(h.holidays))
+    for _, holiday := range h.holidays {
+        holidays = append(holidays, holiday)
+    }
+    sort.Slice(holidays, func(i, j int) bool {
+        return holidays[i].date < holidays[j].date
+    })
+    return holidays
+}
+
+// localTime converts at to the store's timezone, UTC for stores without hours
+func (h OpeningHours) localTime(at time.Time) time.Time {
+    if h.location == nil {
+        return at.UTC()
+    }
+    return at.In(h.location)
+}
+
+// holidayOn returns the holiday closure covering the local date of at, if any
+func (h OpeningHours) holidayOn(at time.Time) (HolidayClosure, bool) {
+    holiday, exists := h.holidays[at.In(h.location).Format(time.DateOnly)]
+    return holiday, exists
+}
+
+// isOpenAt reports whether at falls inside an opening period on a day that isn't a holiday
+func (h OpeningHours) isOpenAt(at time.Time) bool {
+    if _, closed := h.holidayOn(at); closed {
+        return false
+    }
+    
+    local := at.In(h.location)
+    minute := TimeOfDay(local.Hour()*60 + local.Minute())
+    for _, period := range h.periods {
+        if period.day == local.Weekday() && period.opens <= minute && minute < period.closes {
+            return true
+        }
+    }
+    return false
+}
+
+// nextOpening returns the first time at or after from that the store is open
+// WHAT: Zero if the store never opens within the next year
+func (h OpeningHours) nextOpening(from time.Time) time.Time {
+    if h.isOpenAt(from) {
+        return from
+    }
+    
+    local := from.In(h.location)
+    for days := 0; days <= 366; days++ {
+        date := time.Date(local.Year(), local.Month(), local.Day()+days, 0, 0, 0, 0, h.location)
+        if _, closed := h.holidays[date.Format(time.DateOnly)]; closed {
+            continue
+        }
+        
+        for _, period := range h.periods {
+            if period.day != date.Weekday() {
+                continue
+            }
+            // Built from the wall clock so daylight saving changes don't shift it
+            opens := time.Date(date.Year(), date.Month(), date.Day(),
+                int(period.opens)/60, int(period.opens)%60, 0, 0, h.location)
+            if opens.After(from) {
+                return opens
+            }
+        }
+    }
+    return time.Time{}
+}
+
+// OpenStatus says whether a store is taking orders at a point in time
+type OpenStatus struct {
+    open        bool
+    reason      string    // Why the store is closed
+    nextOpening time.Time // Zero when open, or when it won't reopen by itself
+}
+
+func (s OpenStatus) IsOpen() bool           { return s.open }
+func (s OpenStatus) Reason() string         { return s.reason }
+func (s OpenStatus) NextOpening() time.Time { return s.nextOpening }
@@ -0,0 +1,82 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// PrepTimes are a store's figures for estimating how long orders take to make
+// WHY: A cart with one person pouring is slower than the main stand, so each store sets its own
+type PrepTimes struct {
+    baseMinutes       int          // Every order, however small
+    minutesPerItem    int          // Per order line
+    largeOrderAmount  shared.Money // Orders totalling at least this take extra
+    largeOrderMinutes int
+    minutesPerQueued  int          // Per order already confirmed or being made at the store
+}
+
+func NewPrepTimes(
+    baseMinutes int,
+    minutesPerItem int,
+    largeOrderAmount shared.Money,
+    largeOrderMinutes int,
+    minutesPerQueued int,
+) (PrepTimes, error) {
+    if baseMinutes < 1 {
+        return PrepTimes{}, fmt.Errorf("%w: base time must be at least a minute", ErrInvalidPrepTimes)
+    }
+    if minutesPerItem < 0 || largeOrderMinutes < 0 || minutesPerQueued < 0 {
+        return PrepTimes{}, fmt.Errorf("%w: minutes can't be negative", ErrInvalidPrepTimes)
+    }
+    if largeOrderAmount.Amount() <= 0 {
+        return PrepTimes{}, fmt.Errorf("%w: large order amount must be positive", ErrInvalidPrepTimes)
+    }
+    
+    return PrepTimes{
+        baseMinutes:       baseMinutes,
+        minutesPerItem:    minutesPerItem,
+        largeOrderAmount:  largeOrderAmount,
+        largeOrderMinutes: largeOrderMinutes,
+        minutesPerQueued:  minutesPerQueued,
+    }, nil
+}
+
+// DefaultPrepTimes is what a new store starts with
+// WHAT: 5 minutes plus 2 per item, 10 more for orders of $50 or over,
+// and 3 more for each order ahead in the kitchen
+func DefaultPrepTimes() PrepTimes {
+    largeOrderAmount, _ := shared.NewMoney(5000, "USD")
+    return PrepTimes{
+        baseMinutes:       5,
+        minutesPerItem:    2,
+        largeOrderAmount:  largeOrderAmount,
+        largeOrderMinutes: 10,
+        minutesPerQueued:  3,
+    }
+}
+
+func (t PrepTimes) BaseMinutes() int               { return t.baseMinutes }
+func (t PrepTimes) MinutesPerItem() int            { return t.minutesPerItem }
+func (t PrepTimes) LargeOrderAmount() shared.Money { return t.largeOrderAmount }
+func (t PrepTimes) LargeOrderMinutes() int         { return t.largeOrderMinutes }
+func (t PrepTimes) MinutesPerQueued() int          { return t.minutesPerQueued }
+
+// SetPrepTimes replaces the figures used to estimate when the store's orders will be ready
+func (s *Store) SetPrepTimes(times PrepTimes) {
+    s.prepTimes = times
+    
+    // Raise domain event
+    s.Raise(PrepTimesSetEvent{
+        BaseEvent:        shared.NewBaseEvent(),
+        StoreID:          string(s.id),
+        BaseMinutes:      times.baseMinutes,
+        MinutesPerItem:   times.minutesPerItem,
+        MinutesPerQueued: times.minutesPerQueued,
+    })
+}
+
+// PrepTimes returns the figures used to estimate when the store's orders will be ready
+func (s *Store) PrepTimes() PrepTimes {
+    return s.prepTimes
+}
@@ -0,0 +1,132 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// PriceChangeReason says why a product's base price changed
+type PriceChangeReason string
+
+const (
+    PriceChangeInitial   PriceChangeReason = "INITIAL"   // Price the product was added with
+    PriceChangeManual    PriceChangeReason = "MANUAL"    // Changed straight away by staff
+    PriceChangeScheduled PriceChangeReason = "SCHEDULED" // A scheduled change that came due
+)
+
+// PriceHistoryEntry records one base price and when it took effect
+// WHY: Finance needs to know what a product cost on any given day
+type PriceHistoryEntry struct {
+    price         shared.Money
+    effectiveFrom time.Time
+    reason        PriceChangeReason
+}
+
+func (e PriceHistoryEntry) Price() shared.Money       { return e.price }
+func (e PriceHistoryEntry) EffectiveFrom() time.Time   { return e.effectiveFrom }
+func (e PriceHistoryEntry) Reason() PriceChangeReason { return e.reason }
+
+// ScheduledPrice is a base price change that takes effect at a future time
+// WHAT: Applied by the price scheduler, but honoured at order time even if the scheduler lags
+type ScheduledPrice struct {
+    id          string
+    price       shared.Money
+    effectiveAt time.Time
+}
+
+func (p ScheduledPrice) ID() string             { return p.id }
+func (p ScheduledPrice) Price() shared.Money    { return p.price }
+func (p ScheduledPrice) EffectiveAt() time.Time { return p.effectiveAt }
+
+// PriceRule is a recurring percentage discount during a window of the week, e.g. happy hour
+// WHY: Rules are evaluated when an order is placed, so base prices never flip back and forth
+// WHAT: Windows are in the store's local time, an empty product list covers the whole menu
+type PriceRule struct {
+    id         string
+    name       string
+    days       []time.Weekday
+    starts     TimeOfDay
+    ends       TimeOfDay
+    percentOff int
+    products   []ProductID
+}
+
+func NewPriceRule(
+    name string,
+    days []time.Weekday,
+    starts TimeOfDay,
+    ends TimeOfDay,
+    percentOff int,
+    products []ProductID,
+) (PriceRule, error) {
+    if strings.TrimSpace(name) == "" {
+        return PriceRule{}, fmt.Errorf("%w: name is required", ErrInvalidPriceRule)
+    }
+    if len(days) == 0 {
+        return PriceRule{}, fmt.Errorf("%w: at least one day is required", ErrInvalidPriceRule)
+    }
+    if ends <= starts {
+        return PriceRule{}, fmt.Errorf("%w: window ends at %s before it starts at %s", ErrInvalidPriceRule, ends, starts)
+    }
+    if percentOff < 1 || percentOff > 99 {
+        return PriceRule{}, fmt.Errorf("%w: percent off must be between 1 and 99", ErrInvalidPriceRule)
+    }
+    
+    sortedDays := append([]time.Weekday(nil), days...)
+    sort.Slice(sortedDays, func(i, j int) bool { return sortedDays[i] < sortedDays[j] })
+    for i := 1; i < len(sortedDays); i++ {
+        if sortedDays[i] == sortedDays[i-1] {
+            return PriceRule{}, fmt.Errorf("%w: %s is listed twice", ErrInvalidPriceRule, sortedDays[i])
+        }
+    }
+    
+    return PriceRule{
+        id:         uuid.New().String(),
+        name:       name,
+        days:       sortedDays,
+        starts:     starts,
+        ends:       ends,
+        percentOff: percentOff,
+        products:   append([]ProductID(nil), products...),
+    }, nil
+}
+
+func (r PriceRule) ID() string            { return r.id }
+func (r PriceRule) Name() string          { return r.name }
+func (r PriceRule) Days() []time.Weekday  { return r.days }
+func (r PriceRule) Starts() TimeOfDay     { return r.starts }
+func (r PriceRule) Ends() TimeOfDay       { return r.ends }
+func (r PriceRule) PercentOff() int       { return r.percentOff }
+func (r PriceRule) Products() []ProductID { return r.products }
+
+// appliesTo reports whether the rule covers the product at the given local time
+func (r PriceRule) appliesTo(productID ProductID, local time.Time) bool {
+    if len(r.products) > 0 {
+        covered := false
+        for _, id := range r.products {
+            if id == productID {
+                covered = true
+                break
+            }
+        }
+        if !covered {
+            return false
+        }
+    }
+    
+    minute := TimeOfDay(local.Hour()*60 + local.Minute())
+    if minute < r.starts || minute >= r.ends {
+        return false
+    }
+    for _, day := range r.days {
+        if day == local.Weekday() {
+            return true
+        }
+    }
+    return false
+}
@@ -2,7 +2,12 @@ package store
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
 )
 
@@ -15,6 +20,16 @@ type Product struct {
     description string
     price       shared.Money
     isActive    bool
+    taxExempt   bool // e.g. bottled water in states that don't tax groceries
+    modifiers   []*ModifierGroup
+    recipe      Recipe // Empty for products stocked as finished units
+    
+    // Where the product sits on the menu
+    categoryID   CategoryID // Empty for uncategorized products
+    displayOrder int        // Lower comes first within the category
+    
+    priceHistory []PriceHistoryEntry // Base prices, oldest first
+    scheduled    []ScheduledPrice    // Future base prices, soonest first
 }
 
 // NewProduct creates a new product with validation
@@ -35,31 +50,253 @@ func NewProduct(name string, description string, price shared.Money) (*Product,
         description: description,
         price:       price,
         isActive:    true,
+        modifiers:   make([]*ModifierGroup, 0),
+        
+        priceHistory: []PriceHistoryEntry{{price: price, effectiveFrom: time.Now(), reason: PriceChangeInitial}},
     }, nil
 }
 
-// UpdatePrice changes the product price
-// WHY: Business rule - price changes must be tracked and validated
-func (p *Product) UpdatePrice(newPrice shared.Money) error {
+// checkPrice validates a new base price for the product
+func (p *Product) checkPrice(newPrice shared.Money) error {
     if newPrice.Amount() <= 0 {
-        return errors.New("price must be greater than zero")
+        return fmt.Errorf("%w: price must be greater than zero", ErrInvalidPrice)
     }
     if newPrice.Currency() != p.price.Currency() {
-        return errors.New("cannot change currency of existing product")
+        return fmt.Errorf("%w: cannot change currency of existing product", ErrInvalidPrice)
+    }
+    return nil
+}
+
+// updatePrice changes the product price
+// WHY: Business rule - price changes must be tracked and validated
+// WHERE: Called through the Store aggregate so the change raises an event
+func (p *Product) updatePrice(newPrice shared.Money, effectiveFrom time.Time, reason PriceChangeReason) error {
+    if err := p.checkPrice(newPrice); err != nil {
+        return err
     }
     p.price = newPrice
+    p.priceHistory = append(p.priceHistory, PriceHistoryEntry{
+        price:         newPrice,
+        effectiveFrom: effectiveFrom,
+        reason:        reason,
+    })
+    return nil
+}
+
+// schedulePrice queues a base price change for a future time
+func (p *Product) schedulePrice(newPrice shared.Money, effectiveAt time.Time) (ScheduledPrice, error) {
+    if err := p.checkPrice(newPrice); err != nil {
+        return ScheduledPrice{}, err
+    }
+    
+    change := ScheduledPrice{
+        id:          uuid.New().String(),
+        price:       newPrice,
+        effectiveAt: effectiveAt,
+    }
+    
+    // Keep soonest first so due changes come off the front in order
+    index := sort.Search(len(p.scheduled), func(i int) bool {
+        return p.scheduled[i].effectiveAt.After(effectiveAt)
+    })
+    p.scheduled = append(p.scheduled, ScheduledPrice{})
+    copy(p.scheduled[index+1:], p.scheduled[index:])
+    p.scheduled[index] = change
+    
+    return change, nil
+}
+
+// cancelScheduledPrice drops a queued change, reporting whether it was found
+func (p *Product) cancelScheduledPrice(changeID string) (ScheduledPrice, bool) {
+    for i, change := range p.scheduled {
+        if change.id == changeID {
+            p.scheduled = append(p.scheduled[:i], p.scheduled[i+1:]...)
+            return change, true
+        }
+    }
+    return ScheduledPrice{}, false
+}
+
+// popDuePrices removes and returns the queued changes that take effect at or before now
+func (p *Product) popDuePrices(now time.Time) []ScheduledPrice {
+    due := 0
+    for due < len(p.scheduled) && !p.scheduled[due].effectiveAt.After(now) {
+        due++
+    }
+    if due == 0 {
+        return nil
+    }
+    
+    changes := append([]ScheduledPrice(nil), p.scheduled[:due]...)
+    p.scheduled = p.scheduled[due:]
+    return changes
+}
+
+// BasePriceAt returns the base price in force at a point in time
+// WHAT: Counts scheduled changes that are due even if the scheduler hasn't applied them yet
+func (p *Product) BasePriceAt(at time.Time) shared.Money {
+    price := p.price
+    for _, change := range p.scheduled {
+        if change.effectiveAt.After(at) {
+            break
+        }
+        price = change.price
+    }
+    return price
+}
+
+// setTaxExempt marks whether sales tax is charged on the product
+// WHY: Some jurisdictions exempt certain goods, so tax is decided per product
+// WHERE: Called through Store.SetProductTaxExempt so the change raises an event
+func (p *Product) setTaxExempt(exempt bool) {
+    p.taxExempt = exempt
+}
+
+// AddModifierGroup lets customers customize the product
+// WHY: Group names must be unique so a selection reads unambiguously on the order
+func (p *Product) AddModifierGroup(group *ModifierGroup) error {
+    if len(group.Options()) == 0 {
+        return errors.New("modifier group must have at least one option")
+    }
+    
+    if len(group.Options()) < group.MinSelections() {
+        return errors.New("modifier group has fewer options than its minimum selections")
+    }
+    
+    for _, option := range group.Options() {
+        if !option.PriceDelta().IsZero() && option.PriceDelta().Currency() != p.price.Currency() {
+            return fmt.Errorf("option %s must be priced in %s", option.Name(), p.price.Currency())
+        }
+    }
+    
+    for _, existing := range p.modifiers {
+        if strings.EqualFold(existing.Name(), group.Name()) {
+            return fmt.Errorf("modifier group %q already exists", group.Name())
+        }
+    }
+    
+    p.modifiers = append(p.modifiers, group)
     return nil
 }
 
+// SelectModifiers checks the customer's choices against the modifier groups
+// WHAT: Returns the selection in menu order, so the same choices always compare equal
+func (p *Product) SelectModifiers(optionIDs []string) ([]SelectedModifier, error) {
+    chosen := make(map[string]bool, len(optionIDs))
+    for _, optionID := range optionIDs {
+        if chosen[optionID] {
+            return nil, fmt.Errorf("%w: option %s selected twice", ErrInvalidModifiers, optionID)
+        }
+        chosen[optionID] = true
+    }
+    
+    selected := make([]SelectedModifier, 0, len(optionIDs))
+    for _, group := range p.modifiers {
+        count := 0
+        for _, option := range group.Options() {
+            if !chosen[option.ID()] {
+                continue
+            }
+            delete(chosen, option.ID())
+            count++
+            selected = append(selected, SelectedModifier{
+                groupID:    group.ID(),
+                groupName:  group.Name(),
+                optionID:   option.ID(),
+                optionName: option.Name(),
+                priceDelta: option.PriceDelta(),
+            })
+        }
+        
+        if count < group.MinSelections() {
+            return nil, fmt.Errorf("%w: %s requires at least %d selection(s)", ErrInvalidModifiers, group.Name(), group.MinSelections())
+        }
+        if count > group.MaxSelections() {
+            return nil, fmt.Errorf("%w: %s allows at most %d selection(s)", ErrInvalidModifiers, group.Name(), group.MaxSelections())
+        }
+    }
+    
+    // Anything left over does not belong to this product
+    for optionID := range chosen {
+        return nil, fmt.Errorf("%w: option %s not available for %s", ErrInvalidModifiers, optionID, p.name)
+    }
+    
+    return selected, nil
+}
+
+// PriceWith returns the unit price at a point in time including the selected modifiers
+func (p *Product) PriceWith(modifiers []SelectedModifier, at time.Time) (shared.Money, error) {
+    price := p.BasePriceAt(at)
+    for _, modifier := range modifiers {
+        if modifier.PriceDelta().IsZero() {
+            continue
+        }
+        var err error
+        price, err = price.Add(modifier.PriceDelta())
+        if err != nil {
+            return shared.Money{}, err
+        }
+    }
+    return price, nil
+}
+
+// findOption returns the modifier option with the given ID from any group
+func (p *Product) findOption(optionID string) (*ModifierOption, bool) {
+    for _, group := range p.modifiers {
+        if option, found := group.findOption(optionID); found {
+            return option, true
+        }
+    }
+    return nil, false
+}
+
+// requirements returns the ingredients needed to make quantity units with the modifiers
+func (p *Product) requirements(quantity int, modifiers []SelectedModifier) map[IngredientID]int {
+    requirements := make(map[IngredientID]int)
+    p.recipe.addTo(requirements, quantity)
+    for _, modifier := range modifiers {
+        if option, found := p.findOption(modifier.OptionID()); found {
+            option.recipe.addTo(requirements, quantity)
+        }
+    }
+    return requirements
+}
+
+// edit replaces the product's menu details
+// WHERE: Called through the Store aggregate, which checks names and categories
+func (p *Product) edit(name ProductName, description string, categoryID CategoryID, displayOrder int) {
+    p.name = name
+    p.description = description
+    p.categoryID = categoryID
+    p.displayOrder = displayOrder
+}
+
 // Deactivate marks product as unavailable
 // WHAT: Soft delete - we don't remove products, just deactivate them
 func (p *Product) Deactivate() {
     p.isActive = false
 }
 
+// Reactivate puts a deactivated product back on the menu
+func (p *Product) Reactivate() {
+    p.isActive = true
+}
+
 // Getters for encapsulation
-func (p *Product) ID() ProductID         { return p.id }
-func (p *Product) Name() ProductName     { return p.name }
-func (p *Product) Description() string   { return p.description }
-func (p *Product) Price() shared.Money   { return p.price }
-func (p *Product) IsActive() bool        { return p.isActive }
+func (p *Product) ID() ProductID                    { return p.id }
+func (p *Product) Name() ProductName                { return p.name }
+func (p *Product) Description() string              { return p.description }
+func (p *Product) Price() shared.Money              { return p.price }
+func (p *Product) IsActive() bool                   { return p.isActive }
+func (p *Product) IsTaxExempt() bool                { return p.taxExempt }
+func (p *Product) ModifierGroups() []*ModifierGroup { return p.modifiers }
+func (p *Product) Recipe() Recipe                   { return p.recipe }
+func (p *Product) IsMadeToOrder() bool              { return !p.recipe.IsEmpty() }
+func (p *Product) CategoryID() CategoryID           { return p.categoryID }
+func (p *Product) DisplayOrder() int                { return p.displayOrder }
+
+// PriceHistory returns every base price the product has had, oldest first
+func (p *Product) PriceHistory() []PriceHistoryEntry { return p.priceHistory }
+
+// ScheduledPrices returns queued base price changes, soonest first
+func (p *Product) ScheduledPrices() []ScheduledPrice { return p.scheduled }
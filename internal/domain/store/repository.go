@@ -8,3 +8,10 @@ type StoreRepository interface {
     FindByID(id StoreID) (*Store, error)
     FindAll() ([]*Store, error)
 }
+
+// TransferRepository defines persistence operations for Transfer aggregate
+type TransferRepository interface {
+    Save(transfer *Transfer) error
+    FindByID(id TransferID) (*Transfer, error)
+    FindByStoreID(storeID StoreID) ([]*Transfer, error) // Sent from or to the store
+}
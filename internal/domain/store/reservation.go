@@ -0,0 +1,65 @@
+package store
+
+import "time"
+
+// ReleaseReason says why reserved stock went back on the shelf
+type ReleaseReason string
+
+const (
+    ReleaseReasonCancelled ReleaseReason = "CANCELLED"
+    ReleaseReasonExpired   ReleaseReason = "EXPIRED"
+)
+
+// Reservation holds stock for one order until it is completed or released
+// WHY: Stock stays on hand while an order is open so cancellations don't look like deliveries
+// WHAT: An expiry lets the sweeper free stock held by abandoned orders
+type Reservation struct {
+    orderID   string
+    lines     []ReservationLine
+    expiresAt time.Time // Zero once held for preparation
+}
+
+// ReservationLine is the stock held for one order line
+type ReservationLine struct {
+    productID   ProductID
+    quantity    int
+    ingredients map[IngredientID]int // Empty for products stocked as finished units
+}
+
+func (l ReservationLine) ProductID() ProductID              { return l.productID }
+func (l ReservationLine) Quantity() int                     { return l.quantity }
+func (l ReservationLine) Ingredients() map[IngredientID]int { return l.ingredients }
+
+// IsExpired reports whether the hold lapsed before the order was started
+func (r *Reservation) IsExpired(now time.Time) bool {
+    return !r.expiresAt.IsZero() && !now.Before(r.expiresAt)
+}
+
+func (r *Reservation) OrderID() string          { return r.orderID }
+func (r *Reservation) Lines() []ReservationLine { return r.lines }
+func (r *Reservation) ExpiresAt() time.Time     { return r.expiresAt }
+
+// snapshots converts the lines for events
+func (r *Reservation) snapshots() []ReservationLineSnapshot {
+    snapshots := make([]ReservationLineSnapshot, len(r.lines))
+    for i, line := range r.lines {
+        snapshots[i] = ReservationLineSnapshot{
+            ProductID:   string(line.productID),
+            Quantity:    line.quantity,
+            Ingredients: ingredientAmounts(line.ingredients),
+        }
+    }
+    return snapshots
+}
+
+func ingredientAmounts(ingredients map[IngredientID]int) map[string]int {
+    if len(ingredients) == 0 {
+        return nil
+    }
+    
+    amounts := make(map[string]int, len(ingredients))
+    for ingredientID, amount := range ingredients {
+        amounts[string(ingredientID)] = amount
+    }
+    return amounts
+}
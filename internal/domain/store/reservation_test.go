@@ -0,0 +1,150 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// newTestStore creates a store selling bottled lemonade with stock on hand
+func newTestStore(t *testing.T, name string, stock int) (*Store, *Product) {
+    t.Helper()
+    location, err := shared.NewAddress("1 Main St", "Lemonade City", "CA", "90001", "US")
+    if err != nil {
+        t.Fatalf("NewAddress: %v", err)
+    }
+    storeAgg, err := NewStore(name, location)
+    if err != nil {
+        t.Fatalf("NewStore: %v", err)
+    }
+    
+    price, _ := shared.NewMoney(300, "USD")
+    product, err := storeAgg.AddProduct("Bottled Lemonade", "Ready to drink", price)
+    if err != nil {
+        t.Fatalf("AddProduct: %v", err)
+    }
+    if stock > 0 {
+        if err := storeAgg.AddInventory(product.ID(), stock); err != nil {
+            t.Fatalf("AddInventory: %v", err)
+        }
+    }
+    storeAgg.PullEvents()
+    
+    return storeAgg, product
+}
+
+func available(t *testing.T, storeAgg *Store, productID ProductID) int {
+    t.Helper()
+    quantity, err := storeAgg.GetAvailableQuantity(productID)
+    if err != nil {
+        t.Fatalf("GetAvailableQuantity: %v", err)
+    }
+    return quantity
+}
+
+func TestReservationHoldsStockUntilItExpires(t *testing.T) {
+    storeAgg, product := newTestStore(t, "Main St", 10)
+    now := time.Now()
+    
+    err := storeAgg.ReserveInventory("order-1", product.ID(), 3, nil, now.Add(15*time.Minute))
+    if err != nil {
+        t.Fatalf("ReserveInventory: %v", err)
+    }
+    
+    if got := available(t, storeAgg, product.ID()); got != 7 {
+        t.Errorf("available = %d, want 7", got)
+    }
+    if got := storeAgg.GetReservedQuantity(product.ID()); got != 3 {
+        t.Errorf("reserved = %d, want 3", got)
+    }
+    if expired := storeAgg.ExpiredReservations(now.Add(14 * time.Minute)); len(expired) != 0 {
+        t.Errorf("expired before its time: %v", expired)
+    }
+    
+    expired := storeAgg.ExpiredReservations(now.Add(15 * time.Minute))
+    if len(expired) != 1 || expired[0] != "order-1" {
+        t.Fatalf("expired = %v, want [order-1]", expired)
+    }
+    
+    err = storeAgg.ReleaseReservation("order-1", ReleaseReasonExpired)
+    if err != nil {
+        t.Fatalf("ReleaseReservation: %v", err)
+    }
+    if got := available(t, storeAgg, product.ID()); got != 10 {
+        t.Errorf("available after release = %d, want 10", got)
+    }
+    
+    err = storeAgg.ReleaseReservation("order-1", ReleaseReasonCancelled)
+    if !errors.Is(err, ErrReservationNotFound) {
+        t.Errorf("second release err = %v, want ErrReservationNotFound", err)
+    }
+}
+
+func TestHeldReservationNeverExpires(t *testing.T) {
+    storeAgg, product := newTestStore(t, "Main St", 10)
+    now := time.Now()
+    
+    err := storeAgg.ReserveInventory("order-1", product.ID(), 2, nil, now.Add(15*time.Minute))
+    if err != nil {
+        t.Fatalf("ReserveInventory: %v", err)
+    }
+    
+    err = storeAgg.HoldReservation("order-1")
+    if err != nil {
+        t.Fatalf("HoldReservation: %v", err)
+    }
+    
+    if expired := storeAgg.ExpiredReservations(now.Add(24 * time.Hour)); len(expired) != 0 {
+        t.Errorf("held reservation expired: %v", expired)
+    }
+    if err := storeAgg.HoldReservation("order-2"); !errors.Is(err, ErrReservationNotFound) {
+        t.Errorf("holding unknown reservation err = %v, want ErrReservationNotFound", err)
+    }
+}
+
+func TestCommitReservationTakesStockOffHand(t *testing.T) {
+    storeAgg, product := newTestStore(t, "Main St", 10)
+    
+    err := storeAgg.ReserveInventory("order-1", product.ID(), 4, nil, time.Now().Add(time.Hour))
+    if err != nil {
+        t.Fatalf("ReserveInventory: %v", err)
+    }
+    
+    err = storeAgg.CommitReservation("order-1")
+    if err != nil {
+        t.Fatalf("CommitReservation: %v", err)
+    }
+    
+    if got := int(storeAgg.inventory[product.ID()]); got != 6 {
+        t.Errorf("on hand = %d, want 6", got)
+    }
+    if got := storeAgg.GetReservedQuantity(product.ID()); got != 0 {
+        t.Errorf("reserved = %d, want 0", got)
+    }
+    if got := available(t, storeAgg, product.ID()); got != 6 {
+        t.Errorf("available = %d, want 6", got)
+    }
+}
+
+func TestReserveMoreThanAvailableHoldsNothing(t *testing.T) {
+    storeAgg, product := newTestStore(t, "Main St", 5)
+    expiresAt := time.Now().Add(time.Hour)
+    
+    err := storeAgg.ReserveInventory("order-1", product.ID(), 4, nil, expiresAt)
+    if err != nil {
+        t.Fatalf("ReserveInventory: %v", err)
+    }
+    
+    err = storeAgg.ReserveInventory("order-2", product.ID(), 2, nil, expiresAt)
+    if !errors.Is(err, ErrInsufficientStock) {
+        t.Fatalf("err = %v, want ErrInsufficientStock", err)
+    }
+    if got := storeAgg.GetReservedQuantity(product.ID()); got != 4 {
+        t.Errorf("reserved = %d, want 4", got)
+    }
+    if err := storeAgg.HoldReservation("order-2"); !errors.Is(err, ErrReservationNotFound) {
+        t.Errorf("failed reservation was kept: %v", err)
+    }
+}
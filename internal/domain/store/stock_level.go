@@ -0,0 +1,84 @@
+package store
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+
+// StockLevel describes how close a product is to running out
+type StockLevel string
+
+const (
+    StockLevelOK         StockLevel = "OK"
+    StockLevelLow        StockLevel = "LOW"
+    StockLevelOutOfStock StockLevel = "OUT_OF_STOCK"
+)
+
+// stockLevelFor places an available quantity against a reorder point
+// WHAT: A reorder point of zero means only running out is reported
+func stockLevelFor(available int, reorderPoint Quantity) StockLevel {
+    switch {
+    case available <= 0:
+        return StockLevelOutOfStock
+    case available <= int(reorderPoint):
+        return StockLevelLow
+    default:
+        return StockLevelOK
+    }
+}
+
+// stockLevels returns the current level of every active product
+// WHERE: Taken before a stock change so raiseStockAlerts can see what crossed a threshold
+func (s *Store) stockLevels() map[ProductID]StockLevel {
+    levels := make(map[ProductID]StockLevel, len(s.products))
+    for productID, product := range s.products {
+        if !product.IsActive() {
+            continue
+        }
+        available, _ := s.GetAvailableQuantity(productID)
+        levels[productID] = stockLevelFor(available, s.reorderPoints[productID])
+    }
+    return levels
+}
+
+// raiseStockAlerts raises an event for every product whose level changed since before
+// WHY: Ingredients are shared, so a Strawberry order can make Classic run low too
+// WHAT: A product new to the menu starts from OK, so it is reported if it has no stock.
+// A product taken off the menu has its alert cleared.
+func (s *Store) raiseStockAlerts(before map[ProductID]StockLevel) {
+    after := s.stockLevels()
+    for productID, previous := range before {
+        if _, active := after[productID]; !active && previous != StockLevelOK {
+            s.Raise(StockAlertClearedEvent{BaseEvent: shared.NewBaseEvent(), StockAlert: s.stockAlert(productID)})
+        }
+    }
+    
+    for productID, level := range after {
+        previous, known := before[productID]
+        if !known {
+            previous = StockLevelOK
+        }
+        if level == previous {
+            continue
+        }
+        
+        alert := s.stockAlert(productID)
+        switch level {
+        case StockLevelLow:
+            s.Raise(LowStockEvent{BaseEvent: shared.NewBaseEvent(), StockAlert: alert})
+        case StockLevelOutOfStock:
+            s.Raise(OutOfStockEvent{BaseEvent: shared.NewBaseEvent(), StockAlert: alert})
+        default:
+            s.Raise(StockReplenishedEvent{BaseEvent: shared.NewBaseEvent(), StockAlert: alert})
+        }
+    }
+}
+
+// stockAlert returns the details stock level events carry for a product
+func (s *Store) stockAlert(productID ProductID) StockAlert {
+    available, _ := s.GetAvailableQuantity(productID)
+    return StockAlert{
+        StoreID:      string(s.id),
+        ProductID:    string(productID),
+        ProductName:  string(s.products[productID].Name()),
+        Available:    available,
+        ReorderPoint: int(s.reorderPoints[productID]),
+    }
+}
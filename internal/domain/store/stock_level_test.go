@@ -0,0 +1,74 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// stockEvents returns the names of the stock level events raised for a product
+func stockEvents(storeAgg *Store, productID ProductID) []string {
+    var names []string
+    for _, event := range storeAgg.PullEvents() {
+        var alert StockAlert
+        switch e := event.(type) {
+        case LowStockEvent:
+            alert = e.StockAlert
+        case OutOfStockEvent:
+            alert = e.StockAlert
+        case StockReplenishedEvent:
+            alert = e.StockAlert
+        case StockAlertClearedEvent:
+            alert = e.StockAlert
+        default:
+            continue
+        }
+        if alert.ProductID == string(productID) {
+            names = append(names, event.EventName())
+        }
+    }
+    return names
+}
+
+func TestNewProductIsReportedOutOfStock(t *testing.T) {
+    storeAgg, _ := newTestStore(t, "Main St", 10)
+    
+    price, _ := shared.NewMoney(350, "USD")
+    product, err := storeAgg.AddProduct("Pink Lemonade", "Ready to drink", price)
+    if err != nil {
+        t.Fatalf("AddProduct: %v", err)
+    }
+    
+    got := stockEvents(storeAgg, product.ID())
+    if len(got) != 1 || got[0] != "inventory.out_of_stock" {
+        t.Errorf("events = %v, want [inventory.out_of_stock]", got)
+    }
+}
+
+func TestDeactivatingProductClearsItsAlert(t *testing.T) {
+    storeAgg, product := newTestStore(t, "Main St", 5)
+    err := storeAgg.SetReorderPoint(product.ID(), 10)
+    if err != nil {
+        t.Fatalf("SetReorderPoint: %v", err)
+    }
+    if got := stockEvents(storeAgg, product.ID()); len(got) != 1 || got[0] != "inventory.low_stock" {
+        t.Fatalf("events = %v, want [inventory.low_stock]", got)
+    }
+    
+    err = storeAgg.DeactivateProduct(product.ID())
+    if err != nil {
+        t.Fatalf("DeactivateProduct: %v", err)
+    }
+    if got := stockEvents(storeAgg, product.ID()); len(got) != 1 || got[0] != "inventory.stock_alert_cleared" {
+        t.Errorf("events = %v, want [inventory.stock_alert_cleared]", got)
+    }
+    
+    // Back on the menu, it is still low
+    err = storeAgg.ReactivateProduct(product.ID())
+    if err != nil {
+        t.Fatalf("ReactivateProduct: %v", err)
+    }
+    if got := stockEvents(storeAgg, product.ID()); len(got) != 1 || got[0] != "inventory.low_stock" {
+        t.Errorf("events = %v, want [inventory.low_stock]", got)
+    }
+}
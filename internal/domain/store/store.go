@@ -2,20 +2,48 @@ package store
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
 )
 
 // Store is the aggregate root for store management
 // WHY: Store is the consistency boundary for products and inventory
-// WHAT: Manages products and inventory as a cohesive unit
+// WHAT: Manages products, ingredients and inventory as a cohesive unit
 type Store struct {
     shared.AggregateRoot // Embed for event functionality
-    id        StoreID
-    name      string
-    location  shared.Address
-    products  map[ProductID]*Product
-    inventory map[ProductID]Quantity
+    id          StoreID
+    name        string
+    location    shared.Address
+    products    map[ProductID]*Product
+    categories  map[CategoryID]*Category
+    inventory   map[ProductID]Quantity // Finished units on hand, for products without a recipe
+    ingredients map[IngredientID]*Ingredient
+    stock       map[IngredientID]Quantity // Ingredient levels on hand in the ingredient's unit
+    
+    // Stock held for open orders, still counted on hand until committed
+    reservations  map[string]*Reservation // Keyed by order ID
+    reserved      map[ProductID]Quantity  // Units held per product
+    reservedStock map[IngredientID]Quantity
+    
+    reorderPoints map[ProductID]Quantity // Available level at or below which a product is low
+    adjustments   []Adjustment           // Manual stock corrections, oldest first
+    incoming      map[StockItem]Quantity // Transferred from other stores, not yet on the shelf
+    
+    // When the store takes orders
+    openingHours OpeningHours // Unset means always open
+    paused       bool
+    pauseReason  string
+    pausedUntil  time.Time // Zero means until resumed by hand
+    
+    priceRules []PriceRule // Recurring discounts such as happy hour, in the order added
+    
+    deliveryBands []DeliveryBand // Where the store delivers, empty for pickup only
+    
+    prepTimes PrepTimes // How long orders take to make, for ready time estimates
 }
 
 // NewStore creates a new store
@@ -29,8 +57,19 @@ func NewStore(name string, location shared.Address) (*Store, error) {
         id:        NewStoreID(),
         name:      name,
         location:  location,
-        products:  make(map[ProductID]*Product),
-        inventory: make(map[ProductID]Quantity),
+        products:    make(map[ProductID]*Product),
+        categories:  make(map[CategoryID]*Category),
+        inventory:   make(map[ProductID]Quantity),
+        ingredients: make(map[IngredientID]*Ingredient),
+        stock:       make(map[IngredientID]Quantity),
+        
+        reservations:  make(map[string]*Reservation),
+        reserved:      make(map[ProductID]Quantity),
+        reservedStock: make(map[IngredientID]Quantity),
+        reorderPoints: make(map[ProductID]Quantity),
+        incoming:      make(map[StockItem]Quantity),
+        
+        prepTimes: DefaultPrepTimes(),
     }
     
     // Raise domain event
@@ -54,12 +93,11 @@ func (s *Store) AddProduct(name string, description string, price shared.Money)
     }
     
     // Check for duplicate product names
-    for _, p := range s.products {
-        if p.Name() == product.Name() && p.IsActive() {
-            return nil, errors.New("product with this name already exists")
-        }
+    if s.hasActiveProductNamed(product.Name(), "") {
+        return nil, ErrDuplicateProduct
     }
     
+    before := s.stockLevels()
     s.products[product.ID()] = product
     s.inventory[product.ID()] = 0 // Initialize with zero inventory
     
@@ -71,13 +109,47 @@ func (s *Store) AddProduct(name string, description string, price shared.Money)
         ProductName: string(product.Name()),
         Price:       price,
     })
+    // A new product has nothing to sell until it is stocked
+    s.raiseStockAlerts(before)
     
     return product, nil
 }
 
-// AddInventory increases product quantity
-// WHERE: Called when receiving new stock
-func (s *Store) AddInventory(productID ProductID, quantity int) error {
+// AddModifierGroup adds a customizable choice to one of the store's products
+// WHY: Menu changes go through the Store aggregate so they raise events
+func (s *Store) AddModifierGroup(productID ProductID, group *ModifierGroup) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    err = product.AddModifierGroup(group)
+    if err != nil {
+        return err
+    }
+    
+    options := make([]string, len(group.Options()))
+    for i, option := range group.Options() {
+        options[i] = option.Name()
+    }
+    
+    // Raise domain event
+    s.Raise(ModifierGroupAddedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        ProductID: string(productID),
+        GroupID:   group.ID(),
+        GroupName: group.Name(),
+        Required:  group.IsRequired(),
+        Options:   options,
+    })
+    
+    return nil
+}
+
+// CanAddInventory checks that stock could be added without adding it
+// WHY: Callers that also move money or paperwork check every line before changing anything
+func (s *Store) CanAddInventory(productID ProductID, quantity int) error {
     if _, exists := s.products[productID]; !exists {
         return errors.New("product not found")
     }
@@ -86,11 +158,25 @@ func (s *Store) AddInventory(productID ProductID, quantity int) error {
         return errors.New("cannot add inventory to inactive product")
     }
     
-    qty, err := NewQuantity(quantity)
+    // Made-to-order products are stocked through their ingredients
+    if s.products[productID].IsMadeToOrder() {
+        return errors.New("product is made from a recipe, restock its ingredients instead")
+    }
+    
+    _, err := NewQuantity(quantity)
+    return err
+}
+
+// AddInventory increases product quantity
+// WHERE: Called when receiving new stock
+func (s *Store) AddInventory(productID ProductID, quantity int) error {
+    err := s.CanAddInventory(productID, quantity)
     if err != nil {
         return err
     }
+    qty := Quantity(quantity)
     
+    before := s.stockLevels()
     s.inventory[productID] += qty
     
     // Raise domain event
@@ -101,58 +187,1210 @@ func (s *Store) AddInventory(productID ProductID, quantity int) error {
         QuantityAdded: int(qty),
         NewTotal:      int(s.inventory[productID]),
     })
+    s.raiseStockAlerts(before)
     
     return nil
 }
 
-// ReserveInventory decreases product quantity for an order
-// WHY: Ensures we don't oversell products
-func (s *Store) ReserveInventory(productID ProductID, quantity int) error {
-    currentQty, exists := s.inventory[productID]
-    if !exists {
-        return errors.New("product not found")
+// AddIngredient adds an ingredient to the store's catalog with zero stock
+// WHY: Recipes can only reference ingredients the store knows about
+func (s *Store) AddIngredient(name string, unit Unit) (*Ingredient, error) {
+    ingredient, err := NewIngredient(name, unit)
+    if err != nil {
+        return nil, err
+    }
+    
+    // Check for duplicate ingredient names
+    for _, existing := range s.ingredients {
+        if existing.Name() == ingredient.Name() {
+            return nil, ErrDuplicateIngredient
+        }
+    }
+    
+    s.ingredients[ingredient.ID()] = ingredient
+    s.stock[ingredient.ID()] = 0
+    
+    // Raise domain event
+    s.Raise(IngredientAddedEvent{
+        BaseEvent:      shared.NewBaseEvent(),
+        StoreID:        string(s.id),
+        IngredientID:   string(ingredient.ID()),
+        IngredientName: ingredient.Name(),
+        Unit:           string(unit),
+    })
+    
+    return ingredient, nil
+}
+
+// CanRestockIngredient checks that an ingredient could be restocked without restocking it
+// WHERE: Checked for every line of a delivery before the delivery is booked
+func (s *Store) CanRestockIngredient(ingredientID IngredientID, quantity int) error {
+    if _, exists := s.ingredients[ingredientID]; !exists {
+        return ErrIngredientNotFound
+    }
+    
+    _, err := NewQuantity(quantity)
+    return err
+}
+
+// RestockIngredient increases an ingredient's stock level
+// WHERE: Called when a delivery of lemons, sugar, cups etc. arrives
+func (s *Store) RestockIngredient(ingredientID IngredientID, quantity int) error {
+    err := s.CanRestockIngredient(ingredientID, quantity)
+    if err != nil {
+        return err
+    }
+    qty := Quantity(quantity)
+    
+    before := s.stockLevels()
+    s.stock[ingredientID] += qty
+    
+    // Raise domain event
+    s.Raise(IngredientRestockedEvent{
+        BaseEvent:     shared.NewBaseEvent(),
+        StoreID:       string(s.id),
+        IngredientID:  string(ingredientID),
+        QuantityAdded: int(qty),
+        NewTotal:      int(s.stock[ingredientID]),
+    })
+    s.raiseStockAlerts(before)
+    
+    return nil
+}
+
+// SetRecipe sets what goes into a product, or into one of its modifier options
+// WHY: Once a product has a recipe its stock comes from ingredient levels
+// WHAT: An empty optionID sets the product's own recipe
+func (s *Store) SetRecipe(productID ProductID, optionID string, recipe Recipe) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    for _, component := range recipe.Components() {
+        if _, exists := s.ingredients[component.IngredientID()]; !exists {
+            return fmt.Errorf("%w: %s", ErrIngredientNotFound, component.IngredientID())
+        }
+    }
+    
+    if optionID == "" {
+        product.recipe = recipe
+    } else {
+        option, found := product.findOption(optionID)
+        if !found {
+            return fmt.Errorf("%w: unknown option %s", ErrInvalidModifiers, optionID)
+        }
+        option.recipe = recipe
+    }
+    
+    components := make(map[string]int, len(recipe.Components()))
+    for _, component := range recipe.Components() {
+        components[string(component.IngredientID())] = component.Quantity()
+    }
+    
+    // Raise domain event
+    s.Raise(RecipeSetEvent{
+        BaseEvent:  shared.NewBaseEvent(),
+        StoreID:    string(s.id),
+        ProductID:  string(productID),
+        OptionID:   optionID,
+        Components: components,
+    })
+    
+    return nil
+}
+
+// ReserveInventory holds stock for an order line until the order completes
+// WHY: Ensures we don't oversell products while orders are open
+// WHAT: Made-to-order products hold ingredients for the drink and its modifiers,
+// other products hold finished units. Lines for the same order share one reservation.
+func (s *Store) ReserveInventory(
+    orderID string,
+    productID ProductID,
+    quantity int,
+    modifiers []SelectedModifier,
+    expiresAt time.Time,
+) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
     }
     
-    if int(currentQty) < quantity {
-        return errors.New("insufficient inventory")
+    before := s.stockLevels()
+    line := ReservationLine{productID: productID, quantity: quantity}
+    if product.IsMadeToOrder() {
+        requirements := product.requirements(quantity, modifiers)
+        
+        // Check every ingredient before holding any so a shortfall changes nothing
+        for ingredientID, needed := range requirements {
+            if s.availableStock(ingredientID) < needed {
+                return fmt.Errorf("%w: not enough %s for %s",
+                    ErrInsufficientStock, s.ingredientName(ingredientID), product.Name())
+            }
+        }
+        
+        for ingredientID, needed := range requirements {
+            s.reservedStock[ingredientID] += Quantity(needed)
+        }
+        line.ingredients = requirements
+    } else {
+        available, _ := s.GetAvailableQuantity(productID)
+        if available < quantity {
+            return fmt.Errorf("%w: %s", ErrInsufficientStock, product.Name())
+        }
+    }
+    s.reserved[productID] += Quantity(quantity)
+    
+    reservation, exists := s.reservations[orderID]
+    if !exists {
+        reservation = &Reservation{orderID: orderID, expiresAt: expiresAt}
+        s.reservations[orderID] = reservation
     }
+    reservation.lines = append(reservation.lines, line)
     
-    s.inventory[productID] = Quantity(int(currentQty) - quantity)
+    remaining, _ := s.GetAvailableQuantity(productID)
     
     // Raise domain event
     s.Raise(InventoryReservedEvent{
         BaseEvent:         shared.NewBaseEvent(),
         StoreID:          string(s.id),
+        OrderID:          orderID,
         ProductID:        string(productID),
         QuantityReserved: quantity,
-        RemainingQty:     int(s.inventory[productID]),
+        RemainingQty:     remaining,
+        Ingredients:      ingredientAmounts(line.ingredients),
+        ExpiresAt:        reservation.expiresAt,
     })
+    s.raiseStockAlerts(before)
     
     return nil
 }
 
-// GetProduct returns a product by ID
-func (s *Store) GetProduct(productID ProductID) (*Product, error) {
-    product, exists := s.products[productID]
+// AdjustInventory changes stock on hand for spoilage, breakage, theft or corrections
+// WHY: Deliveries and sales aren't the only way stock changes, losses need a record
+// WHAT: Losses must take stock away, and stock on hand can't go below zero
+func (s *Store) AdjustInventory(item StockItem, delta int, reason AdjustmentReason, note string) (Adjustment, error) {
+    if delta == 0 {
+        return Adjustment{}, fmt.Errorf("%w: quantity cannot be zero", ErrInvalidAdjustment)
+    }
+    
+    if reason.IsLoss() && delta > 0 {
+        return Adjustment{}, fmt.Errorf("%w: %s can only reduce stock", ErrInvalidAdjustment, reason)
+    }
+    
+    if reason == AdjustmentOther && strings.TrimSpace(note) == "" {
+        return Adjustment{}, fmt.Errorf("%w: a note is required for OTHER", ErrInvalidAdjustment)
+    }
+    
+    return s.applyAdjustment(item, delta, reason, note)
+}
+
+// CountInventory sets stock on hand to a physical count and records the variance
+// WHERE: Called during stocktakes, a matching count is still recorded with zero variance
+func (s *Store) CountInventory(item StockItem, counted int, note string) (Adjustment, error) {
+    if counted < 0 {
+        return Adjustment{}, fmt.Errorf("%w: counted quantity cannot be negative", ErrInvalidAdjustment)
+    }
+    
+    onHand, _, err := s.stockItem(item)
+    if err != nil {
+        return Adjustment{}, err
+    }
+    
+    return s.applyAdjustment(item, counted-onHand, AdjustmentCountCorrection, note)
+}
+
+// applyAdjustment updates stock on hand, records the adjustment and raises events
+func (s *Store) applyAdjustment(item StockItem, delta int, reason AdjustmentReason, note string) (Adjustment, error) {
+    onHand, name, err := s.stockItem(item)
+    if err != nil {
+        return Adjustment{}, err
+    }
+    
+    if onHand+delta < 0 {
+        return Adjustment{}, fmt.Errorf("%w: only %d of %s on hand", ErrInvalidAdjustment, onHand, name)
+    }
+    
+    before := s.stockLevels()
+    switch item.Type() {
+    case StockItemProduct:
+        s.inventory[ProductID(item.ID())] = Quantity(onHand + delta)
+    case StockItemIngredient:
+        s.stock[IngredientID(item.ID())] = Quantity(onHand + delta)
+    }
+    
+    adjustment := newAdjustment(item, name, delta, onHand, reason, note)
+    s.adjustments = append(s.adjustments, adjustment)
+    
+    // Raise domain event
+    s.Raise(InventoryAdjustedEvent{
+        BaseEvent:      shared.NewBaseEvent(),
+        StoreID:        string(s.id),
+        AdjustmentID:   adjustment.ID(),
+        ItemType:       string(item.Type()),
+        ItemID:         item.ID(),
+        ItemName:       name,
+        Delta:          delta,
+        QuantityBefore: onHand,
+        QuantityAfter:  adjustment.QuantityAfter(),
+        Reason:         string(reason),
+        Note:           note,
+    })
+    s.raiseStockAlerts(before)
+    
+    return adjustment, nil
+}
+
+// stockItem returns the quantity on hand and name of a stock item
+func (s *Store) stockItem(item StockItem) (int, string, error) {
+    switch item.Type() {
+    case StockItemProduct:
+        product, err := s.GetProduct(ProductID(item.ID()))
+        if err != nil {
+            return 0, "", err
+        }
+        if product.IsMadeToOrder() {
+            return 0, "", fmt.Errorf("%w: %s is made from a recipe, adjust its ingredients instead",
+                ErrInvalidAdjustment, product.Name())
+        }
+        return int(s.inventory[product.ID()]), string(product.Name()), nil
+    case StockItemIngredient:
+        ingredient, err := s.GetIngredient(IngredientID(item.ID()))
+        if err != nil {
+            return 0, "", err
+        }
+        return int(s.stock[ingredient.ID()]), ingredient.Name(), nil
+    default:
+        return 0, "", fmt.Errorf("%w: unknown item type", ErrInvalidAdjustment)
+    }
+}
+
+// CanDispatchTransfer checks that this store could send the transfer
+// WHY: Stock held for open orders stays behind, only what's available can be sent
+func (s *Store) CanDispatchTransfer(transfer *Transfer) error {
+    if transfer.SourceStoreID() != s.id {
+        return fmt.Errorf("%w: transfer is not from %s", ErrInvalidTransfer, s.name)
+    }
+    
+    for _, line := range transfer.Lines() {
+        available, err := s.transferableStock(line.SourceItem())
+        if err != nil {
+            return err
+        }
+        if line.Quantity() > available {
+            return fmt.Errorf("%w: only %d of %s can be transferred", ErrInsufficientStock, available, line.ItemName())
+        }
+    }
+    
+    return nil
+}
+
+// DispatchTransfer takes a transfer's stock off this store's shelf
+func (s *Store) DispatchTransfer(transfer *Transfer) error {
+    // Check every line before taking anything
+    err := s.CanDispatchTransfer(transfer)
+    if err != nil {
+        return err
+    }
+    
+    before := s.stockLevels()
+    for _, line := range transfer.Lines() {
+        newTotal := s.addStock(line.SourceItem(), -line.Quantity())
+        
+        // Raise domain event
+        s.Raise(InventoryTransferredOutEvent{
+            BaseEvent:  shared.NewBaseEvent(),
+            StoreID:    string(s.id),
+            TransferID: string(transfer.ID()),
+            ItemType:   string(line.SourceItem().Type()),
+            ItemID:     line.SourceItem().ID(),
+            ItemName:   line.ItemName(),
+            Quantity:   line.Quantity(),
+            NewTotal:   newTotal,
+        })
+    }
+    s.raiseStockAlerts(before)
+    
+    return nil
+}
+
+// CanExpectTransfer checks that the transfer is headed for this store
+func (s *Store) CanExpectTransfer(transfer *Transfer) error {
+    if transfer.DestinationStoreID() != s.id {
+        return fmt.Errorf("%w: transfer is not to %s", ErrInvalidTransfer, s.name)
+    }
+    return nil
+}
+
+// ExpectTransfer records stock on its way to this store
+// WHERE: Called alongside DispatchTransfer so both stores change in one unit of work
+func (s *Store) ExpectTransfer(transfer *Transfer) error {
+    err := s.CanExpectTransfer(transfer)
+    if err != nil {
+        return err
+    }
+    
+    for _, line := range transfer.Lines() {
+        s.incoming[line.DestinationItem()] += Quantity(line.Quantity())
+    }
+    
+    return nil
+}
+
+// CanReceiveTransfer checks that every item on the transfer can still be stocked here
+// WHY: An item may have been removed since the transfer was dispatched
+func (s *Store) CanReceiveTransfer(transfer *Transfer) error {
+    if transfer.DestinationStoreID() != s.id {
+        return fmt.Errorf("%w: transfer is not to %s", ErrInvalidTransfer, s.name)
+    }
+    
+    for _, line := range transfer.Lines() {
+        if _, _, err := s.stockItem(line.DestinationItem()); err != nil {
+            return err
+        }
+    }
+    
+    return nil
+}
+
+// ReceiveTransfer puts a transfer's stock on this store's shelf
+func (s *Store) ReceiveTransfer(transfer *Transfer) error {
+    // Check every item before changing anything
+    err := s.CanReceiveTransfer(transfer)
+    if err != nil {
+        return err
+    }
+    
+    before := s.stockLevels()
+    for _, line := range transfer.Lines() {
+        s.incoming[line.DestinationItem()] -= Quantity(line.Quantity())
+        s.putAwayTransfer(transfer.ID(), line.DestinationItem(), line)
+    }
+    s.raiseStockAlerts(before)
+    
+    return nil
+}
+
+// ReturnTransfer puts a cancelled transfer's stock back on this store's shelf
+func (s *Store) ReturnTransfer(transfer *Transfer) error {
+    if transfer.SourceStoreID() != s.id {
+        return fmt.Errorf("%w: transfer is not from %s", ErrInvalidTransfer, s.name)
+    }
+    
+    before := s.stockLevels()
+    for _, line := range transfer.Lines() {
+        s.putAwayTransfer(transfer.ID(), line.SourceItem(), line)
+    }
+    s.raiseStockAlerts(before)
+    
+    return nil
+}
+
+// CancelIncomingTransfer stops expecting a cancelled transfer's stock
+func (s *Store) CancelIncomingTransfer(transfer *Transfer) error {
+    if transfer.DestinationStoreID() != s.id {
+        return fmt.Errorf("%w: transfer is not to %s", ErrInvalidTransfer, s.name)
+    }
+    
+    for _, line := range transfer.Lines() {
+        s.incoming[line.DestinationItem()] -= Quantity(line.Quantity())
+    }
+    
+    return nil
+}
+
+// putAwayTransfer adds one transfer line to stock on hand
+func (s *Store) putAwayTransfer(transferID TransferID, item StockItem, line TransferLine) {
+    newTotal := s.addStock(item, line.Quantity())
+    
+    // Raise domain event
+    s.Raise(InventoryTransferredInEvent{
+        BaseEvent:  shared.NewBaseEvent(),
+        StoreID:    string(s.id),
+        TransferID: string(transferID),
+        ItemType:   string(item.Type()),
+        ItemID:     item.ID(),
+        ItemName:   line.ItemName(),
+        Quantity:   line.Quantity(),
+        NewTotal:   newTotal,
+    })
+}
+
+// transferableStock returns how much of a stock item isn't held for an order
+func (s *Store) transferableStock(item StockItem) (int, error) {
+    if _, _, err := s.stockItem(item); err != nil {
+        return 0, err
+    }
+    
+    if item.Type() == StockItemProduct {
+        productID := ProductID(item.ID())
+        return max(int(s.inventory[productID])-int(s.reserved[productID]), 0), nil
+    }
+    return max(s.availableStock(IngredientID(item.ID())), 0), nil
+}
+
+// addStock changes stock on hand by delta and returns the new level
+func (s *Store) addStock(item StockItem, delta int) int {
+    if item.Type() == StockItemProduct {
+        productID := ProductID(item.ID())
+        s.inventory[productID] += Quantity(delta)
+        return int(s.inventory[productID])
+    }
+    
+    ingredientID := IngredientID(item.ID())
+    s.stock[ingredientID] += Quantity(delta)
+    return int(s.stock[ingredientID])
+}
+
+// SetReorderPoint sets the available level at which a product counts as low on stock
+// WHAT: Zero turns off low-stock alerts, running out is still reported
+func (s *Store) SetReorderPoint(productID ProductID, reorderPoint int) error {
+    if _, err := s.GetProduct(productID); err != nil {
+        return err
+    }
+    
+    qty, err := NewQuantity(reorderPoint)
+    if err != nil {
+        return err
+    }
+    
+    before := s.stockLevels()
+    s.reorderPoints[productID] = qty
+    
+    // Raise domain event
+    s.Raise(ReorderPointSetEvent{
+        BaseEvent:    shared.NewBaseEvent(),
+        StoreID:      string(s.id),
+        ProductID:    string(productID),
+        ReorderPoint: int(qty),
+    })
+    s.raiseStockAlerts(before)
+    
+    return nil
+}
+
+// HoldReservation stops an order's reservation from expiring
+// WHERE: Called when preparation starts, the drink is being made so the stock is spoken for
+func (s *Store) HoldReservation(orderID string) error {
+    reservation, exists := s.reservations[orderID]
     if !exists {
-        return nil, errors.New("product not found")
+        return ErrReservationNotFound
     }
-    return product, nil
+    
+    reservation.expiresAt = time.Time{}
+    return nil
 }
 
-// GetAvailableQuantity returns current inventory level
-func (s *Store) GetAvailableQuantity(productID ProductID) (int, error) {
-    qty, exists := s.inventory[productID]
+// CanCommitReservation checks that the store is holding stock for the order
+// WHERE: Checked before the order is completed or dispatched
+func (s *Store) CanCommitReservation(orderID string) error {
+    if _, exists := s.reservations[orderID]; !exists {
+        return ErrReservationNotFound
+    }
+    return nil
+}
+
+// CommitReservation takes an order's held stock off hand
+// WHERE: Called when the order is completed
+func (s *Store) CommitReservation(orderID string) error {
+    err := s.CanCommitReservation(orderID)
+    if err != nil {
+        return err
+    }
+    reservation := s.reservations[orderID]
+    
+    // Floor at zero, an adjustment may have taken stock the order was holding
+    for _, line := range reservation.lines {
+        s.unreserve(line)
+        if len(line.ingredients) > 0 {
+            for ingredientID, amount := range line.ingredients {
+                s.stock[ingredientID] = max(s.stock[ingredientID]-Quantity(amount), 0)
+            }
+        } else {
+            s.inventory[line.productID] = max(s.inventory[line.productID]-Quantity(line.quantity), 0)
+        }
+    }
+    delete(s.reservations, orderID)
+    
+    // Raise domain event
+    s.Raise(InventoryConsumedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        OrderID:   orderID,
+        Lines:     reservation.snapshots(),
+    })
+    
+    return nil
+}
+
+// ReleaseReservation makes an order's held stock available again
+// WHERE: Called when an order is cancelled or its reservation expires
+func (s *Store) ReleaseReservation(orderID string, reason ReleaseReason) error {
+    reservation, exists := s.reservations[orderID]
     if !exists {
-        return 0, errors.New("product not found")
+        return ErrReservationNotFound
     }
-    return int(qty), nil
+    
+    before := s.stockLevels()
+    for _, line := range reservation.lines {
+        s.unreserve(line)
+    }
+    delete(s.reservations, orderID)
+    
+    // Raise domain event
+    s.Raise(InventoryReservationReleasedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        OrderID:   orderID,
+        Reason:    string(reason),
+        Lines:     reservation.snapshots(),
+    })
+    s.raiseStockAlerts(before)
+    
+    return nil
 }
 
-// Getters
-func (s *Store) ID() StoreID              { return s.id }
-func (s *Store) Name() string             { return s.name }
+// ExpiredReservations returns the order IDs whose reservations lapsed by now
+// WHERE: Used by the reservation sweeper
+func (s *Store) ExpiredReservations(now time.Time) []string {
+    var orderIDs []string
+    for orderID, reservation := range s.reservations {
+        if reservation.IsExpired(now) {
+            orderIDs = append(orderIDs, orderID)
+        }
+    }
+    return orderIDs
+}
+
+// unreserve removes a line's hold from the reserved totals
+func (s *Store) unreserve(line ReservationLine) {
+    s.reserved[line.productID] -= Quantity(line.quantity)
+    for ingredientID, amount := range line.ingredients {
+        s.reservedStock[ingredientID] -= Quantity(amount)
+    }
+}
+
+// availableStock returns ingredient stock on hand that isn't held for an order
+func (s *Store) availableStock(ingredientID IngredientID) int {
+    return int(s.stock[ingredientID]) - int(s.reservedStock[ingredientID])
+}
+
+// SetOpeningHours replaces the store's weekly schedule and holiday closures
+func (s *Store) SetOpeningHours(hours OpeningHours) {
+    s.openingHours = hours
+    
+    // Raise domain event
+    s.Raise(OpeningHoursSetEvent{
+        BaseEvent:    shared.NewBaseEvent(),
+        StoreID:      string(s.id),
+        Timezone:     hours.Timezone(),
+        PeriodCount:  len(hours.Periods()),
+        HolidayCount: len(hours.Holidays()),
+    })
+}
+
+// PauseOrdering stops the store taking orders during opening hours
+// WHY: Staff need to stop new orders when the stand is swamped or runs out of cups
+// WHAT: A zero until pauses until ResumeOrdering is called
+func (s *Store) PauseOrdering(reason string, until time.Time) error {
+    if strings.TrimSpace(reason) == "" {
+        return errors.New("a reason is required to pause ordering")
+    }
+    
+    s.paused = true
+    s.pauseReason = reason
+    s.pausedUntil = until
+    
+    // Raise domain event
+    s.Raise(OrderingPausedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        Reason:    reason,
+        Until:     until,
+    })
+    
+    return nil
+}
+
+// ResumeOrdering lifts a pause, the store takes orders again during opening hours
+func (s *Store) ResumeOrdering() {
+    if !s.paused {
+        return
+    }
+    
+    s.paused = false
+    s.pauseReason = ""
+    s.pausedUntil = time.Time{}
+    
+    // Raise domain event
+    s.Raise(OrderingResumedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+    })
+}
+
+// IsPausedAt reports whether ordering is paused at a point in time
+// WHAT: A pause with an end time lifts itself once that time has passed
+func (s *Store) IsPausedAt(at time.Time) bool {
+    return s.paused && (s.pausedUntil.IsZero() || at.Before(s.pausedUntil))
+}
+
+// OpenStatusAt works out whether the store takes orders at a point in time
+func (s *Store) OpenStatusAt(at time.Time) OpenStatus {
+    if s.IsPausedAt(at) {
+        status := OpenStatus{reason: "ordering is paused: " + s.pauseReason}
+        if !s.pausedUntil.IsZero() {
+            status.nextOpening = s.pausedUntil
+            if s.openingHours.IsSet() {
+                status.nextOpening = s.openingHours.nextOpening(s.pausedUntil)
+            }
+        }
+        return status
+    }
+    
+    if !s.openingHours.IsSet() {
+        return OpenStatus{open: true}
+    }
+    
+    if holiday, closed := s.openingHours.holidayOn(at); closed {
+        return OpenStatus{
+            reason:      fmt.Sprintf("closed for %s", holiday.Name()),
+            nextOpening: s.openingHours.nextOpening(at),
+        }
+    }
+    
+    if !s.openingHours.isOpenAt(at) {
+        return OpenStatus{
+            reason:      "outside opening hours",
+            nextOpening: s.openingHours.nextOpening(at),
+        }
+    }
+    
+    return OpenStatus{open: true}
+}
+
+// GetProduct returns a product by ID
+func (s *Store) GetProduct(productID ProductID) (*Product, error) {
+    product, exists := s.products[productID]
+    if !exists {
+        return nil, ErrProductNotFound
+    }
+    return product, nil
+}
+
+// GetAvailableQuantity returns how many units can still be sold
+// WHAT: Stock on hand less what open orders hold. For made-to-order products,
+// how many can be made from unheld ingredient stock without modifiers
+func (s *Store) GetAvailableQuantity(productID ProductID) (int, error) {
+    product, exists := s.products[productID]
+    if !exists {
+        return 0, errors.New("product not found")
+    }
+    
+    if !product.IsMadeToOrder() {
+        // Never below zero, even when an adjustment took stock that orders were holding
+        return max(int(s.inventory[productID])-int(s.reserved[productID]), 0), nil
+    }
+    
+    makeable := -1
+    for _, component := range product.Recipe().Components() {
+        count := s.availableStock(component.IngredientID()) / component.Quantity()
+        if makeable < 0 || count < makeable {
+            makeable = count
+        }
+    }
+    return max(makeable, 0), nil
+}
+
+// GetReservedQuantity returns how many units of a product open orders hold
+func (s *Store) GetReservedQuantity(productID ProductID) int {
+    return int(s.reserved[productID])
+}
+
+// GetReorderPoint returns a product's low-stock threshold
+func (s *Store) GetReorderPoint(productID ProductID) int {
+    return int(s.reorderPoints[productID])
+}
+
+// GetStockLevel returns whether a product is OK, low or out of stock
+func (s *Store) GetStockLevel(productID ProductID) (StockLevel, error) {
+    available, err := s.GetAvailableQuantity(productID)
+    if err != nil {
+        return "", err
+    }
+    return stockLevelFor(available, s.reorderPoints[productID]), nil
+}
+
+// GetIncomingQuantity returns how much of a stock item is on its way from other stores
+func (s *Store) GetIncomingQuantity(item StockItem) int {
+    return int(s.incoming[item])
+}
+
+// GetIngredient returns an ingredient by ID
+func (s *Store) GetIngredient(ingredientID IngredientID) (*Ingredient, error) {
+    ingredient, exists := s.ingredients[ingredientID]
+    if !exists {
+        return nil, ErrIngredientNotFound
+    }
+    return ingredient, nil
+}
+
+// GetIngredientQuantity returns an ingredient's stock level on hand
+func (s *Store) GetIngredientQuantity(ingredientID IngredientID) (int, error) {
+    qty, exists := s.stock[ingredientID]
+    if !exists {
+        return 0, ErrIngredientNotFound
+    }
+    return int(qty), nil
+}
+
+// GetReservedIngredientQuantity returns how much of an ingredient open orders hold
+func (s *Store) GetReservedIngredientQuantity(ingredientID IngredientID) int {
+    return int(s.reservedStock[ingredientID])
+}
+
+// ingredientName returns a readable name for error messages
+func (s *Store) ingredientName(ingredientID IngredientID) string {
+    if ingredient, exists := s.ingredients[ingredientID]; exists {
+        return ingredient.Name()
+    }
+    return string(ingredientID)
+}
+
+// Getters
+func (s *Store) ID() StoreID              { return s.id }
+func (s *Store) Name() string             { return s.name }
 func (s *Store) Location() shared.Address { return s.location }
 func (s *Store) Products() map[ProductID]*Product {
     return s.products
 }
+func (s *Store) Categories() map[CategoryID]*Category {
+    return s.categories
+}
+func (s *Store) Ingredients() map[IngredientID]*Ingredient {
+    return s.ingredients
+}
+func (s *Store) Adjustments() []Adjustment {
+    return s.adjustments
+}
+func (s *Store) OpeningHours() OpeningHours { return s.openingHours }
+func (s *Store) PauseReason() string        { return s.pauseReason }
+func (s *Store) PausedUntil() time.Time     { return s.pausedUntil }
+
+// UpdateProductPrice changes a product's base price straight away
+// WHY: Price changes go through the Store aggregate so they are recorded and raise events
+func (s *Store) UpdateProductPrice(productID ProductID, newPrice shared.Money) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    oldPrice := product.Price()
+    effectiveAt := time.Now()
+    err = product.updatePrice(newPrice, effectiveAt, PriceChangeManual)
+    if err != nil {
+        return err
+    }
+    
+    // Raise domain event
+    s.Raise(ProductPriceChangedEvent{
+        BaseEvent:   shared.NewBaseEvent(),
+        StoreID:     string(s.id),
+        ProductID:   string(productID),
+        OldPrice:    oldPrice,
+        NewPrice:    newPrice,
+        Reason:      string(PriceChangeManual),
+        EffectiveAt: effectiveAt,
+    })
+    
+    return nil
+}
+
+// SchedulePriceChange queues a base price change for a future time
+// WHY: Menu price rises are announced ahead and should land on the hour, not when someone remembers
+func (s *Store) SchedulePriceChange(productID ProductID, newPrice shared.Money, effectiveAt time.Time, now time.Time) (ScheduledPrice, error) {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return ScheduledPrice{}, err
+    }
+    
+    if !effectiveAt.After(now) {
+        return ScheduledPrice{}, fmt.Errorf("%w: scheduled changes must take effect in the future", ErrInvalidPrice)
+    }
+    
+    change, err := product.schedulePrice(newPrice, effectiveAt)
+    if err != nil {
+        return ScheduledPrice{}, err
+    }
+    
+    // Raise domain event
+    s.Raise(PriceChangeScheduledEvent{
+        BaseEvent:   shared.NewBaseEvent(),
+        StoreID:     string(s.id),
+        ProductID:   string(productID),
+        ChangeID:    change.ID(),
+        NewPrice:    newPrice,
+        EffectiveAt: effectiveAt,
+    })
+    
+    return change, nil
+}
+
+// CancelScheduledPrice drops a queued price change before it takes effect
+func (s *Store) CancelScheduledPrice(productID ProductID, changeID string) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    if _, found := product.cancelScheduledPrice(changeID); !found {
+        return ErrPriceChangeNotFound
+    }
+    
+    // Raise domain event
+    s.Raise(PriceChangeCancelledEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        ProductID: string(productID),
+        ChangeID:  changeID,
+    })
+    
+    return nil
+}
+
+// ApplyScheduledPrices makes every queued change due by now the product's base price
+// WHAT: History records the scheduled time, not when the scheduler got round to it
+// WHERE: Called periodically by the price scheduler
+func (s *Store) ApplyScheduledPrices(now time.Time) int {
+    applied := 0
+    for _, product := range s.products {
+        for _, change := range product.popDuePrices(now) {
+            oldPrice := product.Price()
+            if err := product.updatePrice(change.price, change.effectiveAt, PriceChangeScheduled); err != nil {
+                continue // Validated when scheduled, so only a corrupt entry ends up here
+            }
+            applied++
+            
+            // Raise domain event
+            s.Raise(ProductPriceChangedEvent{
+                BaseEvent:   shared.NewBaseEvent(),
+                StoreID:     string(s.id),
+                ProductID:   string(product.ID()),
+                OldPrice:    oldPrice,
+                NewPrice:    change.price,
+                Reason:      string(PriceChangeScheduled),
+                EffectiveAt: change.effectiveAt,
+            })
+        }
+    }
+    return applied
+}
+
+// AddPriceRule sets up a recurring discount window
+func (s *Store) AddPriceRule(rule PriceRule) error {
+    for _, productID := range rule.Products() {
+        if _, err := s.GetProduct(productID); err != nil {
+            return err
+        }
+    }
+    
+    for _, existing := range s.priceRules {
+        if strings.EqualFold(existing.Name(), rule.Name()) {
+            return fmt.Errorf("%w: %q already exists", ErrInvalidPriceRule, rule.Name())
+        }
+    }
+    
+    s.priceRules = append(s.priceRules, rule)
+    
+    // Raise domain event
+    s.Raise(PriceRuleAddedEvent{
+        BaseEvent:  shared.NewBaseEvent(),
+        StoreID:    string(s.id),
+        RuleID:     rule.ID(),
+        Name:       rule.Name(),
+        PercentOff: rule.PercentOff(),
+    })
+    
+    return nil
+}
+
+// RemovePriceRule takes down a recurring discount window
+func (s *Store) RemovePriceRule(ruleID string) error {
+    for i, rule := range s.priceRules {
+        if rule.ID() != ruleID {
+            continue
+        }
+        s.priceRules = append(s.priceRules[:i], s.priceRules[i+1:]...)
+        
+        // Raise domain event
+        s.Raise(PriceRuleRemovedEvent{
+            BaseEvent: shared.NewBaseEvent(),
+            StoreID:   string(s.id),
+            RuleID:    ruleID,
+        })
+        return nil
+    }
+    return ErrPriceRuleNotFound
+}
+
+// PriceRules returns the store's recurring discounts in the order they were added
+func (s *Store) PriceRules() []PriceRule {
+    return s.priceRules
+}
+
+// UnitPriceAt works out what one unit costs at a point in time
+// WHY: Orders are priced when placed, so scheduled changes and happy hour are both honoured
+// WHAT: Rules don't stack, the biggest discount covering the product wins
+func (s *Store) UnitPriceAt(productID ProductID, modifiers []SelectedModifier, at time.Time) (shared.Money, error) {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return shared.Money{}, err
+    }
+    
+    price, err := product.PriceWith(modifiers, at)
+    if err != nil {
+        return shared.Money{}, err
+    }
+    
+    percentOff := 0
+    local := s.openingHours.localTime(at)
+    for _, rule := range s.priceRules {
+        if rule.appliesTo(productID, local) {
+            percentOff = max(percentOff, rule.PercentOff())
+        }
+    }
+    if percentOff == 0 {
+        return price, nil
+    }
+    
+    return price.Subtract(price.Percentage(shared.PartsPerMillion(percentOff * 10000)))
+}
+
+// hasActiveProductNamed reports whether another active product already uses the name
+func (s *Store) hasActiveProductNamed(name ProductName, except ProductID) bool {
+    for _, p := range s.products {
+        if p.ID() != except && p.Name() == name && p.IsActive() {
+            return true
+        }
+    }
+    return false
+}
+
+// AddCategory adds a section to the store's menu
+func (s *Store) AddCategory(name string, displayOrder int) (*Category, error) {
+    category, err := newCategory(name, displayOrder)
+    if err != nil {
+        return nil, err
+    }
+    
+    for _, existing := range s.categories {
+        if strings.EqualFold(existing.Name(), category.Name()) {
+            return nil, fmt.Errorf("%w: %q already exists", ErrInvalidCategory, category.Name())
+        }
+    }
+    
+    s.categories[category.ID()] = category
+    
+    // Raise domain event
+    s.Raise(CategoryAddedEvent{
+        BaseEvent:    shared.NewBaseEvent(),
+        StoreID:      string(s.id),
+        CategoryID:   string(category.ID()),
+        Name:         category.Name(),
+        DisplayOrder: displayOrder,
+    })
+    
+    return category, nil
+}
+
+// UpdateCategory renames or moves a menu section
+func (s *Store) UpdateCategory(categoryID CategoryID, name string, displayOrder int) (*Category, error) {
+    category, err := s.GetCategory(categoryID)
+    if err != nil {
+        return nil, err
+    }
+    
+    updated, err := newCategory(name, displayOrder)
+    if err != nil {
+        return nil, err
+    }
+    for _, existing := range s.categories {
+        if existing.ID() != categoryID && strings.EqualFold(existing.Name(), updated.Name()) {
+            return nil, fmt.Errorf("%w: %q already exists", ErrInvalidCategory, updated.Name())
+        }
+    }
+    
+    category.name = updated.name
+    category.displayOrder = displayOrder
+    
+    // Raise domain event
+    s.Raise(CategoryUpdatedEvent{
+        BaseEvent:    shared.NewBaseEvent(),
+        StoreID:      string(s.id),
+        CategoryID:   string(categoryID),
+        Name:         category.Name(),
+        DisplayOrder: displayOrder,
+    })
+    
+    return category, nil
+}
+
+// GetCategory returns a menu section by ID
+func (s *Store) GetCategory(categoryID CategoryID) (*Category, error) {
+    category, exists := s.categories[categoryID]
+    if !exists {
+        return nil, ErrCategoryNotFound
+    }
+    return category, nil
+}
+
+// EditProduct replaces a product's name, description and place on the menu
+// WHY: Names stay unique among active products, and a product can only join a category that exists
+// WHAT: An empty category ID takes the product out of its category
+func (s *Store) EditProduct(productID ProductID, name string, description string, categoryID CategoryID, displayOrder int) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    productName, err := NewProductName(name)
+    if err != nil {
+        return err
+    }
+    if product.IsActive() && s.hasActiveProductNamed(productName, productID) {
+        return ErrDuplicateProduct
+    }
+    
+    if categoryID != "" {
+        if _, err := s.GetCategory(categoryID); err != nil {
+            return err
+        }
+    }
+    
+    product.edit(productName, description, categoryID, displayOrder)
+    
+    // Raise domain event
+    s.Raise(ProductEditedEvent{
+        BaseEvent:    shared.NewBaseEvent(),
+        StoreID:      string(s.id),
+        ProductID:    string(productID),
+        ProductName:  name,
+        Description:  description,
+        CategoryID:   string(categoryID),
+        DisplayOrder: displayOrder,
+    })
+    
+    return nil
+}
+
+// SetProductTaxExempt marks whether sales tax is charged on one of the store's products
+// WHAT: Setting the flag it already has changes nothing and raises no event
+func (s *Store) SetProductTaxExempt(productID ProductID, exempt bool) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    if product.IsTaxExempt() == exempt {
+        return nil
+    }
+    product.setTaxExempt(exempt)
+    
+    // Raise domain event
+    s.Raise(ProductTaxExemptionSetEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        ProductID: string(productID),
+        TaxExempt: exempt,
+    })
+    
+    return nil
+}
+
+// DeactivateProduct takes a product off the menu
+// WHAT: Open orders keep their items, the product just can't be ordered again
+func (s *Store) DeactivateProduct(productID ProductID) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    if !product.IsActive() {
+        return nil
+    }
+    before := s.stockLevels()
+    product.Deactivate()
+    
+    // Raise domain event
+    s.Raise(ProductDeactivatedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        ProductID: string(productID),
+    })
+    s.raiseStockAlerts(before)
+    
+    return nil
+}
+
+// ReactivateProduct puts a deactivated product back on the menu
+// WHY: A replacement may have taken the name while it was off, active names must stay unique
+func (s *Store) ReactivateProduct(productID ProductID) error {
+    product, err := s.GetProduct(productID)
+    if err != nil {
+        return err
+    }
+    
+    if product.IsActive() {
+        return nil
+    }
+    if s.hasActiveProductNamed(product.Name(), productID) {
+        return ErrDuplicateProduct
+    }
+    before := s.stockLevels()
+    product.Reactivate()
+    
+    // Raise domain event
+    s.Raise(ProductReactivatedEvent{
+        BaseEvent: shared.NewBaseEvent(),
+        StoreID:   string(s.id),
+        ProductID: string(productID),
+    })
+    s.raiseStockAlerts(before)
+    
+    return nil
+}
+
+// Menu returns products in display order
+// WHAT: By category order, then product order within the category, then name.
+// Uncategorized products come last
+func (s *Store) Menu(includeInactive bool) []*Product {
+    products := make([]*Product, 0, len(s.products))
+    for _, product := range s.products {
+        if product.IsActive() || includeInactive {
+            products = append(products, product)
+        }
+    }
+    
+    sort.Slice(products, func(i, j int) bool {
+        a, b := products[i], products[j]
+        if a.categoryID != b.categoryID {
+            categoryA, inA := s.categories[a.categoryID]
+            categoryB, inB := s.categories[b.categoryID]
+            if inA != inB {
+                return inA
+            }
+            if categoryA.displayOrder != categoryB.displayOrder {
+                return categoryA.displayOrder < categoryB.displayOrder
+            }
+            if categoryA.name != categoryB.name {
+                return categoryA.name < categoryB.name
+            }
+        }
+        if a.displayOrder != b.displayOrder {
+            return a.displayOrder < b.displayOrder
+        }
+        return a.name < b.name
+    })
+    
+    return products
+}
@@ -0,0 +1,238 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// TransferID uniquely identifies a stock transfer between stores
+type TransferID string
+
+func NewTransferID() TransferID {
+    return TransferID(uuid.New().String())
+}
+
+// TransferStatus represents where a transfer is between the two stores
+type TransferStatus string
+
+const (
+    TransferStatusInTransit TransferStatus = "IN_TRANSIT"
+    TransferStatusReceived  TransferStatus = "RECEIVED"
+    TransferStatusCancelled TransferStatus = "CANCELLED"
+)
+
+// TransferItem is a quantity of one of the source store's stock items to send
+type TransferItem struct {
+    Item     StockItem
+    Quantity int
+}
+
+// TransferLine is one item moving between stores
+// WHY: Product and ingredient IDs are per store, so each line knows the item on both sides
+type TransferLine struct {
+    sourceItem      StockItem
+    destinationItem StockItem
+    itemName        string
+    quantity        int
+}
+
+func (l TransferLine) SourceItem() StockItem      { return l.sourceItem }
+func (l TransferLine) DestinationItem() StockItem { return l.destinationItem }
+func (l TransferLine) ItemName() string           { return l.itemName }
+func (l TransferLine) Quantity() int              { return l.quantity }
+
+// Transfer is the aggregate root for stock moving from one store to another
+// WHY: Stock on the road belongs to neither store's shelf, so it is tracked until it arrives
+// WHAT: Dispatched when created, then either received by the destination or cancelled back to the source
+type Transfer struct {
+    shared.AggregateRoot
+    id                 TransferID
+    sourceStoreID      StoreID
+    destinationStoreID StoreID
+    lines              []TransferLine
+    status             TransferStatus
+    note               string
+    dispatchedAt       time.Time
+    closedAt           time.Time
+}
+
+// NewTransfer dispatches stock from one store to another
+// WHAT: Matches each item to the destination store's item with the same name,
+// the caller then takes the stock off the source with DispatchTransfer
+func NewTransfer(source *Store, destination *Store, items []TransferItem, note string) (*Transfer, error) {
+    if source.id == destination.id {
+        return nil, fmt.Errorf("%w: source and destination must be different stores", ErrInvalidTransfer)
+    }
+    
+    if len(items) == 0 {
+        return nil, fmt.Errorf("%w: nothing to transfer", ErrInvalidTransfer)
+    }
+    
+    transfer := &Transfer{
+        id:                 NewTransferID(),
+        sourceStoreID:      source.id,
+        destinationStoreID: destination.id,
+        status:             TransferStatusInTransit,
+        note:               note,
+        dispatchedAt:       time.Now(),
+    }
+    
+    for _, item := range items {
+        line, err := newTransferLine(source, destination, item)
+        if err != nil {
+            return nil, err
+        }
+        for _, existing := range transfer.lines {
+            if existing.sourceItem == line.sourceItem {
+                return nil, fmt.Errorf("%w: %s is listed twice", ErrInvalidTransfer, line.itemName)
+            }
+        }
+        transfer.lines = append(transfer.lines, line)
+    }
+    
+    // Raise domain event
+    transfer.Raise(TransferDispatchedEvent{
+        BaseEvent:          shared.NewBaseEvent(),
+        TransferID:         string(transfer.id),
+        SourceStoreID:      string(source.id),
+        DestinationStoreID: string(destination.id),
+        Lines:              transfer.snapshots(),
+        Note:               note,
+    })
+    
+    return transfer, nil
+}
+
+// newTransferLine resolves a source item to the destination store's matching item
+func newTransferLine(source *Store, destination *Store, item TransferItem) (TransferLine, error) {
+    if item.Quantity <= 0 {
+        return TransferLine{}, fmt.Errorf("%w: quantity must be positive", ErrInvalidTransfer)
+    }
+    
+    switch item.Item.Type() {
+    case StockItemProduct:
+        product, err := source.GetProduct(ProductID(item.Item.ID()))
+        if err != nil {
+            return TransferLine{}, err
+        }
+        if product.IsMadeToOrder() {
+            return TransferLine{}, fmt.Errorf("%w: %s is made from a recipe, transfer its ingredients instead",
+                ErrInvalidTransfer, product.Name())
+        }
+        
+        for _, match := range destination.products {
+            if strings.EqualFold(string(match.Name()), string(product.Name())) && match.IsActive() {
+                if match.IsMadeToOrder() {
+                    return TransferLine{}, fmt.Errorf("%w: %s is made from a recipe at %s",
+                        ErrInvalidTransfer, product.Name(), destination.name)
+                }
+                return TransferLine{
+                    sourceItem:      item.Item,
+                    destinationItem: ProductStockItem(match.ID()),
+                    itemName:        string(product.Name()),
+                    quantity:        item.Quantity,
+                }, nil
+            }
+        }
+        return TransferLine{}, fmt.Errorf("%w: %s does not sell %s", ErrInvalidTransfer, destination.name, product.Name())
+    case StockItemIngredient:
+        ingredient, err := source.GetIngredient(IngredientID(item.Item.ID()))
+        if err != nil {
+            return TransferLine{}, err
+        }
+        
+        for _, match := range destination.ingredients {
+            if strings.EqualFold(match.Name(), ingredient.Name()) {
+                // WHY: 500 grams of sugar must not arrive as 500 units
+                if match.Unit() != ingredient.Unit() {
+                    return TransferLine{}, fmt.Errorf("%w: %s is measured in %s at %s",
+                        ErrInvalidTransfer, ingredient.Name(), match.Unit(), destination.name)
+                }
+                return TransferLine{
+                    sourceItem:      item.Item,
+                    destinationItem: IngredientStockItem(match.ID()),
+                    itemName:        ingredient.Name(),
+                    quantity:        item.Quantity,
+                }, nil
+            }
+        }
+        return TransferLine{}, fmt.Errorf("%w: %s does not stock %s", ErrInvalidTransfer, destination.name, ingredient.Name())
+    default:
+        return TransferLine{}, fmt.Errorf("%w: unknown item type", ErrInvalidTransfer)
+    }
+}
+
+// Receive records the stock arriving at the destination store
+func (t *Transfer) Receive() error {
+    if t.status != TransferStatusInTransit {
+        return fmt.Errorf("%w: transfer is %s", ErrTransferNotInTransit, t.status)
+    }
+    
+    t.status = TransferStatusReceived
+    t.closedAt = time.Now()
+    
+    // Raise domain event
+    t.Raise(TransferReceivedEvent{
+        BaseEvent:          shared.NewBaseEvent(),
+        TransferID:         string(t.id),
+        SourceStoreID:      string(t.sourceStoreID),
+        DestinationStoreID: string(t.destinationStoreID),
+        Lines:              t.snapshots(),
+    })
+    
+    return nil
+}
+
+// Cancel calls the transfer off, the stock goes back to the source store
+func (t *Transfer) Cancel(reason string) error {
+    if t.status != TransferStatusInTransit {
+        return fmt.Errorf("%w: transfer is %s", ErrTransferNotInTransit, t.status)
+    }
+    
+    t.status = TransferStatusCancelled
+    t.closedAt = time.Now()
+    
+    // Raise domain event
+    t.Raise(TransferCancelledEvent{
+        BaseEvent:          shared.NewBaseEvent(),
+        TransferID:         string(t.id),
+        SourceStoreID:      string(t.sourceStoreID),
+        DestinationStoreID: string(t.destinationStoreID),
+        Reason:             reason,
+    })
+    
+    return nil
+}
+
+// IsOpen reports whether the stock is still on its way
+func (t *Transfer) IsOpen() bool {
+    return t.status == TransferStatusInTransit
+}
+
+func (t *Transfer) snapshots() []TransferLineSnapshot {
+    snapshots := make([]TransferLineSnapshot, len(t.lines))
+    for i, line := range t.lines {
+        snapshots[i] = TransferLineSnapshot{
+            ItemType:          string(line.sourceItem.Type()),
+            SourceItemID:      line.sourceItem.ID(),
+            DestinationItemID: line.destinationItem.ID(),
+            ItemName:          line.itemName,
+            Quantity:          line.quantity,
+        }
+    }
+    return snapshots
+}
+
+// Getters
+func (t *Transfer) ID() TransferID              { return t.id }
+func (t *Transfer) SourceStoreID() StoreID      { return t.sourceStoreID }
+func (t *Transfer) DestinationStoreID() StoreID { return t.destinationStoreID }
+func (t *Transfer) Lines() []TransferLine       { return t.lines }
+func (t *Transfer) Status() TransferStatus      { return t.status }
+func (t *Transfer) Note() string                { return t.note }
+func (t *Transfer) DispatchedAt() time.Time     { return t.dispatchedAt }
+func (t *Transfer) ClosedAt() time.Time         { return t.closedAt }
@@ -0,0 +1,134 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestTransfer creates two stores selling the same product and a transfer of quantity between them
+func newTestTransfer(t *testing.T, quantity int) (*Store, *Store, *Transfer, *Product, *Product) {
+    t.Helper()
+    source, sent := newTestStore(t, "Main St", 10)
+    destination, expected := newTestStore(t, "Harbor", 0)
+    
+    transfer, err := NewTransfer(source, destination, []TransferItem{
+        {Item: ProductStockItem(sent.ID()), Quantity: quantity},
+    }, "")
+    if err != nil {
+        t.Fatalf("NewTransfer: %v", err)
+    }
+    
+    return source, destination, transfer, sent, expected
+}
+
+func dispatch(t *testing.T, source, destination *Store, transfer *Transfer) {
+    t.Helper()
+    if err := source.DispatchTransfer(transfer); err != nil {
+        t.Fatalf("DispatchTransfer: %v", err)
+    }
+    if err := destination.ExpectTransfer(transfer); err != nil {
+        t.Fatalf("ExpectTransfer: %v", err)
+    }
+}
+
+func TestTransferMovesStockOnReceipt(t *testing.T) {
+    source, destination, transfer, sent, expected := newTestTransfer(t, 4)
+    dispatch(t, source, destination, transfer)
+    
+    if got := int(source.inventory[sent.ID()]); got != 6 {
+        t.Errorf("source on hand = %d, want 6", got)
+    }
+    if got := destination.GetIncomingQuantity(ProductStockItem(expected.ID())); got != 4 {
+        t.Errorf("destination incoming = %d, want 4", got)
+    }
+    if transfer.Status() != TransferStatusInTransit {
+        t.Errorf("status = %s, want %s", transfer.Status(), TransferStatusInTransit)
+    }
+    
+    if err := destination.CanReceiveTransfer(transfer); err != nil {
+        t.Fatalf("CanReceiveTransfer: %v", err)
+    }
+    if err := transfer.Receive(); err != nil {
+        t.Fatalf("Receive: %v", err)
+    }
+    if err := destination.ReceiveTransfer(transfer); err != nil {
+        t.Fatalf("ReceiveTransfer: %v", err)
+    }
+    
+    if got := int(destination.inventory[expected.ID()]); got != 4 {
+        t.Errorf("destination on hand = %d, want 4", got)
+    }
+    if got := destination.GetIncomingQuantity(ProductStockItem(expected.ID())); got != 0 {
+        t.Errorf("destination incoming = %d, want 0", got)
+    }
+    if transfer.Status() != TransferStatusReceived {
+        t.Errorf("status = %s, want %s", transfer.Status(), TransferStatusReceived)
+    }
+    
+    if err := transfer.Receive(); !errors.Is(err, ErrTransferNotInTransit) {
+        t.Errorf("second receive err = %v, want ErrTransferNotInTransit", err)
+    }
+    if err := transfer.Cancel("too late"); !errors.Is(err, ErrTransferNotInTransit) {
+        t.Errorf("cancel after receipt err = %v, want ErrTransferNotInTransit", err)
+    }
+}
+
+func TestCancelledTransferReturnsStock(t *testing.T) {
+    source, destination, transfer, sent, expected := newTestTransfer(t, 4)
+    dispatch(t, source, destination, transfer)
+    
+    if err := transfer.Cancel("van broke down"); err != nil {
+        t.Fatalf("Cancel: %v", err)
+    }
+    if err := source.ReturnTransfer(transfer); err != nil {
+        t.Fatalf("ReturnTransfer: %v", err)
+    }
+    if err := destination.CancelIncomingTransfer(transfer); err != nil {
+        t.Fatalf("CancelIncomingTransfer: %v", err)
+    }
+    
+    if got := int(source.inventory[sent.ID()]); got != 10 {
+        t.Errorf("source on hand = %d, want 10", got)
+    }
+    if got := destination.GetIncomingQuantity(ProductStockItem(expected.ID())); got != 0 {
+        t.Errorf("destination incoming = %d, want 0", got)
+    }
+    if err := transfer.Receive(); !errors.Is(err, ErrTransferNotInTransit) {
+        t.Errorf("receive after cancel err = %v, want ErrTransferNotInTransit", err)
+    }
+}
+
+func TestDispatchLeavesReservedStockBehind(t *testing.T) {
+    source, _, transfer, sent, _ := newTestTransfer(t, 8)
+    
+    err := source.ReserveInventory("order-1", sent.ID(), 3, nil, time.Now().Add(time.Hour))
+    if err != nil {
+        t.Fatalf("ReserveInventory: %v", err)
+    }
+    
+    err = source.CanDispatchTransfer(transfer)
+    if !errors.Is(err, ErrInsufficientStock) {
+        t.Fatalf("CanDispatchTransfer err = %v, want ErrInsufficientStock", err)
+    }
+    if err := source.DispatchTransfer(transfer); !errors.Is(err, ErrInsufficientStock) {
+        t.Fatalf("DispatchTransfer err = %v, want ErrInsufficientStock", err)
+    }
+    if got := int(source.inventory[sent.ID()]); got != 10 {
+        t.Errorf("source on hand = %d, want 10", got)
+    }
+}
+
+func TestTransferChecksRejectTheWrongStore(t *testing.T) {
+    source, destination, transfer, _, _ := newTestTransfer(t, 2)
+    
+    if err := destination.CanDispatchTransfer(transfer); !errors.Is(err, ErrInvalidTransfer) {
+        t.Errorf("dispatch from destination err = %v, want ErrInvalidTransfer", err)
+    }
+    if err := source.CanExpectTransfer(transfer); !errors.Is(err, ErrInvalidTransfer) {
+        t.Errorf("expect at source err = %v, want ErrInvalidTransfer", err)
+    }
+    if err := source.CanReceiveTransfer(transfer); !errors.Is(err, ErrInvalidTransfer) {
+        t.Errorf("receive at source err = %v, want ErrInvalidTransfer", err)
+    }
+}
@@ -0,0 +1,75 @@
+package tax
+
+import (
+	"errors"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// Calculator is a domain service that works out sales tax
+// WHY: Tax depends on the store location and the product mix, which no single aggregate owns
+// WHERE: Used by the application layer while pricing an order
+type Calculator struct {
+    rates RateTable
+}
+
+func NewCalculator(rates RateTable) *Calculator {
+    return &Calculator{rates: rates}
+}
+
+// Calculate returns one tax line per rate that applies at location
+// WHAT: Order-level discounts are spread across items in proportion to their
+// price, so only the taxable share reduces the tax base. Each line is rounded
+// to the nearest cent on its own, the way receipts show it.
+func (c *Calculator) Calculate(
+    location shared.Address,
+    items []TaxableItem,
+    discount shared.Money,
+) ([]Line, error) {
+    if len(items) == 0 {
+        return []Line{}, nil
+    }
+    
+    currency := items[0].Amount.Currency()
+    var subtotal, taxable int64
+    for _, item := range items {
+        if item.Amount.Currency() != currency {
+            return nil, errors.New("cannot calculate tax across different currencies")
+        }
+        subtotal += item.Amount.Amount()
+        if !item.Exempt {
+            taxable += item.Amount.Amount()
+        }
+    }
+    
+    // Allocate the discount to the taxable share, rounding half up
+    if subtotal > 0 && discount.Amount() > 0 {
+        taxableDiscount := (discount.Amount()*taxable*2 + subtotal) / (subtotal * 2)
+        taxable -= taxableDiscount
+        if taxable < 0 {
+            taxable = 0
+        }
+    }
+    
+    base, err := shared.NewMoney(taxable, currency)
+    if err != nil {
+        return nil, err
+    }
+    
+    rates, err := c.rates.RatesFor(location)
+    if err != nil {
+        return nil, err
+    }
+    
+    lines := make([]Line, 0, len(rates))
+    for _, rate := range rates {
+        lines = append(lines, Line{
+            name:          rate.Name(),
+            rate:          rate.PartsPerMillion(),
+            taxableAmount: base,
+            amount:        base.Percentage(rate.PartsPerMillion()),
+        })
+    }
+    
+    return lines, nil
+}
@@ -0,0 +1,107 @@
+package tax
+
+import (
+	"testing"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// fixedRates returns the same rates for every location
+type fixedRates []Rate
+
+func (r fixedRates) RatesFor(location shared.Address) ([]Rate, error) { return r, nil }
+
+func newTestCalculator(t *testing.T, rates ...float64) *Calculator {
+    t.Helper()
+    jurisdiction, err := NewJurisdiction("US", "CA", "")
+    if err != nil {
+        t.Fatalf("NewJurisdiction: %v", err)
+    }
+    
+    table := make(fixedRates, len(rates))
+    for i, rate := range rates {
+        table[i], err = NewRate(jurisdiction, "tax", rate)
+        if err != nil {
+            t.Fatalf("NewRate(%v): %v", rate, err)
+        }
+    }
+    return NewCalculator(table)
+}
+
+func usd(t *testing.T, cents int64) shared.Money {
+    t.Helper()
+    money, err := shared.NewMoney(cents, "USD")
+    if err != nil {
+        t.Fatalf("NewMoney(%d): %v", cents, err)
+    }
+    return money
+}
+
+func TestCalculateRoundsEachLineHalfUp(t *testing.T) {
+    calculator := newTestCalculator(t, 0.0725, 0.01)
+    
+    lines, err := calculator.Calculate(shared.Address{}, []TaxableItem{{Amount: usd(t, 200)}}, shared.Money{})
+    if err != nil {
+        t.Fatalf("Calculate: %v", err)
+    }
+    
+    want := []int64{15, 2}
+    if len(lines) != len(want) {
+        t.Fatalf("got %d lines, want %d", len(lines), len(want))
+    }
+    for i, line := range lines {
+        if line.Amount().Amount() != want[i] {
+            t.Errorf("line %d at %v = %d¢, want %d¢", i, line.Rate(), line.Amount().Amount(), want[i])
+        }
+    }
+}
+
+func TestCalculateSkipsExemptItemsAndTheirShareOfDiscount(t *testing.T) {
+    calculator := newTestCalculator(t, 0.0725)
+    items := []TaxableItem{
+        {Amount: usd(t, 300)},
+        {Amount: usd(t, 100), Exempt: true},
+    }
+    
+    // 100¢ off a 400¢ order is 75¢ off the taxable 300¢
+    lines, err := calculator.Calculate(shared.Address{}, items, usd(t, 100))
+    if err != nil {
+        t.Fatalf("Calculate: %v", err)
+    }
+    
+    if got := lines[0].TaxableAmount().Amount(); got != 225 {
+        t.Errorf("taxable amount = %d¢, want 225¢", got)
+    }
+    if got := lines[0].Amount().Amount(); got != 16 {
+        t.Errorf("tax = %d¢, want 16¢", got)
+    }
+}
+
+func TestCalculateKeepsRatesFinerThanABasisPoint(t *testing.T) {
+    calculator := newTestCalculator(t, 0.08875)
+    
+    lines, err := calculator.Calculate(shared.Address{}, []TaxableItem{{Amount: usd(t, 20000)}}, shared.Money{})
+    if err != nil {
+        t.Fatalf("Calculate: %v", err)
+    }
+    
+    // 8.88% would be 1776¢
+    if got := lines[0].Amount().Amount(); got != 1775 {
+        t.Errorf("tax = %d¢, want 1775¢", got)
+    }
+    if got := lines[0].Rate(); got != 0.08875 {
+        t.Errorf("rate = %v, want 0.08875", got)
+    }
+}
+
+func TestNewRateRejectsRatesItCannotHoldExactly(t *testing.T) {
+    jurisdiction, err := NewJurisdiction("US", "NY", "")
+    if err != nil {
+        t.Fatalf("NewJurisdiction: %v", err)
+    }
+    
+    _, err = NewRate(jurisdiction, "tax", 0.0887501)
+    if err == nil {
+        t.Error("NewRate(0.0887501) succeeded, want an error instead of rounding")
+    }
+}
@@ -0,0 +1,9 @@
+package tax
+
+import "github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+
+// RateTable looks up the tax rates that apply at a location
+// WHY: Domain defines the interface, infrastructure decides where rates come from
+type RateTable interface {
+    RatesFor(location shared.Address) ([]Rate, error)
+}
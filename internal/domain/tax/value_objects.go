@@ -0,0 +1,98 @@
+package tax
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// Jurisdiction identifies where a tax rate applies
+// WHY: Sales tax stacks by level - country, then state, then local (zip) rates
+// WHAT: Empty state or zip code means the rate covers the whole country or state
+type Jurisdiction struct {
+    country string
+    state   string
+    zipCode string
+}
+
+func NewJurisdiction(country, state, zipCode string) (Jurisdiction, error) {
+    if country == "" {
+        return Jurisdiction{}, errors.New("country is required")
+    }
+    if zipCode != "" && state == "" {
+        return Jurisdiction{}, errors.New("state is required when zip code is set")
+    }
+    return Jurisdiction{
+        country: strings.ToUpper(country),
+        state:   strings.ToUpper(state),
+        zipCode: zipCode,
+    }, nil
+}
+
+// Covers reports whether an address falls inside the jurisdiction
+func (j Jurisdiction) Covers(address shared.Address) bool {
+    if !strings.EqualFold(j.country, address.Country()) {
+        return false
+    }
+    if j.state != "" && !strings.EqualFold(j.state, address.State()) {
+        return false
+    }
+    if j.zipCode != "" && j.zipCode != address.ZipCode() {
+        return false
+    }
+    return true
+}
+
+func (j Jurisdiction) Country() string { return j.country }
+func (j Jurisdiction) State() string   { return j.state }
+func (j Jurisdiction) ZipCode() string { return j.zipCode }
+
+// Rate is a value object for a single tax levied in a jurisdiction
+type Rate struct {
+    jurisdiction Jurisdiction
+    name         string
+    rate         shared.PartsPerMillion
+}
+
+// NewRate creates a tax rate, e.g. NewRate(ca, "CA state sales tax", 0.0725)
+func NewRate(jurisdiction Jurisdiction, name string, rate float64) (Rate, error) {
+    if name == "" {
+        return Rate{}, errors.New("tax name is required")
+    }
+    parts := shared.PartsPerMillionFrom(rate)
+    if parts <= 0 || parts >= shared.PartsPerMillionFrom(1) {
+        return Rate{}, errors.New("tax rate must be between 0 and 1")
+    }
+    // WHY: Rounding a finer rate would quietly charge the wrong tax on every order
+    if parts.Fraction() != rate {
+        return Rate{}, fmt.Errorf("tax rate %v is finer than a ten-thousandth of a percent", rate)
+    }
+    return Rate{jurisdiction: jurisdiction, name: name, rate: parts}, nil
+}
+
+func (r Rate) Jurisdiction() Jurisdiction              { return r.jurisdiction }
+func (r Rate) Name() string                            { return r.name }
+func (r Rate) Rate() float64                           { return r.rate.Fraction() }
+func (r Rate) PartsPerMillion() shared.PartsPerMillion { return r.rate }
+
+// Line is a value object for the tax charged under one rate
+// WHERE: Stored on orders so the breakdown survives later rate changes
+type Line struct {
+    name          string
+    rate          shared.PartsPerMillion
+    taxableAmount shared.Money
+    amount        shared.Money
+}
+
+func (l Line) Name() string                { return l.name }
+func (l Line) Rate() float64               { return l.rate.Fraction() }
+func (l Line) TaxableAmount() shared.Money { return l.taxableAmount }
+func (l Line) Amount() shared.Money        { return l.amount }
+
+// TaxableItem is the input the calculator needs from one order line
+type TaxableItem struct {
+    Amount shared.Money
+    Exempt bool
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds application settings
+// WHY: Keeps deploy-specific values out of the code
+// WHERE: Loaded once in main.go and passed to the components that need it
+type Config struct {
+    // GRPCAddress is the address the gRPC server listens on
+    GRPCAddress string
+    
+    // PointsValueCents is how many cents one loyalty point is worth at checkout
+    PointsValueCents int64
+    
+    // ReservationTTL is how long stock is held for an order that hasn't started preparing
+    ReservationTTL time.Duration
+    
+    // ReservationSweepInterval is how often expired reservations are released
+    ReservationSweepInterval time.Duration
+    
+    // PriceScheduleInterval is how often scheduled price changes are checked
+    PriceScheduleInterval time.Duration
+    
+    // PreOrderReleaseInterval is how often pre-orders are checked for release to the kitchen
+    PreOrderReleaseInterval time.Duration
+}
+
+// Load reads configuration from environment variables, falling back to defaults
+func Load() (*Config, error) {
+    cfg := &Config{
+        GRPCAddress:              ":50051",
+        PointsValueCents:         1, // 100 points = $1.00
+        ReservationTTL:           30 * time.Minute,
+        ReservationSweepInterval: time.Minute,
+        PriceScheduleInterval:    time.Minute,
+        PreOrderReleaseInterval:  30 * time.Second,
+    }
+    
+    if address := os.Getenv("GRPC_ADDRESS"); address != "" {
+        cfg.GRPCAddress = address
+    }
+    
+    if value := os.Getenv("POINTS_VALUE_CENTS"); value != "" {
+        cents, err := strconv.ParseInt(value, 10, 64)
+        if err != nil {
+            return nil, fmt.Errorf("invalid POINTS_VALUE_CENTS: %w", err)
+        }
+        cfg.PointsValueCents = cents
+    }
+    
+    if value := os.Getenv("RESERVATION_TTL"); value != "" {
+        ttl, err := time.ParseDuration(value)
+        if err != nil {
+            return nil, fmt.Errorf("invalid RESERVATION_TTL: %w", err)
+        }
+        cfg.ReservationTTL = ttl
+    }
+    
+    if value := os.Getenv("RESERVATION_SWEEP_INTERVAL"); value != "" {
+        interval, err := time.ParseDuration(value)
+        if err != nil || interval <= 0 {
+            return nil, fmt.Errorf("invalid RESERVATION_SWEEP_INTERVAL: %q", value)
+        }
+        cfg.ReservationSweepInterval = interval
+    }
+    
+    if value := os.Getenv("PRICE_SCHEDULE_INTERVAL"); value != "" {
+        interval, err := time.ParseDuration(value)
+        if err != nil || interval <= 0 {
+            return nil, fmt.Errorf("invalid PRICE_SCHEDULE_INTERVAL: %q", value)
+        }
+        cfg.PriceScheduleInterval = interval
+    }
+    
+    if value := os.Getenv("PRE_ORDER_RELEASE_INTERVAL"); value != "" {
+        interval, err := time.ParseDuration(value)
+        if err != nil || interval <= 0 {
+            return nil, fmt.Errorf("invalid PRE_ORDER_RELEASE_INTERVAL: %q", value)
+        }
+        cfg.PreOrderReleaseInterval = interval
+    }
+    
+    return cfg, nil
+}
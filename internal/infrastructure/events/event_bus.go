@@ -10,52 +10,91 @@ import (
 )
 
 // EventHandler is a function that handles domain events
-type EventHandler func(ctx context.Context, event shared.DomainEvent) error
+type EventHandler = interfaces.EventHandler
+
+// subscription pairs a handler with an ID so it can be removed later
+type subscription struct {
+    id      uint64
+    handler EventHandler
+}
 
 // InMemoryEventBus is an in-memory implementation of event publishing
 // WHY: Decouples event producers from consumers
 // WHERE: Used by application layer to publish domain events
 type InMemoryEventBus struct {
     mu       sync.RWMutex
-    handlers map[string][]EventHandler
+    nextID   uint64
+    handlers map[string][]subscription
 }
 
 // NewInMemoryEventBus creates a new event bus
 func NewInMemoryEventBus() *InMemoryEventBus {
     return &InMemoryEventBus{
-        handlers: make(map[string][]EventHandler),
+        handlers: make(map[string][]subscription),
     }
 }
 
 // Subscribe registers a handler for an event type
 // WHAT: Allows registration of multiple handlers per event
-func (bus *InMemoryEventBus) Subscribe(eventName string, handler EventHandler) {
+// The returned function removes only this handler and is safe to call more than once
+func (bus *InMemoryEventBus) Subscribe(eventName string, handler EventHandler) func() {
     bus.mu.Lock()
     defer bus.mu.Unlock()
     
-    bus.handlers[eventName] = append(bus.handlers[eventName], handler)
+    bus.nextID++
+    id := bus.nextID
+    bus.handlers[eventName] = append(bus.handlers[eventName], subscription{id: id, handler: handler})
+    
+    return func() {
+        bus.unsubscribe(eventName, id)
+    }
+}
+
+// unsubscribe removes a single handler by subscription ID
+func (bus *InMemoryEventBus) unsubscribe(eventName string, id uint64) {
+    bus.mu.Lock()
+    defer bus.mu.Unlock()
+    
+    subs := bus.handlers[eventName]
+    for i, sub := range subs {
+        if sub.id == id {
+            // Copy so in-flight Publish calls keep their own snapshot
+            remaining := make([]subscription, 0, len(subs)-1)
+            remaining = append(remaining, subs[:i]...)
+            remaining = append(remaining, subs[i+1:]...)
+            bus.handlers[eventName] = remaining
+            return
+        }
+    }
 }
 
 // Publish sends events to all registered handlers
 // WHY: Implements eventual consistency pattern
 func (bus *InMemoryEventBus) Publish(ctx context.Context, events ...shared.DomainEvent) error {
+    // Handlers outlive the request that published the event, so they must not
+    // be cancelled with it while waiting for the unit of work
+    ctx = context.WithoutCancel(ctx)
+    
     for _, event := range events {
         bus.mu.RLock()
-        handlers := bus.handlers[event.EventName()]
+        subs := bus.handlers[event.EventName()]
         bus.mu.RUnlock()
         
         // Execute handlers asynchronously
-        for _, handler := range handlers {
+        for _, sub := range subs {
             go func(h EventHandler, e shared.DomainEvent) {
                 if err := h(ctx, e); err != nil {
                     log.Printf("Event handler error for %s: %v", e.EventName(), err)
                 }
-            }(handler, event)
+            }(sub.handler, event)
         }
     }
     
     return nil
 }
 
-// Ensure it implements the interface
-var _ interfaces.EventPublisher = (*InMemoryEventBus)(nil)
+// Ensure it implements the interfaces
+var (
+    _ interfaces.EventPublisher  = (*InMemoryEventBus)(nil)
+    _ interfaces.EventSubscriber = (*InMemoryEventBus)(nil)
+)
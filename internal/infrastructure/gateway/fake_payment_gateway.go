@@ -0,0 +1,120 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+)
+
+// DeclinedCents makes the fake gateway decline any amount ending in these cents
+// WHY: Lets local runs and tests trigger a decline on purpose, e.g. $4.13
+const DeclinedCents = 13
+
+// FakePaymentGateway is a deterministic in-memory PaymentGateway
+// WHY: Local development and tests need payments without a real provider
+// WHAT: Issues sequential authorization ids and enforces the same rules a provider would
+type FakePaymentGateway struct {
+    mu             sync.Mutex
+    nextID         int
+    authorizations map[string]*fakeAuthorization
+}
+
+type fakeAuthorization struct {
+    amount   shared.Money
+    captured bool
+    voided   bool
+    refunded int64
+}
+
+// NewFakePaymentGateway creates a new fake gateway
+func NewFakePaymentGateway() *FakePaymentGateway {
+    return &FakePaymentGateway{
+        authorizations: make(map[string]*fakeAuthorization),
+    }
+}
+
+// Authorize holds funds unless the amount ends in DeclinedCents
+func (g *FakePaymentGateway) Authorize(ctx context.Context, orderID string, amount shared.Money) (string, error) {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    
+    if amount.Amount() <= 0 {
+        return "", errors.New("amount must be greater than zero")
+    }
+    
+    if amount.Amount()%100 == DeclinedCents {
+        return "", fmt.Errorf("%w: card declined for order %s", payment.ErrPaymentDeclined, orderID)
+    }
+    
+    g.nextID++
+    authorizationID := fmt.Sprintf("fake_auth_%06d", g.nextID)
+    g.authorizations[authorizationID] = &fakeAuthorization{amount: amount}
+    
+    return authorizationID, nil
+}
+
+// Capture collects held funds, at most the authorized amount
+func (g *FakePaymentGateway) Capture(ctx context.Context, authorizationID string, amount shared.Money) error {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    
+    auth, exists := g.authorizations[authorizationID]
+    if !exists {
+        return errors.New("authorization not found")
+    }
+    if auth.captured || auth.voided {
+        return errors.New("authorization already settled")
+    }
+    if amount.Amount() > auth.amount.Amount() {
+        return errors.New("capture exceeds authorized amount")
+    }
+    
+    auth.amount = amount
+    auth.captured = true
+    return nil
+}
+
+// Void releases held funds
+func (g *FakePaymentGateway) Void(ctx context.Context, authorizationID string) error {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    
+    auth, exists := g.authorizations[authorizationID]
+    if !exists {
+        return errors.New("authorization not found")
+    }
+    if auth.captured {
+        return errors.New("cannot void captured authorization")
+    }
+    
+    auth.voided = true
+    return nil
+}
+
+// Refund returns captured funds, at most what was captured
+func (g *FakePaymentGateway) Refund(ctx context.Context, authorizationID string, amount shared.Money) error {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    
+    auth, exists := g.authorizations[authorizationID]
+    if !exists {
+        return errors.New("authorization not found")
+    }
+    if !auth.captured {
+        return errors.New("cannot refund uncaptured authorization")
+    }
+    if auth.refunded+amount.Amount() > auth.amount.Amount() {
+        return errors.New("refund exceeds captured amount")
+    }
+    
+    auth.refunded += amount.Amount()
+    return nil
+}
+
+// Ensure it implements the interface
+var _ interfaces.PaymentGateway = (*FakePaymentGateway)(nil)
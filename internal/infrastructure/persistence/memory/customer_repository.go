@@ -1,7 +1,6 @@
 package memory
 
 import (
-	"errors"
 	"sync"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
@@ -43,7 +42,7 @@ func (r *InMemoryCustomerRepository) FindByID(id customer.CustomerID) (*customer
     
     customerAgg, exists := r.customers[id]
     if !exists {
-        return nil, errors.New("customer not found")
+        return nil, customer.ErrCustomerNotFound
     }
     
     return customerAgg, nil
@@ -57,7 +56,7 @@ func (r *InMemoryCustomerRepository) FindByEmail(email customer.Email) (*custome
     
     customerID, exists := r.emailIndex[email]
     if !exists {
-        return nil, errors.New("customer not found")
+        return nil, customer.ErrCustomerNotFound
     }
     
     return r.customers[customerID], nil
@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+)
+
+// removedRetention is how long a removed order is remembered
+// WHY: Stale events arrive within moments of the removal, long after it the
+// entry only takes up memory
+const removedRetention = 10 * time.Minute
+
+// InMemoryKitchenQueueStore is an in-memory implementation of KitchenQueueStore
+// WHY: For testing and demo purposes without database dependency
+type InMemoryKitchenQueueStore struct {
+    mu       sync.RWMutex
+    tickets  map[string]map[string]dtos.KitchenTicketDTO // Keyed by store, then order ID
+    removed  map[string]time.Time                        // When each order left the queue
+    watchers map[string]map[int]func()                   // Keyed by store, then watcher ID
+    nextID   int
+}
+
+// NewInMemoryKitchenQueueStore creates a new in-memory kitchen queue store
+func NewInMemoryKitchenQueueStore() *InMemoryKitchenQueueStore {
+    return &InMemoryKitchenQueueStore{
+        tickets:  make(map[string]map[string]dtos.KitchenTicketDTO),
+        removed:  make(map[string]time.Time),
+        watchers: make(map[string]map[int]func()),
+    }
+}
+
+// Record stores the ticket, events are delivered concurrently so an older one is ignored
+func (s *InMemoryKitchenQueueStore) Record(ticket dtos.KitchenTicketDTO) error {
+    s.mu.Lock()
+    if removedAt, exists := s.removed[ticket.OrderID]; exists && !ticket.UpdatedAt.After(removedAt) {
+        s.mu.Unlock()
+        return nil
+    }
+    current, exists := s.tickets[ticket.StoreID][ticket.OrderID]
+    if exists && current.UpdatedAt.After(ticket.UpdatedAt) {
+        s.mu.Unlock()
+        return nil
+    }
+    
+    if s.tickets[ticket.StoreID] == nil {
+        s.tickets[ticket.StoreID] = make(map[string]dtos.KitchenTicketDTO)
+    }
+    s.tickets[ticket.StoreID][ticket.OrderID] = ticket
+    delete(s.removed, ticket.OrderID)
+    notify := s.watchersOf(ticket.StoreID)
+    s.mu.Unlock()
+    
+    for _, fn := range notify {
+        fn()
+    }
+    return nil
+}
+
+// Remove drops the ticket and remembers when, so a late Record can't bring it back
+func (s *InMemoryKitchenQueueStore) Remove(storeID string, orderID string, at time.Time) error {
+    s.mu.Lock()
+    current, exists := s.tickets[storeID][orderID]
+    if exists && current.UpdatedAt.After(at) {
+        s.mu.Unlock()
+        return nil
+    }
+    if removedAt, seen := s.removed[orderID]; !seen || at.After(removedAt) {
+        s.removed[orderID] = at
+    }
+    s.pruneRemoved(time.Now())
+    if !exists {
+        s.mu.Unlock()
+        return nil
+    }
+    
+    delete(s.tickets[storeID], orderID)
+    notify := s.watchersOf(storeID)
+    s.mu.Unlock()
+    
+    for _, fn := range notify {
+        fn()
+    }
+    return nil
+}
+
+// List returns the store's tickets by rank, then due time, then order ID
+func (s *InMemoryKitchenQueueStore) List(storeID string) ([]dtos.KitchenTicketDTO, error) {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    
+    tickets := make([]dtos.KitchenTicketDTO, 0, len(s.tickets[storeID]))
+    for _, ticket := range s.tickets[storeID] {
+        tickets = append(tickets, ticket)
+    }
+    
+    sort.Slice(tickets, func(i, j int) bool {
+        if tickets[i].Rank != tickets[j].Rank {
+            return tickets[i].Rank < tickets[j].Rank
+        }
+        if !tickets[i].DueAt.Equal(tickets[j].DueAt) {
+            return tickets[i].DueAt.Before(tickets[j].DueAt)
+        }
+        return tickets[i].OrderID < tickets[j].OrderID
+    })
+    
+    return tickets, nil
+}
+
+// Watch registers notify for the store, it is called without the lock held
+func (s *InMemoryKitchenQueueStore) Watch(storeID string, notify func()) func() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    
+    s.nextID++
+    id := s.nextID
+    if s.watchers[storeID] == nil {
+        s.watchers[storeID] = make(map[int]func())
+    }
+    s.watchers[storeID][id] = notify
+    
+    return func() {
+        s.mu.Lock()
+        defer s.mu.Unlock()
+        delete(s.watchers[storeID], id)
+    }
+}
+
+// watchersOf copies the store's watchers so they can be called after unlocking
+func (s *InMemoryKitchenQueueStore) watchersOf(storeID string) []func() {
+    notify := make([]func(), 0, len(s.watchers[storeID]))
+    for _, fn := range s.watchers[storeID] {
+        notify = append(notify, fn)
+    }
+    return notify
+}
+
+// pruneRemoved forgets orders removed longer ago than the retention window
+func (s *InMemoryKitchenQueueStore) pruneRemoved(now time.Time) {
+    cutoff := now.Add(-removedRetention)
+    for orderID, removedAt := range s.removed {
+        if removedAt.Before(cutoff) {
+            delete(s.removed, orderID)
+        }
+    }
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// InMemoryOrderPolicies is an in-memory implementation of order.OrderPolicyProvider
+type InMemoryOrderPolicies struct {
+    mu            sync.RWMutex
+    defaultPolicy order.OrderPolicy
+    policies      map[store.StoreID]order.OrderPolicy
+}
+
+// NewInMemoryOrderPolicies creates a provider that gives every store the default policy
+func NewInMemoryOrderPolicies(defaultPolicy order.OrderPolicy) *InMemoryOrderPolicies {
+    return &InMemoryOrderPolicies{
+        defaultPolicy: defaultPolicy,
+        policies:      make(map[store.StoreID]order.OrderPolicy),
+    }
+}
+
+// SetPolicy gives one store its own policy
+func (p *InMemoryOrderPolicies) SetPolicy(storeID store.StoreID, policy order.OrderPolicy) {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    
+    p.policies[storeID] = policy
+}
+
+// PolicyFor returns the store's own policy, or the default if it has none
+func (p *InMemoryOrderPolicies) PolicyFor(storeID store.StoreID) order.OrderPolicy {
+    p.mu.RLock()
+    defer p.mu.RUnlock()
+    
+    if policy, exists := p.policies[storeID]; exists {
+        return policy
+    }
+    return p.defaultPolicy
+}
+
+// Ensure it implements the interface
+var _ order.OrderPolicyProvider = (*InMemoryOrderPolicies)(nil)
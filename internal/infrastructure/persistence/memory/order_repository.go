@@ -1,7 +1,6 @@
 package memory
 
 import (
-	"errors"
 	"sync"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
@@ -61,7 +60,7 @@ func (r *InMemoryOrderRepository) FindByID(id order.OrderID) (*order.Order, erro
     
     orderAgg, exists := r.orders[id]
     if !exists {
-        return nil, errors.New("order not found")
+        return nil, order.ErrOrderNotFound
     }
     
     return orderAgg, nil
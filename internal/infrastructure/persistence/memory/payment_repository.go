@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+)
+
+// InMemoryPaymentRepository is an in-memory implementation of PaymentRepository
+type InMemoryPaymentRepository struct {
+    mu       sync.RWMutex
+    payments map[payment.PaymentID]*payment.Payment
+    // Secondary index, an order has at most one payment
+    orderIndex map[order.OrderID]payment.PaymentID
+}
+
+// NewInMemoryPaymentRepository creates a new in-memory payment repository
+func NewInMemoryPaymentRepository() *InMemoryPaymentRepository {
+    return &InMemoryPaymentRepository{
+        payments:   make(map[payment.PaymentID]*payment.Payment),
+        orderIndex: make(map[order.OrderID]payment.PaymentID),
+    }
+}
+
+// Save persists a payment aggregate
+func (r *InMemoryPaymentRepository) Save(paymentAgg *payment.Payment) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    
+    r.payments[paymentAgg.ID()] = paymentAgg
+    r.orderIndex[paymentAgg.OrderID()] = paymentAgg.ID()
+    
+    return nil
+}
+
+// FindByID retrieves a payment by ID
+func (r *InMemoryPaymentRepository) FindByID(id payment.PaymentID) (*payment.Payment, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    paymentAgg, exists := r.payments[id]
+    if !exists {
+        return nil, payment.ErrPaymentNotFound
+    }
+    
+    return paymentAgg, nil
+}
+
+// FindByOrderID retrieves the payment taken for an order
+func (r *InMemoryPaymentRepository) FindByOrderID(orderID order.OrderID) (*payment.Payment, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    id, exists := r.orderIndex[orderID]
+    if !exists {
+        return nil, payment.ErrPaymentNotFound
+    }
+    
+    return r.payments[id], nil
+}
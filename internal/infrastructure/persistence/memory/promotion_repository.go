@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+)
+
+// InMemoryPromotionRepository is an in-memory implementation of PromotionRepository
+type InMemoryPromotionRepository struct {
+    mu         sync.RWMutex
+    promotions map[promotion.PromotionID]*promotion.Promotion
+    codeIndex  map[promotion.PromoCode]promotion.PromotionID
+}
+
+// NewInMemoryPromotionRepository creates a new in-memory promotion repository
+func NewInMemoryPromotionRepository() *InMemoryPromotionRepository {
+    return &InMemoryPromotionRepository{
+        promotions: make(map[promotion.PromotionID]*promotion.Promotion),
+        codeIndex:  make(map[promotion.PromoCode]promotion.PromotionID),
+    }
+}
+
+// Save persists a promotion aggregate
+// WHY: Codes are unique, a different promotion cannot reuse one
+func (r *InMemoryPromotionRepository) Save(promotionAgg *promotion.Promotion) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    
+    if existingID, exists := r.codeIndex[promotionAgg.Code()]; exists && existingID != promotionAgg.ID() {
+        return promotion.ErrDuplicatePromoCode
+    }
+    
+    // Save promotion
+    r.promotions[promotionAgg.ID()] = promotionAgg
+    
+    // Update code index
+    r.codeIndex[promotionAgg.Code()] = promotionAgg.ID()
+    
+    return nil
+}
+
+// FindByID retrieves a promotion by ID
+func (r *InMemoryPromotionRepository) FindByID(id promotion.PromotionID) (*promotion.Promotion, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    promotionAgg, exists := r.promotions[id]
+    if !exists {
+        return nil, promotion.ErrPromotionNotFound
+    }
+    
+    return promotionAgg, nil
+}
+
+// FindByCode retrieves a promotion by its promo code
+// WHY: Customers only know the code
+func (r *InMemoryPromotionRepository) FindByCode(code promotion.PromoCode) (*promotion.Promotion, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    promotionID, exists := r.codeIndex[code]
+    if !exists {
+        return nil, promotion.ErrPromotionNotFound
+    }
+    
+    return r.promotions[promotionID], nil
+}
+
+// FindAll returns all promotions
+func (r *InMemoryPromotionRepository) FindAll() ([]*promotion.Promotion, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    promotions := make([]*promotion.Promotion, 0, len(r.promotions))
+    for _, promotionAgg := range r.promotions {
+        promotions = append(promotions, promotionAgg)
+    }
+    
+    return promotions, nil
+}
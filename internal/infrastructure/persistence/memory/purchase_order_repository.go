@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// InMemoryPurchaseOrderRepository is an in-memory implementation of PurchaseOrderRepository
+type InMemoryPurchaseOrderRepository struct {
+    mu             sync.RWMutex
+    purchaseOrders map[purchasing.PurchaseOrderID]*purchasing.PurchaseOrder
+    storeIndex     map[store.StoreID][]purchasing.PurchaseOrderID
+}
+
+// NewInMemoryPurchaseOrderRepository creates a new in-memory purchase order repository
+func NewInMemoryPurchaseOrderRepository() *InMemoryPurchaseOrderRepository {
+    return &InMemoryPurchaseOrderRepository{
+        purchaseOrders: make(map[purchasing.PurchaseOrderID]*purchasing.PurchaseOrder),
+        storeIndex:     make(map[store.StoreID][]purchasing.PurchaseOrderID),
+    }
+}
+
+// Save persists a purchase order aggregate
+func (r *InMemoryPurchaseOrderRepository) Save(purchaseOrder *purchasing.PurchaseOrder) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    
+    // Update store index for new purchase orders
+    if _, exists := r.purchaseOrders[purchaseOrder.ID()]; !exists {
+        storeID := purchaseOrder.StoreID()
+        r.storeIndex[storeID] = append(r.storeIndex[storeID], purchaseOrder.ID())
+    }
+    
+    r.purchaseOrders[purchaseOrder.ID()] = purchaseOrder
+    return nil
+}
+
+// FindByID retrieves a purchase order by ID
+func (r *InMemoryPurchaseOrderRepository) FindByID(id purchasing.PurchaseOrderID) (*purchasing.PurchaseOrder, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    purchaseOrder, exists := r.purchaseOrders[id]
+    if !exists {
+        return nil, purchasing.ErrPurchaseOrderNotFound
+    }
+    
+    return purchaseOrder, nil
+}
+
+// FindByStoreID retrieves all purchase orders for a store, oldest first
+func (r *InMemoryPurchaseOrderRepository) FindByStoreID(storeID store.StoreID) ([]*purchasing.PurchaseOrder, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    ids := r.storeIndex[storeID]
+    purchaseOrders := make([]*purchasing.PurchaseOrder, 0, len(ids))
+    for _, id := range ids {
+        purchaseOrders = append(purchaseOrders, r.purchaseOrders[id])
+    }
+    
+    return purchaseOrders, nil
+}
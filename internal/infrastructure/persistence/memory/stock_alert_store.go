@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// InMemoryStockAlertStore is an in-memory implementation of StockAlertStore
+// WHY: For testing and demo purposes without database dependency
+type InMemoryStockAlertStore struct {
+    mu     sync.RWMutex
+    alerts map[string]dtos.StockAlertDTO // Keyed by store and product ID
+}
+
+// NewInMemoryStockAlertStore creates a new in-memory stock alert store
+func NewInMemoryStockAlertStore() *InMemoryStockAlertStore {
+    return &InMemoryStockAlertStore{
+        alerts: make(map[string]dtos.StockAlertDTO),
+    }
+}
+
+// Record stores the alert, events are delivered concurrently so an older one is ignored
+func (s *InMemoryStockAlertStore) Record(alert dtos.StockAlertDTO) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    
+    key := alert.StoreID + "/" + alert.ProductID
+    if current, exists := s.alerts[key]; exists && current.RaisedAt.After(alert.RaisedAt) {
+        return nil
+    }
+    
+    s.alerts[key] = alert
+    return nil
+}
+
+// ListActive returns low and out of stock alerts ordered by store and product name
+func (s *InMemoryStockAlertStore) ListActive(storeID string) ([]dtos.StockAlertDTO, error) {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    
+    var alerts []dtos.StockAlertDTO
+    for _, alert := range s.alerts {
+        if alert.Level == string(store.StockLevelOK) {
+            continue
+        }
+        if storeID != "" && alert.StoreID != storeID {
+            continue
+        }
+        alerts = append(alerts, alert)
+    }
+    
+    sort.Slice(alerts, func(i, j int) bool {
+        if alerts[i].StoreID != alerts[j].StoreID {
+            return alerts[i].StoreID < alerts[j].StoreID
+        }
+        return alerts[i].ProductName < alerts[j].ProductName
+    })
+    
+    return alerts, nil
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+)
+
+// InMemorySupplierRepository is an in-memory implementation of SupplierRepository
+type InMemorySupplierRepository struct {
+    mu        sync.RWMutex
+    suppliers map[purchasing.SupplierID]*purchasing.Supplier
+}
+
+// NewInMemorySupplierRepository creates a new in-memory supplier repository
+func NewInMemorySupplierRepository() *InMemorySupplierRepository {
+    return &InMemorySupplierRepository{
+        suppliers: make(map[purchasing.SupplierID]*purchasing.Supplier),
+    }
+}
+
+// Save persists a supplier aggregate
+func (r *InMemorySupplierRepository) Save(supplier *purchasing.Supplier) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    
+    r.suppliers[supplier.ID()] = supplier
+    return nil
+}
+
+// FindByID retrieves a supplier by ID
+func (r *InMemorySupplierRepository) FindByID(id purchasing.SupplierID) (*purchasing.Supplier, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    supplier, exists := r.suppliers[id]
+    if !exists {
+        return nil, purchasing.ErrSupplierNotFound
+    }
+    
+    return supplier, nil
+}
+
+// FindAll returns all suppliers
+func (r *InMemorySupplierRepository) FindAll() ([]*purchasing.Supplier, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    suppliers := make([]*purchasing.Supplier, 0, len(r.suppliers))
+    for _, supplier := range r.suppliers {
+        suppliers = append(suppliers, supplier)
+    }
+    
+    return suppliers, nil
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/shared"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/tax"
+)
+
+// InMemoryTaxRateTable is an in-memory implementation of tax.RateTable
+type InMemoryTaxRateTable struct {
+    mu    sync.RWMutex
+    rates []tax.Rate
+}
+
+// NewInMemoryTaxRateTable creates an empty tax rate table
+func NewInMemoryTaxRateTable() *InMemoryTaxRateTable {
+    return &InMemoryTaxRateTable{
+        rates: make([]tax.Rate, 0),
+    }
+}
+
+// AddRate registers a tax rate for its jurisdiction
+func (t *InMemoryTaxRateTable) AddRate(rate tax.Rate) {
+    t.mu.Lock()
+    defer t.mu.Unlock()
+    
+    t.rates = append(t.rates, rate)
+}
+
+// RatesFor returns every rate covering the location
+// WHAT: Ordered from broadest to most local jurisdiction so tax lines read naturally
+func (t *InMemoryTaxRateTable) RatesFor(location shared.Address) ([]tax.Rate, error) {
+    t.mu.RLock()
+    defer t.mu.RUnlock()
+    
+    rates := make([]tax.Rate, 0)
+    for _, rate := range t.rates {
+        if rate.Jurisdiction().Covers(location) {
+            rates = append(rates, rate)
+        }
+    }
+    
+    sort.SliceStable(rates, func(i, j int) bool {
+        return specificity(rates[i].Jurisdiction()) < specificity(rates[j].Jurisdiction())
+    })
+    
+    return rates, nil
+}
+
+// specificity ranks country, state and zip code jurisdictions
+func specificity(jurisdiction tax.Jurisdiction) int {
+    switch {
+    case jurisdiction.ZipCode() != "":
+        return 2
+    case jurisdiction.State() != "":
+        return 1
+    default:
+        return 0
+    }
+}
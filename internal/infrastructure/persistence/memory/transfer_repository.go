@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+)
+
+// InMemoryTransferRepository is an in-memory implementation of TransferRepository
+type InMemoryTransferRepository struct {
+    mu         sync.RWMutex
+    transfers  map[store.TransferID]*store.Transfer
+    storeIndex map[store.StoreID][]store.TransferID // Both the source and destination store
+}
+
+// NewInMemoryTransferRepository creates a new in-memory transfer repository
+func NewInMemoryTransferRepository() *InMemoryTransferRepository {
+    return &InMemoryTransferRepository{
+        transfers:  make(map[store.TransferID]*store.Transfer),
+        storeIndex: make(map[store.StoreID][]store.TransferID),
+    }
+}
+
+// Save persists a transfer aggregate
+func (r *InMemoryTransferRepository) Save(transfer *store.Transfer) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    
+    // Update store index for new transfers
+    if _, exists := r.transfers[transfer.ID()]; !exists {
+        for _, storeID := range []store.StoreID{transfer.SourceStoreID(), transfer.DestinationStoreID()} {
+            r.storeIndex[storeID] = append(r.storeIndex[storeID], transfer.ID())
+        }
+    }
+    
+    r.transfers[transfer.ID()] = transfer
+    return nil
+}
+
+// FindByID retrieves a transfer by ID
+func (r *InMemoryTransferRepository) FindByID(id store.TransferID) (*store.Transfer, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    transfer, exists := r.transfers[id]
+    if !exists {
+        return nil, store.ErrTransferNotFound
+    }
+    
+    return transfer, nil
+}
+
+// FindByStoreID retrieves transfers sent from or to a store, oldest first
+func (r *InMemoryTransferRepository) FindByStoreID(storeID store.StoreID) ([]*store.Transfer, error) {
+    r.mu.RLock()
+    defer r.mu.RUnlock()
+    
+    ids := r.storeIndex[storeID]
+    transfers := make([]*store.Transfer, 0, len(ids))
+    for _, id := range ids {
+        transfers = append(transfers, r.transfers[id])
+    }
+    
+    return transfers, nil
+}
@@ -3,23 +3,30 @@ package memory
 import (
 	"context"
 	"errors"
-	"sync"
 
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/interfaces"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
 	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
 )
 
 // InMemoryUnitOfWork implements unit of work pattern for in-memory repositories
 // WHY: Ensures consistency when updating multiple aggregates
-// WHAT: Provides transaction-like behavior for in-memory storage
+// WHAT: Provides transaction-like behavior for in-memory storage. Units of work
+// run one at a time - Begin waits for the current one to commit or roll back.
 type InMemoryUnitOfWork struct {
-	mu           sync.Mutex
-	inProgress   bool
-	storeRepo    store.StoreRepository
-	orderRepo    order.OrderRepository
-	customerRepo customer.CustomerRepository
+	slot              chan struct{} // Holds a token while a unit of work is in progress
+	storeRepo         store.StoreRepository
+	orderRepo         order.OrderRepository
+	customerRepo      customer.CustomerRepository
+	promoRepo         promotion.PromotionRepository
+	paymentRepo       payment.PaymentRepository
+	supplierRepo      purchasing.SupplierRepository
+	purchaseOrderRepo purchasing.PurchaseOrderRepository
+	transferRepo      store.TransferRepository
 }
 
 // NewInMemoryUnitOfWork creates a new unit of work
@@ -27,48 +34,55 @@ func NewInMemoryUnitOfWork(
 	storeRepo store.StoreRepository,
 	orderRepo order.OrderRepository,
 	customerRepo customer.CustomerRepository,
+	promoRepo promotion.PromotionRepository,
+	paymentRepo payment.PaymentRepository,
+	supplierRepo purchasing.SupplierRepository,
+	purchaseOrderRepo purchasing.PurchaseOrderRepository,
+	transferRepo store.TransferRepository,
 ) *InMemoryUnitOfWork {
 	return &InMemoryUnitOfWork{
-		storeRepo:    storeRepo,
-		orderRepo:    orderRepo,
-		customerRepo: customerRepo,
+		slot:              make(chan struct{}, 1),
+		storeRepo:         storeRepo,
+		orderRepo:         orderRepo,
+		customerRepo:      customerRepo,
+		promoRepo:         promoRepo,
+		paymentRepo:       paymentRepo,
+		supplierRepo:      supplierRepo,
+		purchaseOrderRepo: purchaseOrderRepo,
+		transferRepo:      transferRepo,
 	}
 }
 
 // Begin starts a new unit of work
 // WHERE: Called at the beginning of application commands
+// WHY: Schedulers and event handlers run alongside requests, so Begin waits
+// its turn instead of failing while another unit of work is open
 func (uow *InMemoryUnitOfWork) Begin(ctx context.Context) error {
-	uow.mu.Lock()
-	defer uow.mu.Unlock()
-
-	if uow.inProgress {
-		return errors.New("unit of work already in progress")
+	select {
+	case uow.slot <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-
-	uow.inProgress = true
-	return nil
 }
 
 // Commit commits the unit of work
 // WHAT: In real implementation would commit database transaction
 func (uow *InMemoryUnitOfWork) Commit() error {
-	uow.mu.Lock()
-	defer uow.mu.Unlock()
-
-	if !uow.inProgress {
+	select {
+	case <-uow.slot:
+		return nil
+	default:
 		return errors.New("no unit of work in progress")
 	}
-
-	uow.inProgress = false
-	return nil
 }
 
 // Rollback rolls back the unit of work
 func (uow *InMemoryUnitOfWork) Rollback() error {
-	uow.mu.Lock()
-	defer uow.mu.Unlock()
-
-	uow.inProgress = false
+	select {
+	case <-uow.slot:
+	default:
+	}
 	return nil
 }
 
@@ -85,5 +99,25 @@ func (uow *InMemoryUnitOfWork) CustomerRepository() customer.CustomerRepository
 	return uow.customerRepo
 }
 
+func (uow *InMemoryUnitOfWork) PromotionRepository() promotion.PromotionRepository {
+	return uow.promoRepo
+}
+
+func (uow *InMemoryUnitOfWork) PaymentRepository() payment.PaymentRepository {
+	return uow.paymentRepo
+}
+
+func (uow *InMemoryUnitOfWork) SupplierRepository() purchasing.SupplierRepository {
+	return uow.supplierRepo
+}
+
+func (uow *InMemoryUnitOfWork) PurchaseOrderRepository() purchasing.PurchaseOrderRepository {
+	return uow.purchaseOrderRepo
+}
+
+func (uow *InMemoryUnitOfWork) TransferRepository() store.TransferRepository {
+	return uow.transferRepo
+}
+
 // Ensure it implements the interface
 var _ interfaces.UnitOfWork = (*InMemoryUnitOfWork)(nil)
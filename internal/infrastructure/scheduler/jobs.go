@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	orderCmds "github.com/matzxrr/ddd-lemonadestore/internal/application/order/commands"
+	storeCmds "github.com/matzxrr/ddd-lemonadestore/internal/application/store/commands"
+)
+
+// NewReservationSweeper releases expired inventory reservations every interval
+// WHY: Nothing else happens to an abandoned order, so time has to drive the release
+func NewReservationSweeper(handler *storeCmds.ExpireReservationsHandler, interval time.Duration) *Periodic {
+    job := func(ctx context.Context, now time.Time) (int, error) {
+        return handler.Handle(ctx, storeCmds.ExpireReservationsCommand{Now: now})
+    }
+    return NewPeriodic(job, interval, "Reservation sweep failed", "Released %d expired inventory reservations")
+}
+
+// NewPriceScheduler applies scheduled price changes that have come due every interval
+// WHY: A price change is announced ahead, so time rather than a request has to trigger it
+func NewPriceScheduler(handler *storeCmds.ApplyScheduledPricesHandler, interval time.Duration) *Periodic {
+    job := func(ctx context.Context, now time.Time) (int, error) {
+        return handler.Handle(ctx, storeCmds.ApplyScheduledPricesCommand{Now: now})
+    }
+    return NewPeriodic(job, interval, "Applying scheduled prices failed", "Applied %d scheduled price changes")
+}
+
+// NewPreOrderScheduler releases pre-orders to the kitchen every interval
+// WHY: A pre-order is confirmed by the clock, ahead of its pickup time by how long it takes to make
+func NewPreOrderScheduler(handler *orderCmds.ReleaseScheduledOrdersHandler, interval time.Duration) *Periodic {
+    job := func(ctx context.Context, now time.Time) (int, error) {
+        return handler.Handle(ctx, orderCmds.ReleaseScheduledOrdersCommand{Now: now})
+    }
+    return NewPeriodic(job, interval, "Releasing pre-orders failed", "Released %d pre-orders to the kitchen")
+}
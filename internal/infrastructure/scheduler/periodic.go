@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job is the work a Periodic runner does on each tick
+// WHAT: Returns how many things it handled, so quiet ticks stay out of the log
+type Job func(ctx context.Context, now time.Time) (int, error)
+
+// Periodic runs a job every interval until its context is cancelled
+// WHY: Some work is driven by time rather than a request, e.g. expiring stock holds
+// WHERE: Started in main.go alongside the gRPC server
+type Periodic struct {
+    job      Job
+    interval time.Duration
+    failed   string // Logged with the error when a run fails
+    done     string // Format for how many things a run handled
+}
+
+// NewPeriodic creates a runner for job, logging failures and work done with the given messages
+func NewPeriodic(job Job, interval time.Duration, failed string, done string) *Periodic {
+    return &Periodic{
+        job:      job,
+        interval: interval,
+        failed:   failed,
+        done:     done,
+    }
+}
+
+// Run calls the job every interval until the context is cancelled
+func (p *Periodic) Run(ctx context.Context) {
+    ticker := time.NewTicker(p.interval)
+    defer ticker.Stop()
+    
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case now := <-ticker.C:
+            count, err := p.job(ctx, now)
+            if err != nil {
+                log.Printf("%s: %v", p.failed, err)
+                continue
+            }
+            if count > 0 {
+                log.Printf(p.done, count)
+            }
+        }
+    }
+}
@@ -35,3 +35,29 @@ func ErrorInterceptor(
     
     return resp, err
 }
+
+// StreamErrorInterceptor is the streaming counterpart of ErrorInterceptor
+func StreamErrorInterceptor(
+    srv interface{},
+    ss grpc.ServerStream,
+    info *grpc.StreamServerInfo,
+    handler grpc.StreamHandler,
+) (err error) {
+    // Recover from panics
+    defer func() {
+        if r := recover(); r != nil {
+            log.Printf("[gRPC] Panic in %s: %v", info.FullMethod, r)
+            err = status.Error(codes.Internal, "internal server error")
+        }
+    }()
+    
+    // Call handler
+    err = handler(srv, ss)
+    
+    // Ensure errors are proper gRPC status
+    if err != nil && status.Code(err) == codes.Unknown {
+        err = status.Error(codes.Internal, err.Error())
+    }
+    
+    return err
+}
@@ -38,3 +38,32 @@ func LoggingInterceptor(
     
     return resp, err
 }
+
+// StreamLoggingInterceptor logs all streaming gRPC calls
+// WHAT: Duration covers the whole lifetime of the stream
+func StreamLoggingInterceptor(
+    srv interface{},
+    ss grpc.ServerStream,
+    info *grpc.StreamServerInfo,
+    handler grpc.StreamHandler,
+) error {
+    start := time.Now()
+    
+    // Log stream start
+    log.Printf("[gRPC] Started stream %s", info.FullMethod)
+    
+    // Call the handler
+    err := handler(srv, ss)
+    
+    // Log completion
+    duration := time.Since(start)
+    if err != nil {
+        st, _ := status.FromError(err)
+        log.Printf("[gRPC] Failed stream %s - Code: %s, Message: %s, Duration: %v",
+            info.FullMethod, st.Code(), st.Message(), duration)
+    } else {
+        log.Printf("[gRPC] Completed stream %s - Duration: %v", info.FullMethod, duration)
+    }
+    
+    return err
+}
@@ -10,17 +10,23 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	customerPb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/customer/v1"
+	kitchenPb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/kitchen/v1"
 	orderPb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/order/v1"
+	promotionPb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/promotion/v1"
+	purchasingPb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/purchasing/v1"
 	storePb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/store/v1"
 )
 
 // Server wraps the gRPC server
 // WHY: Encapsulates server configuration and lifecycle
 type Server struct {
-    grpcServer      *grpc.Server
-    storeService    *services.StoreService
-    orderService    *services.OrderService
-    customerService *services.CustomerService
+    grpcServer        *grpc.Server
+    storeService      *services.StoreService
+    orderService      *services.OrderService
+    customerService   *services.CustomerService
+    promotionService  *services.PromotionService
+    purchasingService *services.PurchasingService
+    kitchenService    *services.KitchenService
 }
 
 // NewServer creates a new gRPC server
@@ -29,6 +35,9 @@ func NewServer(
     storeService *services.StoreService,
     orderService *services.OrderService,
     customerService *services.CustomerService,
+    promotionService *services.PromotionService,
+    purchasingService *services.PurchasingService,
+    kitchenService *services.KitchenService,
 ) *Server {
     // Create gRPC server with interceptors
     opts := []grpc.ServerOption{
@@ -36,6 +45,10 @@ func NewServer(
             interceptors.LoggingInterceptor,
             interceptors.ErrorInterceptor,
         ),
+        grpc.ChainStreamInterceptor(
+            interceptors.StreamLoggingInterceptor,
+            interceptors.StreamErrorInterceptor,
+        ),
     }
     
     grpcServer := grpc.NewServer(opts...)
@@ -44,16 +57,22 @@ func NewServer(
     storePb.RegisterStoreServiceServer(grpcServer, storeService)
     orderPb.RegisterOrderServiceServer(grpcServer, orderService)
     customerPb.RegisterCustomerServiceServer(grpcServer, customerService)
+    promotionPb.RegisterPromotionServiceServer(grpcServer, promotionService)
+    purchasingPb.RegisterPurchasingServiceServer(grpcServer, purchasingService)
+    kitchenPb.RegisterKitchenServiceServer(grpcServer, kitchenService)
     
     // Enable reflection for development
     // WHAT: Allows tools like grpcurl to discover services
     reflection.Register(grpcServer)
     
     return &Server{
-        grpcServer:      grpcServer,
-        storeService:    storeService,
-        orderService:    orderService,
-        customerService: customerService,
+        grpcServer:        grpcServer,
+        storeService:      storeService,
+        orderService:      orderService,
+        customerService:   customerService,
+        promotionService:  promotionService,
+        purchasingService: purchasingService,
+        kitchenService:    kitchenService,
     }
 }
 
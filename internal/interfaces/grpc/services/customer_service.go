@@ -17,23 +17,35 @@ type CustomerService struct {
     pb.UnimplementedCustomerServiceServer
     
     // Command handlers
-    registerCustomerHandler *commands.RegisterCustomerHandler
-    updateCustomerHandler   *commands.UpdateCustomerHandler
+    registerCustomerHandler   *commands.RegisterCustomerHandler
+    updateCustomerHandler     *commands.UpdateCustomerHandler
+    redeemPointsHandler       *commands.RedeemPointsHandler
+    deactivateCustomerHandler *commands.DeactivateCustomerHandler
     
     // Query handlers
-    getCustomerHandler *queries.GetCustomerHandler
+    getCustomerHandler         *queries.GetCustomerHandler
+    getCustomerByEmailHandler  *queries.GetCustomerByEmailHandler
+    listCustomersByTypeHandler *queries.ListCustomersByTypeHandler
 }
 
 // NewCustomerService creates a new customer service
 func NewCustomerService(
     registerCustomer *commands.RegisterCustomerHandler,
     updateCustomer *commands.UpdateCustomerHandler,
+    redeemPoints *commands.RedeemPointsHandler,
+    deactivateCustomer *commands.DeactivateCustomerHandler,
     getCustomer *queries.GetCustomerHandler,
+    getCustomerByEmail *queries.GetCustomerByEmailHandler,
+    listCustomersByType *queries.ListCustomersByTypeHandler,
 ) *CustomerService {
     return &CustomerService{
-        registerCustomerHandler: registerCustomer,
-        updateCustomerHandler:   updateCustomer,
-        getCustomerHandler:      getCustomer,
+        registerCustomerHandler:    registerCustomer,
+        updateCustomerHandler:      updateCustomer,
+        redeemPointsHandler:        redeemPoints,
+        deactivateCustomerHandler:  deactivateCustomer,
+        getCustomerHandler:         getCustomer,
+        getCustomerByEmailHandler:  getCustomerByEmail,
+        listCustomersByTypeHandler: listCustomersByType,
     }
 }
 
@@ -100,6 +112,63 @@ func (s *CustomerService) UpdateCustomerContact(
     }, nil
 }
 
+// RedeemPoints spends a customer's loyalty points
+func (s *CustomerService) RedeemPoints(
+    ctx context.Context,
+    req *pb.RedeemPointsRequest,
+) (*pb.RedeemPointsResponse, error) {
+    // Validate request
+    if req.CustomerId == "" {
+        return nil, status.Error(codes.InvalidArgument, "customer_id is required")
+    }
+    
+    if req.Points <= 0 {
+        return nil, status.Error(codes.InvalidArgument, "points must be positive")
+    }
+    
+    // Create command
+    cmd := commands.RedeemPointsCommand{
+        CustomerID: req.CustomerId,
+        Points:     int(req.Points),
+    }
+    
+    // Execute command
+    remaining, err := s.redeemPointsHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.RedeemPointsResponse{
+        RemainingPoints: int32(remaining),
+    }, nil
+}
+
+// DeactivateCustomer closes a customer account
+func (s *CustomerService) DeactivateCustomer(
+    ctx context.Context,
+    req *pb.DeactivateCustomerRequest,
+) (*pb.DeactivateCustomerResponse, error) {
+    // Validate request
+    if req.CustomerId == "" {
+        return nil, status.Error(codes.InvalidArgument, "customer_id is required")
+    }
+    
+    // Create command
+    cmd := commands.DeactivateCustomerCommand{
+        CustomerID: req.CustomerId,
+    }
+    
+    // Execute command
+    err := s.deactivateCustomerHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.DeactivateCustomerResponse{
+        Success: true,
+    }, nil
+}
+
 // GetCustomer retrieves customer details
 func (s *CustomerService) GetCustomer(
     ctx context.Context,
@@ -118,16 +187,75 @@ func (s *CustomerService) GetCustomer(
     
     // Convert to protobuf
     return &pb.GetCustomerResponse{
-        Customer: &pb.Customer{
-            Id:            customerDTO.ID,
-            Email:         customerDTO.Email,
-            FirstName:     customerDTO.FirstName,
-            LastName:      customerDTO.LastName,
-            PhoneNumber:   customerDTO.PhoneNumber,
-            Type:          customerDTO.Type,
-            LoyaltyPoints: int32(customerDTO.LoyaltyPoints),
-            IsActive:      customerDTO.IsActive,
-            RegisteredAt:  timestamppb.New(customerDTO.RegisteredAt),
-        },
+        Customer: toCustomerPb(customerDTO),
+    }, nil
+}
+
+// GetCustomerByEmail retrieves customer details by email address
+func (s *CustomerService) GetCustomerByEmail(
+    ctx context.Context,
+    req *pb.GetCustomerByEmailRequest,
+) (*pb.GetCustomerByEmailResponse, error) {
+    // Validate request
+    if req.Email == "" {
+        return nil, status.Error(codes.InvalidArgument, "email is required")
+    }
+    
+    // Create query
+    query := queries.GetCustomerByEmailQuery{
+        Email: req.Email,
+    }
+    
+    // Execute query
+    customerDTO, err := s.getCustomerByEmailHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    return &pb.GetCustomerByEmailResponse{
+        Customer: toCustomerPb(customerDTO),
     }, nil
 }
+
+// ListCustomersByType lists customers in a loyalty tier
+func (s *CustomerService) ListCustomersByType(
+    ctx context.Context,
+    req *pb.ListCustomersByTypeRequest,
+) (*pb.ListCustomersByTypeResponse, error) {
+    // Create query
+    query := queries.ListCustomersByTypeQuery{
+        Type: req.Type,
+    }
+    
+    // Execute query
+    customerDTOs, err := s.listCustomersByTypeHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    customers := make([]*pb.Customer, len(customerDTOs))
+    for i, customerDTO := range customerDTOs {
+        customers[i] = toCustomerPb(customerDTO)
+    }
+    
+    return &pb.ListCustomersByTypeResponse{
+        Customers: customers,
+    }, nil
+}
+
+// toCustomerPb converts a customer DTO to its protobuf message
+func toCustomerPb(customerDTO *dtos.CustomerDTO) *pb.Customer {
+    return &pb.Customer{
+        Id:            customerDTO.ID,
+        Email:         customerDTO.Email,
+        FirstName:     customerDTO.FirstName,
+        LastName:      customerDTO.LastName,
+        PhoneNumber:   customerDTO.PhoneNumber,
+        Type:          customerDTO.Type,
+        LoyaltyPoints: int32(customerDTO.LoyaltyPoints),
+        IsActive:      customerDTO.IsActive,
+        RegisteredAt:  timestamppb.New(customerDTO.RegisteredAt),
+    }
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/customer"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/order"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/payment"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/promotion"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/purchasing"
+	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to convert domain errors to gRPC status
+// WHY: Shared by all services so the same domain error maps to the same code
+func toGRPCError(err error) error {
+    // Map domain errors to appropriate gRPC codes
+    switch {
+    case errors.Is(err, store.ErrStoreNotFound):
+        return status.Error(codes.NotFound, "store not found")
+    case errors.Is(err, store.ErrProductNotFound):
+        return status.Error(codes.NotFound, "product not found")
+    case errors.Is(err, store.ErrDuplicateProduct):
+        return status.Error(codes.AlreadyExists, "product with this name already exists")
+    case errors.Is(err, store.ErrInvalidModifiers):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrInsufficientStock):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, store.ErrIngredientNotFound):
+        return status.Error(codes.NotFound, err.Error())
+    case errors.Is(err, store.ErrReservationNotFound):
+        return status.Error(codes.FailedPrecondition, "inventory reservation expired or not found")
+    case errors.Is(err, store.ErrInvalidAdjustment):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrTransferNotFound):
+        return status.Error(codes.NotFound, "transfer not found")
+    case errors.Is(err, store.ErrInvalidTransfer):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrTransferNotInTransit):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, store.ErrInvalidOpeningHours):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrStoreClosed):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, store.ErrInvalidPrice):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrInvalidPriceRule):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrPriceRuleNotFound):
+        return status.Error(codes.NotFound, err.Error())
+    case errors.Is(err, store.ErrPriceChangeNotFound):
+        return status.Error(codes.NotFound, err.Error())
+    case errors.Is(err, store.ErrCategoryNotFound):
+        return status.Error(codes.NotFound, err.Error())
+    case errors.Is(err, store.ErrInvalidCategory):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrInvalidDeliveryBand):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrInvalidPrepTimes):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, store.ErrOutsideDeliveryArea):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, store.ErrDuplicateIngredient):
+        return status.Error(codes.AlreadyExists, "ingredient with this name already exists")
+    case errors.Is(err, order.ErrOrderNotFound):
+        return status.Error(codes.NotFound, "order not found")
+    case errors.Is(err, order.ErrInvalidStatusTransition):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, order.ErrPointsExceedTotal):
+        return status.Error(codes.FailedPrecondition, "points credit exceeds order total")
+    case errors.Is(err, order.ErrOrderNotRefundable),
+        errors.Is(err, order.ErrRefundExceedsPaid),
+        errors.Is(err, order.ErrRefundExceedsQuantity):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, order.ErrPromotionAlreadyApplied):
+        return status.Error(codes.FailedPrecondition, "order already has a promo code")
+    case errors.Is(err, order.ErrInvalidPickupTime),
+        errors.Is(err, order.ErrUnknownOrderStatus),
+        errors.Is(err, order.ErrUnknownFulfillmentType),
+        errors.Is(err, order.ErrInvalidDeliveryAddress):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, order.ErrNotDeliveryOrder),
+        errors.Is(err, order.ErrCourierNotAssigned):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, promotion.ErrPromotionNotFound):
+        return status.Error(codes.NotFound, "promo code not found")
+    case errors.Is(err, promotion.ErrDuplicatePromoCode):
+        return status.Error(codes.AlreadyExists, "promo code already exists")
+    case errors.Is(err, promotion.ErrInvalidRewardType):
+        return status.Error(codes.InvalidArgument, "invalid promotion reward type")
+    case errors.Is(err, promotion.ErrPromotionInactive),
+        errors.Is(err, promotion.ErrPromotionNotStarted),
+        errors.Is(err, promotion.ErrPromotionExpired),
+        errors.Is(err, promotion.ErrUsageLimitReached),
+        errors.Is(err, promotion.ErrNotApplicable):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, customer.ErrCustomerNotFound):
+        return status.Error(codes.NotFound, "customer not found")
+    case errors.Is(err, customer.ErrCustomerInactive):
+        return status.Error(codes.FailedPrecondition, "customer is not active")
+    case errors.Is(err, customer.ErrInsufficientPoints):
+        return status.Error(codes.FailedPrecondition, "insufficient loyalty points")
+    case errors.Is(err, customer.ErrInvalidCustomerType):
+        return status.Error(codes.InvalidArgument, "invalid customer type")
+    case errors.Is(err, payment.ErrPaymentNotFound):
+        return status.Error(codes.NotFound, "payment not found")
+    case errors.Is(err, payment.ErrPaymentDeclined):
+        return status.Error(codes.FailedPrecondition, "payment declined")
+    case errors.Is(err, payment.ErrPaymentNotCaptured):
+        return status.Error(codes.FailedPrecondition, "payment has not been captured yet, try again shortly")
+    case errors.Is(err, payment.ErrInvalidPaymentTransition),
+        errors.Is(err, payment.ErrRefundExceedsCaptured):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    case errors.Is(err, purchasing.ErrSupplierNotFound):
+        return status.Error(codes.NotFound, "supplier not found")
+    case errors.Is(err, purchasing.ErrPurchaseOrderNotFound):
+        return status.Error(codes.NotFound, "purchase order not found")
+    case errors.Is(err, purchasing.ErrLineNotFound):
+        return status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, purchasing.ErrSupplierInactive):
+        return status.Error(codes.FailedPrecondition, "supplier is not active")
+    case errors.Is(err, purchasing.ErrInvalidStatusTransition),
+        errors.Is(err, purchasing.ErrPurchaseOrderNotEditable),
+        errors.Is(err, purchasing.ErrEmptyPurchaseOrder),
+        errors.Is(err, purchasing.ErrReceiptExceedsOrdered):
+        return status.Error(codes.FailedPrecondition, err.Error())
+    default:
+        return status.Error(codes.Internal, err.Error())
+    }
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/order/commands"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/order/queries"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/kitchen/v1"
+)
+
+// KitchenService implements the gRPC KitchenService
+type KitchenService struct {
+    pb.UnimplementedKitchenServiceServer
+    
+    // Command handlers
+    bumpOrderHandler   *commands.BumpOrderHandler
+    recallOrderHandler *commands.RecallOrderHandler
+    
+    // Query handlers
+    getQueueHandler   *queries.GetKitchenQueueHandler
+    watchQueueHandler *queries.WatchKitchenQueueHandler
+}
+
+// NewKitchenService creates a new kitchen service
+func NewKitchenService(
+    bumpOrder *commands.BumpOrderHandler,
+    recallOrder *commands.RecallOrderHandler,
+    getQueue *queries.GetKitchenQueueHandler,
+    watchQueue *queries.WatchKitchenQueueHandler,
+) *KitchenService {
+    return &KitchenService{
+        bumpOrderHandler:   bumpOrder,
+        recallOrderHandler: recallOrder,
+        getQueueHandler:    getQueue,
+        watchQueueHandler:  watchQueue,
+    }
+}
+
+// BumpOrder moves a ticket on, starting a confirmed order or sending out a preparing one
+func (s *KitchenService) BumpOrder(
+    ctx context.Context,
+    req *pb.BumpOrderRequest,
+) (*pb.BumpOrderResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Create command
+    cmd := commands.BumpOrderCommand{
+        StoreID: req.StoreId,
+        OrderID: req.OrderId,
+        Actor:   req.Actor,
+    }
+    
+    // Execute command
+    newStatus, err := s.bumpOrderHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.BumpOrderResponse{
+        OrderId: req.OrderId,
+        Status:  string(newStatus),
+    }, nil
+}
+
+// RecallOrder sends a ready order back onto the queue to be made again
+func (s *KitchenService) RecallOrder(
+    ctx context.Context,
+    req *pb.RecallOrderRequest,
+) (*pb.RecallOrderResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Create command
+    cmd := commands.RecallOrderCommand{
+        StoreID: req.StoreId,
+        OrderID: req.OrderId,
+        Actor:   req.Actor,
+        Reason:  req.Reason,
+    }
+    
+    // Execute command
+    err := s.recallOrderHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.RecallOrderResponse{
+        Success: true,
+    }, nil
+}
+
+// GetQueue returns a store's kitchen queue in display order
+func (s *KitchenService) GetQueue(
+    ctx context.Context,
+    req *pb.GetQueueRequest,
+) (*pb.GetQueueResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Create query
+    query := queries.GetKitchenQueueQuery{
+        StoreID: req.StoreId,
+    }
+    
+    // Execute query
+    tickets, err := s.getQueueHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.GetQueueResponse{
+        Tickets: toTicketsPb(tickets),
+    }, nil
+}
+
+// WatchQueue streams a store's kitchen queue, a snapshot first and then each change
+// WHY: The display stays current without polling
+func (s *KitchenService) WatchQueue(
+    req *pb.WatchQueueRequest,
+    stream pb.KitchenService_WatchQueueServer,
+) error {
+    // Validate request
+    if req.StoreId == "" {
+        return status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Create query
+    query := queries.WatchKitchenQueueQuery{
+        StoreID: req.StoreId,
+    }
+    
+    // Execute query, pushing each update onto the stream
+    err := s.watchQueueHandler.Handle(stream.Context(), query, func(update dtos.KitchenQueueUpdateDTO) error {
+        return stream.Send(&pb.QueueUpdate{
+            StoreId:  update.StoreID,
+            Snapshot: update.Snapshot,
+            Upserted: toTicketsPb(update.Upserted),
+            Removed:  update.Removed,
+            OrderIds: update.OrderIDs,
+        })
+    })
+    if err != nil {
+        if stream.Context().Err() != nil {
+            // Display went away, nothing left to report
+            return status.FromContextError(stream.Context().Err()).Err()
+        }
+        return toGRPCError(err)
+    }
+    
+    return nil
+}
+
+// Helper functions to convert DTOs to protobuf
+
+// toTicketsPb converts kitchen tickets to protobuf messages
+func toTicketsPb(tickets []dtos.KitchenTicketDTO) []*pb.Ticket {
+    pbTickets := make([]*pb.Ticket, len(tickets))
+    for i, ticket := range tickets {
+        items := make([]*pb.TicketItem, len(ticket.Items))
+        for j, item := range ticket.Items {
+            items[j] = &pb.TicketItem{
+                Name:      item.Name,
+                Quantity:  int32(item.Quantity),
+                Modifiers: item.Modifiers,
+            }
+        }
+        
+        pbTickets[i] = &pb.Ticket{
+            OrderId:         ticket.OrderID,
+            StoreId:         ticket.StoreID,
+            Status:          ticket.Status,
+            Priority:        ticket.Priority,
+            FulfillmentType: ticket.Fulfillment,
+            Items:           items,
+            PickupAt:        optionalTimestamp(ticket.PickupAt),
+            QueuedAt:        timestamppb.New(ticket.QueuedAt),
+            UpdatedAt:       timestamppb.New(ticket.UpdatedAt),
+        }
+    }
+    return pbTickets
+}
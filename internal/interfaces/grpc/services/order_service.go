@@ -2,13 +2,16 @@ package services
 
 import (
 	"context"
+	"time"
 
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/order/commands"
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/order/queries"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	pb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/order/v1"
+	storepb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/store/v1"
 )
 
 // OrderService implements the gRPC OrderService
@@ -16,26 +19,56 @@ type OrderService struct {
     pb.UnimplementedOrderServiceServer
     
     // Command handlers
-    createOrderHandler *commands.CreateOrderHandler
-    cancelOrderHandler *commands.CancelOrderHandler
+    createOrderHandler    *commands.CreateOrderHandler
+    cancelOrderHandler    *commands.CancelOrderHandler
+    startPreparingHandler *commands.StartPreparingOrderHandler
+    markReadyHandler      *commands.MarkOrderReadyHandler
+    completeOrderHandler  *commands.CompleteOrderHandler
+    refundOrderHandler    *commands.RefundOrderHandler
+    assignCourierHandler  *commands.AssignCourierHandler
+    dispatchOrderHandler  *commands.DispatchOrderHandler
+    markDeliveredHandler  *commands.MarkOrderDeliveredHandler
     
     // Query handlers
-    getOrderHandler    *queries.GetOrderHandler
-    listOrdersHandler  *queries.ListOrdersHandler
+    getOrderHandler     *queries.GetOrderHandler
+    getTimelineHandler  *queries.GetOrderTimelineHandler
+    listOrdersHandler   *queries.ListOrdersHandler
+    listByStatusHandler *queries.ListOrdersByStatusHandler
+    trackOrderHandler   *queries.TrackOrderHandler
 }
 
 // NewOrderService creates a new order service
 func NewOrderService(
     createOrder *commands.CreateOrderHandler,
     cancelOrder *commands.CancelOrderHandler,
+    startPreparing *commands.StartPreparingOrderHandler,
+    markReady *commands.MarkOrderReadyHandler,
+    completeOrder *commands.CompleteOrderHandler,
+    refundOrder *commands.RefundOrderHandler,
+    assignCourier *commands.AssignCourierHandler,
+    dispatchOrder *commands.DispatchOrderHandler,
+    markDelivered *commands.MarkOrderDeliveredHandler,
     getOrder *queries.GetOrderHandler,
+    getTimeline *queries.GetOrderTimelineHandler,
     listOrders *queries.ListOrdersHandler,
+    listByStatus *queries.ListOrdersByStatusHandler,
+    trackOrder *queries.TrackOrderHandler,
 ) *OrderService {
     return &OrderService{
-        createOrderHandler: createOrder,
-        cancelOrderHandler: cancelOrder,
-        getOrderHandler:    getOrder,
-        listOrdersHandler:  listOrders,
+        createOrderHandler:    createOrder,
+        cancelOrderHandler:    cancelOrder,
+        startPreparingHandler: startPreparing,
+        markReadyHandler:      markReady,
+        completeOrderHandler:  completeOrder,
+        refundOrderHandler:    refundOrder,
+        assignCourierHandler:  assignCourier,
+        dispatchOrderHandler:  dispatchOrder,
+        markDeliveredHandler:  markDelivered,
+        getOrderHandler:       getOrder,
+        getTimelineHandler:    getTimeline,
+        listOrdersHandler:     listOrders,
+        listByStatusHandler:   listByStatus,
+        trackOrderHandler:     trackOrder,
     }
 }
 
@@ -54,6 +87,10 @@ func (s *OrderService) CreateOrder(
         return nil, status.Error(codes.InvalidArgument, "order must have at least one item")
     }
     
+    if req.PointsToRedeem < 0 {
+        return nil, status.Error(codes.InvalidArgument, "points_to_redeem cannot be negative")
+    }
+    
     // Convert items
     items := make([]commands.OrderItemRequest, len(req.Items))
     for i, item := range req.Items {
@@ -61,16 +98,32 @@ func (s *OrderService) CreateOrder(
             return nil, status.Error(codes.InvalidArgument, "item quantity must be positive")
         }
         items[i] = commands.OrderItemRequest{
-            ProductID: item.ProductId,
-            Quantity:  int(item.Quantity),
+            ProductID:         item.ProductId,
+            Quantity:          int(item.Quantity),
+            ModifierOptionIDs: item.ModifierOptionIds,
         }
     }
     
     // Create command
     cmd := commands.CreateOrderCommand{
-        CustomerID: req.CustomerId,
-        StoreID:    req.StoreId,
-        Items:      items,
+        CustomerID:      req.CustomerId,
+        StoreID:         req.StoreId,
+        Items:           items,
+        PointsToRedeem:  int(req.PointsToRedeem),
+        PromoCode:       req.PromoCode,
+        FulfillmentType: req.FulfillmentType,
+    }
+    if req.PickupAt != nil {
+        cmd.PickupAt = req.PickupAt.AsTime()
+    }
+    if req.DeliveryAddress != nil {
+        cmd.DeliveryAddress = &dtos.AddressDTO{
+            Street:  req.DeliveryAddress.Street,
+            City:    req.DeliveryAddress.City,
+            State:   req.DeliveryAddress.State,
+            ZipCode: req.DeliveryAddress.ZipCode,
+            Country: req.DeliveryAddress.Country,
+        }
     }
     
     // Execute command
@@ -80,9 +133,20 @@ func (s *OrderService) CreateOrder(
     }
     
     return &pb.CreateOrderResponse{
-        OrderId:     orderDTO.ID,
-        TotalAmount: orderDTO.TotalAmount,
-        Currency:    orderDTO.Currency,
+        OrderId:          orderDTO.ID,
+        TotalAmount:      orderDTO.TotalAmount,
+        Currency:         orderDTO.Currency,
+        Subtotal:         orderDTO.Subtotal,
+        DiscountAmount:   orderDTO.DiscountAmount,
+        PointsRedeemed:   int32(orderDTO.PointsRedeemed),
+        PointsCredit:     orderDTO.PointsCredit,
+        PromoCode:        orderDTO.PromoCode,
+        TaxAmount:        orderDTO.TaxAmount,
+        Status:           orderDTO.Status,
+        PickupAt:         optionalTimestamp(orderDTO.PickupAt),
+        FulfillmentType:  orderDTO.FulfillmentType,
+        DeliveryFee:      orderDTO.DeliveryFee,
+        EstimatedReadyAt: optionalTimestamp(orderDTO.EstimatedReady),
     }, nil
 }
 
@@ -95,6 +159,7 @@ func (s *OrderService) CancelOrder(
     cmd := commands.CancelOrderCommand{
         OrderID: req.OrderId,
         Reason:  req.Reason,
+        Actor:   req.Actor,
     }
     
     // Execute command
@@ -108,6 +173,204 @@ func (s *OrderService) CancelOrder(
     }, nil
 }
 
+// StartPreparingOrder moves a confirmed order into preparation
+// WHY: Kitchen staff signal that they have started making the order
+func (s *OrderService) StartPreparingOrder(
+    ctx context.Context,
+    req *pb.StartPreparingOrderRequest,
+) (*pb.StartPreparingOrderResponse, error) {
+    // Validate request
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Create command
+    cmd := commands.StartPreparingOrderCommand{
+        OrderID: req.OrderId,
+        Actor:   req.Actor,
+    }
+    
+    // Execute command
+    err := s.startPreparingHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.StartPreparingOrderResponse{
+        Success: true,
+    }, nil
+}
+
+// MarkOrderReady marks an order ready for pickup
+func (s *OrderService) MarkOrderReady(
+    ctx context.Context,
+    req *pb.MarkOrderReadyRequest,
+) (*pb.MarkOrderReadyResponse, error) {
+    // Validate request
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Create command
+    cmd := commands.MarkOrderReadyCommand{
+        OrderID: req.OrderId,
+        Actor:   req.Actor,
+    }
+    
+    // Execute command
+    err := s.markReadyHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.MarkOrderReadyResponse{
+        Success: true,
+    }, nil
+}
+
+// CompleteOrder marks an order as picked up
+func (s *OrderService) CompleteOrder(
+    ctx context.Context,
+    req *pb.CompleteOrderRequest,
+) (*pb.CompleteOrderResponse, error) {
+    // Validate request
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Create command
+    cmd := commands.CompleteOrderCommand{
+        OrderID: req.OrderId,
+        Actor:   req.Actor,
+    }
+    
+    // Execute command
+    err := s.completeOrderHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CompleteOrderResponse{
+        Success: true,
+    }, nil
+}
+
+// AssignCourier gives a delivery order to a courier
+func (s *OrderService) AssignCourier(
+    ctx context.Context,
+    req *pb.AssignCourierRequest,
+) (*pb.AssignCourierResponse, error) {
+    // Validate request
+    if req.OrderId == "" || req.CourierId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id and courier_id are required")
+    }
+    
+    // Execute command
+    err := s.assignCourierHandler.Handle(ctx, commands.AssignCourierCommand{
+        OrderID:   req.OrderId,
+        CourierID: req.CourierId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.AssignCourierResponse{Success: true}, nil
+}
+
+// DispatchOrder sends a ready delivery order out with its courier
+func (s *OrderService) DispatchOrder(
+    ctx context.Context,
+    req *pb.DispatchOrderRequest,
+) (*pb.DispatchOrderResponse, error) {
+    // Validate request
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Execute command
+    err := s.dispatchOrderHandler.Handle(ctx, commands.DispatchOrderCommand{
+        OrderID: req.OrderId,
+        Actor:   req.Actor,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.DispatchOrderResponse{Success: true}, nil
+}
+
+// MarkOrderDelivered records that a delivery order reached the customer
+func (s *OrderService) MarkOrderDelivered(
+    ctx context.Context,
+    req *pb.MarkOrderDeliveredRequest,
+) (*pb.MarkOrderDeliveredResponse, error) {
+    // Validate request
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Execute command
+    err := s.markDeliveredHandler.Handle(ctx, commands.MarkOrderDeliveredCommand{
+        OrderID: req.OrderId,
+        Actor:   req.Actor,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.MarkOrderDeliveredResponse{Success: true}, nil
+}
+
+// RefundOrder gives money back on a completed order
+func (s *OrderService) RefundOrder(
+    ctx context.Context,
+    req *pb.RefundOrderRequest,
+) (*pb.RefundOrderResponse, error) {
+    // Validate request
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    if len(req.Items) > 0 && req.Amount != 0 {
+        return nil, status.Error(codes.InvalidArgument, "set either items or amount, not both")
+    }
+    
+    if len(req.Items) == 0 && req.Amount <= 0 {
+        return nil, status.Error(codes.InvalidArgument, "items or a positive amount are required")
+    }
+    
+    // Convert items
+    items := make([]commands.RefundItemRequest, len(req.Items))
+    for i, item := range req.Items {
+        if item.Quantity <= 0 {
+            return nil, status.Error(codes.InvalidArgument, "item quantity must be positive")
+        }
+        items[i] = commands.RefundItemRequest{
+            ItemID:   item.ItemId,
+            Quantity: int(item.Quantity),
+        }
+    }
+    
+    // Create command
+    cmd := commands.RefundOrderCommand{
+        OrderID: req.OrderId,
+        Items:   items,
+        Amount:  req.Amount,
+        Reason:  req.Reason,
+        Restock: req.Restock,
+    }
+    
+    // Execute command
+    refundDTO, err := s.refundOrderHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.RefundOrderResponse{
+        Refund: toRefundPb(*refundDTO),
+    }, nil
+}
+
 // GetOrder retrieves order details
 func (s *OrderService) GetOrder(
     ctx context.Context,
@@ -125,29 +388,51 @@ func (s *OrderService) GetOrder(
     }
     
     // Convert to protobuf
-    items := make([]*pb.OrderItemDetail, len(orderDTO.Items))
-    for i, item := range orderDTO.Items {
-        items[i] = &pb.OrderItemDetail{
-            Id:        item.ID,
-            ProductId: item.ProductID,
-            Name:      item.Name,
-            Quantity:  int32(item.Quantity),
-            UnitPrice: item.UnitPrice,
-            Total:     item.Total,
+    return &pb.GetOrderResponse{
+        Order: toOrderPb(orderDTO),
+    }, nil
+}
+
+// GetOrderTimeline returns an order's status history and time spent per stage
+// WHY: Support needs to see where a slow order got stuck
+func (s *OrderService) GetOrderTimeline(
+    ctx context.Context,
+    req *pb.GetOrderTimelineRequest,
+) (*pb.GetOrderTimelineResponse, error) {
+    // Validate request
+    if req.OrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Execute query
+    timelineDTO, err := s.getTimelineHandler.Handle(ctx, queries.GetOrderTimelineQuery{
+        OrderID: req.OrderId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    stages := make([]*pb.StageTiming, len(timelineDTO.Stages))
+    for i, stage := range timelineDTO.Stages {
+        stages[i] = &pb.StageTiming{
+            Status:          stage.Status,
+            EnteredAt:       timestamppb.New(stage.EnteredAt),
+            DurationSeconds: stage.Duration.Seconds(),
+            Current:         stage.Current,
+        }
+        if !stage.LeftAt.IsZero() {
+            stages[i].LeftAt = timestamppb.New(stage.LeftAt)
         }
     }
     
-    return &pb.GetOrderResponse{
-        Order: &pb.Order{
-            Id:          orderDTO.ID,
-            CustomerId:  orderDTO.CustomerID,
-            StoreId:     orderDTO.StoreID,
-            Status:      orderDTO.Status,
-            TotalAmount: orderDTO.TotalAmount,
-            Currency:    orderDTO.Currency,
-            Items:       items,
-            PlacedAt:    timestamppb.New(orderDTO.PlacedAt),
-        },
+    return &pb.GetOrderTimelineResponse{
+        OrderId:        timelineDTO.OrderID,
+        Status:         timelineDTO.Status,
+        PlacedAt:       timestamppb.New(timelineDTO.PlacedAt),
+        Transitions:    toStatusChangesPb(timelineDTO.Transitions),
+        Stages:         stages,
+        ElapsedSeconds: timelineDTO.Elapsed.Seconds(),
     }, nil
 }
 
@@ -170,31 +455,214 @@ func (s *OrderService) ListCustomerOrders(
     // Convert to protobuf
     pbOrders := make([]*pb.Order, len(orders))
     for i, order := range orders {
-        items := make([]*pb.OrderItemDetail, len(order.Items))
-        for j, item := range order.Items {
-            items[j] = &pb.OrderItemDetail{
-                Id:        item.ID,
-                ProductId: item.ProductID,
-                Name:      item.Name,
-                Quantity:  int32(item.Quantity),
-                UnitPrice: item.UnitPrice,
-                Total:     item.Total,
+        pbOrders[i] = toOrderPb(order)
+    }
+    
+    return &pb.ListCustomerOrdersResponse{
+        Orders: pbOrders,
+    }, nil
+}
+
+// ListOrdersByStatus lists orders in one status, optionally for one store
+// WHERE: Stand staff list SCHEDULED orders to see upcoming pre-orders
+func (s *OrderService) ListOrdersByStatus(
+    ctx context.Context,
+    req *pb.ListOrdersByStatusRequest,
+) (*pb.ListOrdersByStatusResponse, error) {
+    // Validate request
+    if req.Status == "" {
+        return nil, status.Error(codes.InvalidArgument, "status is required")
+    }
+    
+    // Execute query
+    orders, err := s.listByStatusHandler.Handle(ctx, queries.ListOrdersByStatusQuery{
+        Status:  req.Status,
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    pbOrders := make([]*pb.Order, len(orders))
+    for i, order := range orders {
+        pbOrders[i] = toOrderPb(order)
+    }
+    
+    return &pb.ListOrdersByStatusResponse{
+        Orders: pbOrders,
+    }, nil
+}
+
+// TrackOrder streams status updates for an order
+// WHY: Lets customers' phones follow an order without polling
+func (s *OrderService) TrackOrder(
+    req *pb.TrackOrderRequest,
+    stream pb.OrderService_TrackOrderServer,
+) error {
+    // Validate request
+    if req.OrderId == "" {
+        return status.Error(codes.InvalidArgument, "order_id is required")
+    }
+    
+    // Create query
+    query := queries.TrackOrderQuery{
+        OrderID: req.OrderId,
+    }
+    
+    // Execute query, pushing each update onto the stream
+    err := s.trackOrderHandler.Handle(stream.Context(), query, func(update dtos.OrderUpdateDTO) error {
+        return stream.Send(&pb.OrderUpdate{
+            OrderId:          update.OrderID,
+            Status:           update.Status,
+            UpdatedAt:        timestamppb.New(update.UpdatedAt),
+            EstimatedReadyAt: optionalTimestamp(update.EstimatedReady),
+        })
+    })
+    if err != nil {
+        if stream.Context().Err() != nil {
+            // Client went away, nothing left to report
+            return status.FromContextError(stream.Context().Err()).Err()
+        }
+        return toGRPCError(err)
+    }
+    
+    return nil
+}
+
+// toOrderPb converts an order DTO to its protobuf message
+func toOrderPb(orderDTO *dtos.OrderDTO) *pb.Order {
+    items := make([]*pb.OrderItemDetail, len(orderDTO.Items))
+    for i, item := range orderDTO.Items {
+        modifiers := make([]*pb.OrderItemModifier, len(item.Modifiers))
+        for j, modifier := range item.Modifiers {
+            modifiers[j] = &pb.OrderItemModifier{
+                GroupName:  modifier.GroupName,
+                OptionId:   modifier.OptionID,
+                OptionName: modifier.OptionName,
+                PriceDelta: modifier.PriceDelta,
             }
         }
         
-        pbOrders[i] = &pb.Order{
-            Id:          order.ID,
-            CustomerId:  order.CustomerID,
-            StoreId:     order.StoreID,
-            Status:      order.Status,
-            TotalAmount: order.TotalAmount,
-            Currency:    order.Currency,
-            Items:       items,
-            PlacedAt:    timestamppb.New(order.PlacedAt),
+        items[i] = &pb.OrderItemDetail{
+            Id:        item.ID,
+            ProductId: item.ProductID,
+            Name:      item.Name,
+            Quantity:  int32(item.Quantity),
+            UnitPrice: item.UnitPrice,
+            Total:     item.Total,
+            TaxExempt: item.TaxExempt,
+            Modifiers: modifiers,
         }
     }
     
-    return &pb.ListCustomerOrdersResponse{
-        Orders: pbOrders,
-    }, nil
+    discounts := make([]*pb.Discount, len(orderDTO.Discounts))
+    for i, discount := range orderDTO.Discounts {
+        discounts[i] = &pb.Discount{
+            Type:        discount.Type,
+            Description: discount.Description,
+            Amount:      discount.Amount,
+        }
+    }
+    
+    taxLines := make([]*pb.TaxLine, len(orderDTO.TaxLines))
+    for i, line := range orderDTO.TaxLines {
+        taxLines[i] = &pb.TaxLine{
+            Name:          line.Name,
+            Rate:          line.Rate,
+            TaxableAmount: line.TaxableAmount,
+            Amount:        line.Amount,
+        }
+    }
+    
+    refunds := make([]*pb.Refund, len(orderDTO.Refunds))
+    for i, refund := range orderDTO.Refunds {
+        refunds[i] = toRefundPb(refund)
+    }
+    
+    var deliveryAddress *storepb.Address
+    if orderDTO.DeliveryAddress != nil {
+        deliveryAddress = &storepb.Address{
+            Street:  orderDTO.DeliveryAddress.Street,
+            City:    orderDTO.DeliveryAddress.City,
+            State:   orderDTO.DeliveryAddress.State,
+            ZipCode: orderDTO.DeliveryAddress.ZipCode,
+            Country: orderDTO.DeliveryAddress.Country,
+        }
+    }
+    
+    return &pb.Order{
+        Id:               orderDTO.ID,
+        CustomerId:       orderDTO.CustomerID,
+        StoreId:          orderDTO.StoreID,
+        Status:           orderDTO.Status,
+        Subtotal:         orderDTO.Subtotal,
+        DiscountAmount:   orderDTO.DiscountAmount,
+        Discounts:        discounts,
+        PromoCode:        orderDTO.PromoCode,
+        TaxAmount:        orderDTO.TaxAmount,
+        TaxLines:         taxLines,
+        PointsRedeemed:   int32(orderDTO.PointsRedeemed),
+        PointsCredit:     orderDTO.PointsCredit,
+        TotalAmount:      orderDTO.TotalAmount,
+        RefundedAmount:   orderDTO.RefundedAmount,
+        Refunds:          refunds,
+        Currency:         orderDTO.Currency,
+        Items:            items,
+        PlacedAt:         timestamppb.New(orderDTO.PlacedAt),
+        StatusHistory:    toStatusChangesPb(orderDTO.StatusHistory),
+        PickupAt:         optionalTimestamp(orderDTO.PickupAt),
+        ReleaseAt:        optionalTimestamp(orderDTO.ReleaseAt),
+        FulfillmentType:  orderDTO.FulfillmentType,
+        DeliveryAddress:  deliveryAddress,
+        DeliveryFee:      orderDTO.DeliveryFee,
+        CourierId:        orderDTO.CourierID,
+        EstimatedReadyAt: optionalTimestamp(orderDTO.EstimatedReady),
+    }
+}
+
+// toStatusChangesPb converts an order's status history to protobuf messages
+func toStatusChangesPb(changeDTOs []dtos.StatusChangeDTO) []*pb.StatusChange {
+    changes := make([]*pb.StatusChange, len(changeDTOs))
+    for i, change := range changeDTOs {
+        changes[i] = &pb.StatusChange{
+            From:   change.From,
+            To:     change.To,
+            At:     timestamppb.New(change.At),
+            Actor:  change.Actor,
+            Reason: change.Reason,
+        }
+    }
+    return changes
+}
+
+// toRefundPb converts a refund DTO to its protobuf message
+func toRefundPb(refundDTO dtos.RefundDTO) *pb.Refund {
+    lines := make([]*pb.RefundLine, len(refundDTO.Lines))
+    for i, line := range refundDTO.Lines {
+        lines[i] = &pb.RefundLine{
+            ItemId:    line.ItemID,
+            ProductId: line.ProductID,
+            Quantity:  int32(line.Quantity),
+            Amount:    line.Amount,
+        }
+    }
+    
+    return &pb.Refund{
+        Id:         refundDTO.ID,
+        Amount:     refundDTO.Amount,
+        Currency:   refundDTO.Currency,
+        Points:     int32(refundDTO.Points),
+        Reason:     refundDTO.Reason,
+        Lines:      lines,
+        RefundedAt: timestamppb.New(refundDTO.RefundedAt),
+    }
+}
+
+// optionalTimestamp converts a time that may be unset, leaving the field empty for zero
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+    if t.IsZero() {
+        return nil
+    }
+    return timestamppb.New(t)
 }
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/promotion/commands"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/promotion/queries"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/promotion/v1"
+)
+
+// PromotionService implements the gRPC PromotionService
+// WHY: Lets back-office staff run promotions without a deploy
+type PromotionService struct {
+    pb.UnimplementedPromotionServiceServer
+    
+    // Command handlers
+    createPromotionHandler  *commands.CreatePromotionHandler
+    disablePromotionHandler *commands.DisablePromotionHandler
+    
+    // Query handlers
+    listPromotionsHandler *queries.ListPromotionsHandler
+}
+
+// NewPromotionService creates a new promotion service
+func NewPromotionService(
+    createPromotion *commands.CreatePromotionHandler,
+    disablePromotion *commands.DisablePromotionHandler,
+    listPromotions *queries.ListPromotionsHandler,
+) *PromotionService {
+    return &PromotionService{
+        createPromotionHandler:  createPromotion,
+        disablePromotionHandler: disablePromotion,
+        listPromotionsHandler:   listPromotions,
+    }
+}
+
+// CreatePromotion sets up a new promo code
+func (s *PromotionService) CreatePromotion(
+    ctx context.Context,
+    req *pb.CreatePromotionRequest,
+) (*pb.CreatePromotionResponse, error) {
+    // Validate request
+    if req.Code == "" || req.RewardType == "" {
+        return nil, status.Error(codes.InvalidArgument, "code and reward_type are required")
+    }
+    
+    if req.ValidFrom == nil || req.ValidUntil == nil {
+        return nil, status.Error(codes.InvalidArgument, "valid_from and valid_until are required")
+    }
+    
+    // Create command
+    cmd := commands.CreatePromotionCommand{
+        Code:               req.Code,
+        Description:        req.Description,
+        RewardType:         req.RewardType,
+        PercentOff:         req.PercentOff,
+        AmountOff:          req.AmountOff,
+        Currency:           req.Currency,
+        ProductID:          req.ProductId,
+        BuyQuantity:        int(req.BuyQuantity),
+        FreeQuantity:       int(req.FreeQuantity),
+        MinimumSpend:       req.MinimumSpend,
+        ValidFrom:          req.ValidFrom.AsTime(),
+        ValidUntil:         req.ValidUntil.AsTime(),
+        MaxUses:            int(req.MaxUses),
+        MaxUsesPerCustomer: int(req.MaxUsesPerCustomer),
+    }
+    
+    // Execute command
+    promotionDTO, err := s.createPromotionHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CreatePromotionResponse{
+        PromotionId: promotionDTO.ID,
+        Code:        promotionDTO.Code,
+    }, nil
+}
+
+// DisablePromotion switches off a promo code
+func (s *PromotionService) DisablePromotion(
+    ctx context.Context,
+    req *pb.DisablePromotionRequest,
+) (*pb.DisablePromotionResponse, error) {
+    // Validate request
+    if req.Code == "" {
+        return nil, status.Error(codes.InvalidArgument, "code is required")
+    }
+    
+    // Create command
+    cmd := commands.DisablePromotionCommand{
+        Code: req.Code,
+    }
+    
+    // Execute command
+    err := s.disablePromotionHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.DisablePromotionResponse{
+        Success: true,
+    }, nil
+}
+
+// ListPromotions lists promo codes
+func (s *PromotionService) ListPromotions(
+    ctx context.Context,
+    req *pb.ListPromotionsRequest,
+) (*pb.ListPromotionsResponse, error) {
+    // Create query
+    query := queries.ListPromotionsQuery{
+        ActiveOnly: req.ActiveOnly,
+    }
+    
+    // Execute query
+    promotionDTOs, err := s.listPromotionsHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    promotions := make([]*pb.Promotion, len(promotionDTOs))
+    for i, promotionDTO := range promotionDTOs {
+        promotions[i] = &pb.Promotion{
+            Id:                 promotionDTO.ID,
+            Code:               promotionDTO.Code,
+            Description:        promotionDTO.Description,
+            RewardType:         promotionDTO.RewardType,
+            PercentOff:         promotionDTO.PercentOff,
+            AmountOff:          promotionDTO.AmountOff,
+            Currency:           promotionDTO.Currency,
+            ProductId:          promotionDTO.ProductID,
+            BuyQuantity:        int32(promotionDTO.BuyQuantity),
+            FreeQuantity:       int32(promotionDTO.FreeQuantity),
+            MinimumSpend:       promotionDTO.MinimumSpend,
+            ValidFrom:          timestamppb.New(promotionDTO.ValidFrom),
+            ValidUntil:         timestamppb.New(promotionDTO.ValidUntil),
+            MaxUses:            int32(promotionDTO.MaxUses),
+            MaxUsesPerCustomer: int32(promotionDTO.MaxUsesPerCustomer),
+            TotalUses:          int32(promotionDTO.TotalUses),
+            IsActive:           promotionDTO.IsActive,
+        }
+    }
+    
+    return &pb.ListPromotionsResponse{
+        Promotions: promotions,
+    }, nil
+}
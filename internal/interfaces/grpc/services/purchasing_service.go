@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/purchasing/commands"
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/purchasing/queries"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/purchasing/v1"
+)
+
+// PurchasingService implements the gRPC PurchasingService
+// WHY: Lets the ops team order stock from suppliers and book deliveries in
+type PurchasingService struct {
+    pb.UnimplementedPurchasingServiceServer
+    
+    // Command handlers
+    registerSupplierHandler    *commands.RegisterSupplierHandler
+    createPurchaseOrderHandler *commands.CreatePurchaseOrderHandler
+    submitPurchaseOrderHandler *commands.SubmitPurchaseOrderHandler
+    receiveGoodsHandler        *commands.ReceiveGoodsHandler
+    cancelPurchaseOrderHandler *commands.CancelPurchaseOrderHandler
+    
+    // Query handlers
+    listSuppliersHandler      *queries.ListSuppliersHandler
+    getPurchaseOrderHandler   *queries.GetPurchaseOrderHandler
+    listPurchaseOrdersHandler *queries.ListPurchaseOrdersHandler
+}
+
+// NewPurchasingService creates a new purchasing service
+func NewPurchasingService(
+    registerSupplier *commands.RegisterSupplierHandler,
+    createPurchaseOrder *commands.CreatePurchaseOrderHandler,
+    submitPurchaseOrder *commands.SubmitPurchaseOrderHandler,
+    receiveGoods *commands.ReceiveGoodsHandler,
+    cancelPurchaseOrder *commands.CancelPurchaseOrderHandler,
+    listSuppliers *queries.ListSuppliersHandler,
+    getPurchaseOrder *queries.GetPurchaseOrderHandler,
+    listPurchaseOrders *queries.ListPurchaseOrdersHandler,
+) *PurchasingService {
+    return &PurchasingService{
+        registerSupplierHandler:    registerSupplier,
+        createPurchaseOrderHandler: createPurchaseOrder,
+        submitPurchaseOrderHandler: submitPurchaseOrder,
+        receiveGoodsHandler:        receiveGoods,
+        cancelPurchaseOrderHandler: cancelPurchaseOrder,
+        listSuppliersHandler:       listSuppliers,
+        getPurchaseOrderHandler:    getPurchaseOrder,
+        listPurchaseOrdersHandler:  listPurchaseOrders,
+    }
+}
+
+// RegisterSupplier onboards a supplier
+func (s *PurchasingService) RegisterSupplier(
+    ctx context.Context,
+    req *pb.RegisterSupplierRequest,
+) (*pb.RegisterSupplierResponse, error) {
+    // Validate request
+    if req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "name is required")
+    }
+    
+    // Create command
+    cmd := commands.RegisterSupplierCommand{
+        Name:         req.Name,
+        ContactEmail: req.ContactEmail,
+        Phone:        req.Phone,
+    }
+    
+    // Execute command
+    supplierDTO, err := s.registerSupplierHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.RegisterSupplierResponse{
+        Supplier: toSupplierPb(supplierDTO),
+    }, nil
+}
+
+// CreatePurchaseOrder drafts a purchase order
+func (s *PurchasingService) CreatePurchaseOrder(
+    ctx context.Context,
+    req *pb.CreatePurchaseOrderRequest,
+) (*pb.CreatePurchaseOrderResponse, error) {
+    // Validate request
+    if req.SupplierId == "" || req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "supplier_id and store_id are required")
+    }
+    
+    if req.Currency == "" {
+        return nil, status.Error(codes.InvalidArgument, "currency is required")
+    }
+    
+    // Create command
+    cmd := commands.CreatePurchaseOrderCommand{
+        SupplierID: req.SupplierId,
+        StoreID:    req.StoreId,
+        Currency:   req.Currency,
+        Lines:      make([]commands.PurchaseOrderLineInput, len(req.Lines)),
+    }
+    for i, line := range req.Lines {
+        cmd.Lines[i] = commands.PurchaseOrderLineInput{
+            ItemType: line.ItemType,
+            ItemID:   line.ItemId,
+            Quantity: int(line.Quantity),
+            UnitCost: line.UnitCost,
+        }
+    }
+    
+    // Execute command
+    purchaseOrderDTO, err := s.createPurchaseOrderHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CreatePurchaseOrderResponse{
+        PurchaseOrder: toPurchaseOrderPb(purchaseOrderDTO),
+    }, nil
+}
+
+// SubmitPurchaseOrder sends a draft purchase order to its supplier
+func (s *PurchasingService) SubmitPurchaseOrder(
+    ctx context.Context,
+    req *pb.SubmitPurchaseOrderRequest,
+) (*pb.SubmitPurchaseOrderResponse, error) {
+    // Validate request
+    if req.PurchaseOrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "purchase_order_id is required")
+    }
+    
+    // Execute command
+    purchaseOrderDTO, err := s.submitPurchaseOrderHandler.Handle(ctx, commands.SubmitPurchaseOrderCommand{
+        PurchaseOrderID: req.PurchaseOrderId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.SubmitPurchaseOrderResponse{
+        PurchaseOrder: toPurchaseOrderPb(purchaseOrderDTO),
+    }, nil
+}
+
+// ReceiveGoods books a delivery in and adds it to the store's stock
+func (s *PurchasingService) ReceiveGoods(
+    ctx context.Context,
+    req *pb.ReceiveGoodsRequest,
+) (*pb.ReceiveGoodsResponse, error) {
+    // Validate request
+    if req.PurchaseOrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "purchase_order_id is required")
+    }
+    
+    if len(req.Lines) == 0 {
+        return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+    }
+    
+    // Create command
+    cmd := commands.ReceiveGoodsCommand{
+        PurchaseOrderID: req.PurchaseOrderId,
+        Lines:           make([]commands.ReceivedLineInput, len(req.Lines)),
+    }
+    for i, line := range req.Lines {
+        cmd.Lines[i] = commands.ReceivedLineInput{
+            LineID:   line.LineId,
+            Quantity: int(line.Quantity),
+        }
+    }
+    
+    // Execute command
+    purchaseOrderDTO, err := s.receiveGoodsHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.ReceiveGoodsResponse{
+        PurchaseOrder: toPurchaseOrderPb(purchaseOrderDTO),
+    }, nil
+}
+
+// CancelPurchaseOrder closes a purchase order that won't be delivered
+func (s *PurchasingService) CancelPurchaseOrder(
+    ctx context.Context,
+    req *pb.CancelPurchaseOrderRequest,
+) (*pb.CancelPurchaseOrderResponse, error) {
+    // Validate request
+    if req.PurchaseOrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "purchase_order_id is required")
+    }
+    
+    // Execute command
+    purchaseOrderDTO, err := s.cancelPurchaseOrderHandler.Handle(ctx, commands.CancelPurchaseOrderCommand{
+        PurchaseOrderID: req.PurchaseOrderId,
+        Reason:          req.Reason,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CancelPurchaseOrderResponse{
+        PurchaseOrder: toPurchaseOrderPb(purchaseOrderDTO),
+    }, nil
+}
+
+// ListSuppliers lists suppliers
+func (s *PurchasingService) ListSuppliers(
+    ctx context.Context,
+    req *pb.ListSuppliersRequest,
+) (*pb.ListSuppliersResponse, error) {
+    // Execute query
+    supplierDTOs, err := s.listSuppliersHandler.Handle(ctx, queries.ListSuppliersQuery{
+        ActiveOnly: req.ActiveOnly,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    suppliers := make([]*pb.Supplier, len(supplierDTOs))
+    for i, supplierDTO := range supplierDTOs {
+        suppliers[i] = toSupplierPb(supplierDTO)
+    }
+    
+    return &pb.ListSuppliersResponse{
+        Suppliers: suppliers,
+    }, nil
+}
+
+// GetPurchaseOrder retrieves a purchase order
+func (s *PurchasingService) GetPurchaseOrder(
+    ctx context.Context,
+    req *pb.GetPurchaseOrderRequest,
+) (*pb.GetPurchaseOrderResponse, error) {
+    // Validate request
+    if req.PurchaseOrderId == "" {
+        return nil, status.Error(codes.InvalidArgument, "purchase_order_id is required")
+    }
+    
+    // Execute query
+    purchaseOrderDTO, err := s.getPurchaseOrderHandler.Handle(ctx, queries.GetPurchaseOrderQuery{
+        PurchaseOrderID: req.PurchaseOrderId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.GetPurchaseOrderResponse{
+        PurchaseOrder: toPurchaseOrderPb(purchaseOrderDTO),
+    }, nil
+}
+
+// ListPurchaseOrders lists a store's purchase orders
+func (s *PurchasingService) ListPurchaseOrders(
+    ctx context.Context,
+    req *pb.ListPurchaseOrdersRequest,
+) (*pb.ListPurchaseOrdersResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute query
+    purchaseOrderDTOs, err := s.listPurchaseOrdersHandler.Handle(ctx, queries.ListPurchaseOrdersQuery{
+        StoreID: req.StoreId,
+        Status:  req.Status,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    purchaseOrders := make([]*pb.PurchaseOrder, len(purchaseOrderDTOs))
+    for i, purchaseOrderDTO := range purchaseOrderDTOs {
+        purchaseOrders[i] = toPurchaseOrderPb(purchaseOrderDTO)
+    }
+    
+    return &pb.ListPurchaseOrdersResponse{
+        PurchaseOrders: purchaseOrders,
+    }, nil
+}
+
+// Helper functions to convert DTOs to protobuf
+func toSupplierPb(supplierDTO *dtos.SupplierDTO) *pb.Supplier {
+    return &pb.Supplier{
+        Id:           supplierDTO.ID,
+        Name:         supplierDTO.Name,
+        ContactEmail: supplierDTO.ContactEmail,
+        Phone:        supplierDTO.Phone,
+        IsActive:     supplierDTO.IsActive,
+    }
+}
+
+func toPurchaseOrderPb(purchaseOrderDTO *dtos.PurchaseOrderDTO) *pb.PurchaseOrder {
+    lines := make([]*pb.PurchaseOrderLine, len(purchaseOrderDTO.Lines))
+    for i, line := range purchaseOrderDTO.Lines {
+        lines[i] = &pb.PurchaseOrderLine{
+            Id:               line.ID,
+            ItemType:         line.ItemType,
+            ItemId:           line.ItemID,
+            ItemName:         line.ItemName,
+            QuantityOrdered:  int32(line.QuantityOrdered),
+            QuantityReceived: int32(line.QuantityReceived),
+            UnitCost:         line.UnitCost,
+            LineCost:         line.LineCost,
+        }
+    }
+    
+    purchaseOrder := &pb.PurchaseOrder{
+        Id:         purchaseOrderDTO.ID,
+        SupplierId: purchaseOrderDTO.SupplierID,
+        StoreId:    purchaseOrderDTO.StoreID,
+        Status:     purchaseOrderDTO.Status,
+        Lines:      lines,
+        TotalCost:  purchaseOrderDTO.TotalCost,
+        Currency:   purchaseOrderDTO.Currency,
+        CreatedAt:  timestamppb.New(purchaseOrderDTO.CreatedAt),
+    }
+    if !purchaseOrderDTO.SubmittedAt.IsZero() {
+        purchaseOrder.SubmittedAt = timestamppb.New(purchaseOrderDTO.SubmittedAt)
+    }
+    
+    return purchaseOrder
+}
@@ -3,11 +3,12 @@ package services
 import (
 	"context"
 
+	"github.com/matzxrr/ddd-lemonadestore/internal/application/dtos"
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/store/commands"
 	"github.com/matzxrr/ddd-lemonadestore/internal/application/store/queries"
-	"github.com/matzxrr/ddd-lemonadestore/internal/domain/store"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	pb "github.com/matzxrr/ddd-lemonadestore/internal/interfaces/grpc/pb/store/v1"
 )
 
@@ -18,29 +19,209 @@ type StoreService struct {
     pb.UnimplementedStoreServiceServer
     
     // Command handlers
-    addInventoryHandler *commands.AddInventoryHandler
-    updatePriceHandler  *commands.UpdatePriceHandler
+    createStoreHandler       *commands.CreateStoreHandler
+    addProductHandler        *commands.AddProductHandler
+    addInventoryHandler      *commands.AddInventoryHandler
+    updatePriceHandler       *commands.UpdatePriceHandler
+    addModifierHandler       *commands.AddModifierGroupHandler
+    addIngredientHandler     *commands.AddIngredientHandler
+    restockIngredientHandler *commands.RestockIngredientHandler
+    setRecipeHandler         *commands.SetRecipeHandler
+    setReorderPointHandler   *commands.SetReorderPointHandler
+    adjustInventoryHandler   *commands.AdjustInventoryHandler
+    countInventoryHandler    *commands.CountInventoryHandler
+    transferInventoryHandler *commands.TransferInventoryHandler
+    receiveTransferHandler   *commands.ReceiveTransferHandler
+    cancelTransferHandler    *commands.CancelTransferHandler
+    setOpeningHoursHandler   *commands.SetOpeningHoursHandler
+    pauseOrderingHandler     *commands.PauseOrderingHandler
+    resumeOrderingHandler    *commands.ResumeOrderingHandler
+    schedulePriceHandler     *commands.SchedulePriceChangeHandler
+    cancelPriceHandler       *commands.CancelPriceChangeHandler
+    addPriceRuleHandler      *commands.AddPriceRuleHandler
+    removePriceRuleHandler   *commands.RemovePriceRuleHandler
+    addCategoryHandler       *commands.AddCategoryHandler
+    updateCategoryHandler    *commands.UpdateCategoryHandler
+    editProductHandler       *commands.EditProductHandler
+    deactivateProductHandler *commands.DeactivateProductHandler
+    reactivateProductHandler *commands.ReactivateProductHandler
+    setDeliveryBandsHandler  *commands.SetDeliveryBandsHandler
+    setPrepTimesHandler      *commands.SetPrepTimesHandler
     
     // Query handlers
-    getProductHandler   *queries.GetProductHandler
-    getInventoryHandler *queries.GetInventoryHandler
+    getProductHandler      *queries.GetProductHandler
+    listProductsHandler    *queries.ListProductsHandler
+    getInventoryHandler    *queries.GetInventoryHandler
+    listLowStockHandler    *queries.ListLowStockHandler
+    listAdjustmentsHandler *queries.ListInventoryAdjustmentsHandler
+    listTransfersHandler   *queries.ListOpenTransfersHandler
+    getStoreStatusHandler  *queries.GetStoreStatusHandler
+    getPriceHistoryHandler *queries.GetPriceHistoryHandler
+    listPriceRulesHandler  *queries.ListPriceRulesHandler
+    listCategoriesHandler  *queries.ListCategoriesHandler
+    listDeliveryHandler    *queries.ListDeliveryBandsHandler
+    getPrepTimesHandler    *queries.GetPrepTimesHandler
 }
 
 // NewStoreService creates a new store service
 func NewStoreService(
+    createStore *commands.CreateStoreHandler,
+    addProduct *commands.AddProductHandler,
     addInventory *commands.AddInventoryHandler,
     updatePrice *commands.UpdatePriceHandler,
+    addModifierGroup *commands.AddModifierGroupHandler,
+    addIngredient *commands.AddIngredientHandler,
+    restockIngredient *commands.RestockIngredientHandler,
+    setRecipe *commands.SetRecipeHandler,
+    setReorderPoint *commands.SetReorderPointHandler,
+    adjustInventory *commands.AdjustInventoryHandler,
+    countInventory *commands.CountInventoryHandler,
+    transferInventory *commands.TransferInventoryHandler,
+    receiveTransfer *commands.ReceiveTransferHandler,
+    cancelTransfer *commands.CancelTransferHandler,
+    setOpeningHours *commands.SetOpeningHoursHandler,
+    pauseOrdering *commands.PauseOrderingHandler,
+    resumeOrdering *commands.ResumeOrderingHandler,
+    schedulePriceChange *commands.SchedulePriceChangeHandler,
+    cancelPriceChange *commands.CancelPriceChangeHandler,
+    addPriceRule *commands.AddPriceRuleHandler,
+    removePriceRule *commands.RemovePriceRuleHandler,
+    addCategory *commands.AddCategoryHandler,
+    updateCategory *commands.UpdateCategoryHandler,
+    editProduct *commands.EditProductHandler,
+    deactivateProduct *commands.DeactivateProductHandler,
+    reactivateProduct *commands.ReactivateProductHandler,
+    setDeliveryBands *commands.SetDeliveryBandsHandler,
+    setPrepTimes *commands.SetPrepTimesHandler,
     getProduct *queries.GetProductHandler,
+    listProducts *queries.ListProductsHandler,
     getInventory *queries.GetInventoryHandler,
+    listLowStock *queries.ListLowStockHandler,
+    listAdjustments *queries.ListInventoryAdjustmentsHandler,
+    listTransfers *queries.ListOpenTransfersHandler,
+    getStoreStatus *queries.GetStoreStatusHandler,
+    getPriceHistory *queries.GetPriceHistoryHandler,
+    listPriceRules *queries.ListPriceRulesHandler,
+    listCategories *queries.ListCategoriesHandler,
+    listDeliveryBands *queries.ListDeliveryBandsHandler,
+    getPrepTimes *queries.GetPrepTimesHandler,
 ) *StoreService {
     return &StoreService{
-        addInventoryHandler: addInventory,
-        updatePriceHandler:  updatePrice,
-        getProductHandler:   getProduct,
-        getInventoryHandler: getInventory,
+        createStoreHandler:       createStore,
+        addProductHandler:        addProduct,
+        addInventoryHandler:      addInventory,
+        updatePriceHandler:       updatePrice,
+        addModifierHandler:       addModifierGroup,
+        addIngredientHandler:     addIngredient,
+        restockIngredientHandler: restockIngredient,
+        setRecipeHandler:         setRecipe,
+        setReorderPointHandler:   setReorderPoint,
+        adjustInventoryHandler:   adjustInventory,
+        countInventoryHandler:    countInventory,
+        transferInventoryHandler: transferInventory,
+        receiveTransferHandler:   receiveTransfer,
+        cancelTransferHandler:    cancelTransfer,
+        setOpeningHoursHandler:   setOpeningHours,
+        pauseOrderingHandler:     pauseOrdering,
+        resumeOrderingHandler:    resumeOrdering,
+        schedulePriceHandler:     schedulePriceChange,
+        cancelPriceHandler:       cancelPriceChange,
+        addPriceRuleHandler:      addPriceRule,
+        removePriceRuleHandler:   removePriceRule,
+        addCategoryHandler:       addCategory,
+        updateCategoryHandler:    updateCategory,
+        editProductHandler:       editProduct,
+        deactivateProductHandler: deactivateProduct,
+        reactivateProductHandler: reactivateProduct,
+        setDeliveryBandsHandler:  setDeliveryBands,
+        setPrepTimesHandler:      setPrepTimes,
+        getProductHandler:        getProduct,
+        listProductsHandler:      listProducts,
+        getInventoryHandler:      getInventory,
+        listLowStockHandler:      listLowStock,
+        listAdjustmentsHandler:   listAdjustments,
+        listTransfersHandler:     listTransfers,
+        getStoreStatusHandler:    getStoreStatus,
+        getPriceHistoryHandler:   getPriceHistory,
+        listPriceRulesHandler:    listPriceRules,
+        listCategoriesHandler:    listCategories,
+        listDeliveryHandler:      listDeliveryBands,
+        getPrepTimesHandler:      getPrepTimes,
     }
 }
 
+// CreateStore opens a new store
+func (s *StoreService) CreateStore(
+    ctx context.Context,
+    req *pb.CreateStoreRequest,
+) (*pb.CreateStoreResponse, error) {
+    // Validate request
+    if req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "name is required")
+    }
+    
+    if req.Location == nil {
+        return nil, status.Error(codes.InvalidArgument, "location is required")
+    }
+    
+    // Create command
+    cmd := commands.CreateStoreCommand{
+        Name: req.Name,
+        Location: dtos.AddressDTO{
+            Street:  req.Location.Street,
+            City:    req.Location.City,
+            State:   req.Location.State,
+            ZipCode: req.Location.ZipCode,
+            Country: req.Location.Country,
+        },
+    }
+    
+    // Execute command
+    storeDTO, err := s.createStoreHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CreateStoreResponse{
+        StoreId: storeDTO.ID,
+    }, nil
+}
+
+// AddProduct adds a new product to the store menu
+func (s *StoreService) AddProduct(
+    ctx context.Context,
+    req *pb.AddProductRequest,
+) (*pb.AddProductResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and name are required")
+    }
+    
+    if req.Price <= 0 {
+        return nil, status.Error(codes.InvalidArgument, "price must be positive")
+    }
+    
+    // Create command
+    cmd := commands.AddProductCommand{
+        StoreID:     req.StoreId,
+        Name:        req.Name,
+        Description: req.Description,
+        Price:       req.Price,
+        Currency:    req.Currency,
+        TaxExempt:   req.TaxExempt,
+    }
+    
+    // Execute command
+    productDTO, err := s.addProductHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.AddProductResponse{
+        ProductId: productDTO.ID,
+    }, nil
+}
+
 // AddInventory adds inventory to a product
 func (s *StoreService) AddInventory(
     ctx context.Context,
@@ -105,6 +286,260 @@ func (s *StoreService) UpdatePrice(
     }, nil
 }
 
+// AddModifierGroup adds sizes, ice levels or add-ons to a product
+func (s *StoreService) AddModifierGroup(
+    ctx context.Context,
+    req *pb.AddModifierGroupRequest,
+) (*pb.AddModifierGroupResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and product_id are required")
+    }
+    
+    if req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "name is required")
+    }
+    
+    if len(req.Options) == 0 {
+        return nil, status.Error(codes.InvalidArgument, "at least one option is required")
+    }
+    
+    // Convert options
+    options := make([]commands.ModifierOptionRequest, len(req.Options))
+    for i, option := range req.Options {
+        if option.PriceDelta < 0 {
+            return nil, status.Error(codes.InvalidArgument, "price_delta cannot be negative")
+        }
+        options[i] = commands.ModifierOptionRequest{
+            Name:       option.Name,
+            PriceDelta: option.PriceDelta,
+        }
+    }
+    
+    // Create command
+    cmd := commands.AddModifierGroupCommand{
+        StoreID:       req.StoreId,
+        ProductID:     req.ProductId,
+        Name:          req.Name,
+        Required:      req.Required,
+        MinSelections: int(req.MinSelections),
+        MaxSelections: int(req.MaxSelections),
+        Options:       options,
+    }
+    
+    // Execute command
+    productDTO, err := s.addModifierHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.AddModifierGroupResponse{
+        Product: toProductPb(*productDTO),
+    }, nil
+}
+
+// AddIngredient adds an ingredient to the store's catalog
+func (s *StoreService) AddIngredient(
+    ctx context.Context,
+    req *pb.AddIngredientRequest,
+) (*pb.AddIngredientResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and name are required")
+    }
+    
+    // Create command
+    cmd := commands.AddIngredientCommand{
+        StoreID: req.StoreId,
+        Name:    req.Name,
+        Unit:    req.Unit,
+    }
+    
+    // Execute command
+    ingredientDTO, err := s.addIngredientHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.AddIngredientResponse{
+        Ingredient: toIngredientPb(*ingredientDTO),
+    }, nil
+}
+
+// RestockIngredient adds stock of an ingredient
+func (s *StoreService) RestockIngredient(
+    ctx context.Context,
+    req *pb.RestockIngredientRequest,
+) (*pb.RestockIngredientResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.IngredientId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and ingredient_id are required")
+    }
+    
+    if req.Quantity <= 0 {
+        return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
+    }
+    
+    // Create command
+    cmd := commands.RestockIngredientCommand{
+        StoreID:      req.StoreId,
+        IngredientID: req.IngredientId,
+        Quantity:     int(req.Quantity),
+    }
+    
+    // Execute command
+    newQuantity, err := s.restockIngredientHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.RestockIngredientResponse{
+        NewQuantity: int32(newQuantity),
+    }, nil
+}
+
+// SetRecipe sets the ingredients for a product or one of its modifier options
+func (s *StoreService) SetRecipe(
+    ctx context.Context,
+    req *pb.SetRecipeRequest,
+) (*pb.SetRecipeResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and product_id are required")
+    }
+    
+    // Convert components
+    components := make([]commands.RecipeComponentRequest, len(req.Components))
+    for i, component := range req.Components {
+        if component.IngredientId == "" || component.Quantity <= 0 {
+            return nil, status.Error(codes.InvalidArgument, "each component needs an ingredient_id and a positive quantity")
+        }
+        components[i] = commands.RecipeComponentRequest{
+            IngredientID: component.IngredientId,
+            Quantity:     int(component.Quantity),
+        }
+    }
+    
+    // Create command
+    cmd := commands.SetRecipeCommand{
+        StoreID:    req.StoreId,
+        ProductID:  req.ProductId,
+        OptionID:   req.OptionId,
+        Components: components,
+    }
+    
+    // Execute command
+    productDTO, err := s.setRecipeHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.SetRecipeResponse{
+        Product: toProductPb(*productDTO),
+    }, nil
+}
+
+// SetReorderPoint sets the stock level at which a product is reported as low
+func (s *StoreService) SetReorderPoint(
+    ctx context.Context,
+    req *pb.SetReorderPointRequest,
+) (*pb.SetReorderPointResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and product_id are required")
+    }
+    
+    if req.ReorderPoint < 0 {
+        return nil, status.Error(codes.InvalidArgument, "reorder_point cannot be negative")
+    }
+    
+    // Create command
+    cmd := commands.SetReorderPointCommand{
+        StoreID:      req.StoreId,
+        ProductID:    req.ProductId,
+        ReorderPoint: int(req.ReorderPoint),
+    }
+    
+    // Execute command
+    err := s.setReorderPointHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.SetReorderPointResponse{
+        Success: true,
+    }, nil
+}
+
+// AdjustInventory records spoilage, breakage, theft or a correction
+func (s *StoreService) AdjustInventory(
+    ctx context.Context,
+    req *pb.AdjustInventoryRequest,
+) (*pb.AdjustInventoryResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ItemId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and item_id are required")
+    }
+    
+    if req.Quantity == 0 {
+        return nil, status.Error(codes.InvalidArgument, "quantity cannot be zero")
+    }
+    
+    // Create command
+    cmd := commands.AdjustInventoryCommand{
+        StoreID:  req.StoreId,
+        ItemType: req.ItemType,
+        ItemID:   req.ItemId,
+        Quantity: int(req.Quantity),
+        Reason:   req.Reason,
+        Note:     req.Note,
+    }
+    
+    // Execute command
+    adjustmentDTO, err := s.adjustInventoryHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.AdjustInventoryResponse{
+        Adjustment: toAdjustmentPb(*adjustmentDTO),
+    }, nil
+}
+
+// CountInventory sets stock on hand to a stocktake count
+func (s *StoreService) CountInventory(
+    ctx context.Context,
+    req *pb.CountInventoryRequest,
+) (*pb.CountInventoryResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ItemId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and item_id are required")
+    }
+    
+    if req.Counted < 0 {
+        return nil, status.Error(codes.InvalidArgument, "counted cannot be negative")
+    }
+    
+    // Create command
+    cmd := commands.CountInventoryCommand{
+        StoreID:  req.StoreId,
+        ItemType: req.ItemType,
+        ItemID:   req.ItemId,
+        Counted:  int(req.Counted),
+        Note:     req.Note,
+    }
+    
+    // Execute command
+    adjustmentDTO, err := s.countInventoryHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CountInventoryResponse{
+        Adjustment: toAdjustmentPb(*adjustmentDTO),
+    }, nil
+}
+
 // GetProduct retrieves product details
 func (s *StoreService) GetProduct(
     ctx context.Context,
@@ -112,8 +547,9 @@ func (s *StoreService) GetProduct(
 ) (*pb.GetProductResponse, error) {
     // Create query
     query := queries.GetProductQuery{
-        StoreID:   req.StoreId,
-        ProductID: req.ProductId,
+        StoreID:         req.StoreId,
+        ProductID:       req.ProductId,
+        IncludeInactive: req.IncludeInactive,
     }
     
     // Execute query
@@ -124,28 +560,1012 @@ func (s *StoreService) GetProduct(
     
     // Convert to protobuf
     return &pb.GetProductResponse{
-        Product: &pb.Product{
-            Id:          productDTO.ID,
-            Name:        productDTO.Name,
-            Description: productDTO.Description,
-            Price:       productDTO.Price,
-            Currency:    productDTO.Currency,
-            IsActive:    productDTO.IsActive,
-        },
+        Product: toProductPb(*productDTO),
+    }, nil
+}
+
+// ListProducts lists the store menu
+func (s *StoreService) ListProducts(
+    ctx context.Context,
+    req *pb.ListProductsRequest,
+) (*pb.ListProductsResponse, error) {
+    // Create query
+    query := queries.ListProductsQuery{
+        StoreID:         req.StoreId,
+        CategoryID:      req.CategoryId,
+        IncludeInactive: req.IncludeInactive,
+    }
+    
+    // Execute query
+    productDTOs, err := s.listProductsHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    products := make([]*pb.Product, len(productDTOs))
+    for i, productDTO := range productDTOs {
+        products[i] = toProductPb(productDTO)
+    }
+    
+    return &pb.ListProductsResponse{
+        Products: products,
+    }, nil
+}
+
+// GetInventory retrieves inventory levels for a store
+func (s *StoreService) GetInventory(
+    ctx context.Context,
+    req *pb.GetInventoryRequest,
+) (*pb.GetInventoryResponse, error) {
+    // Create query
+    query := queries.GetInventoryQuery{
+        StoreID: req.StoreId,
+    }
+    
+    // Execute query
+    inventoryDTO, err := s.getInventoryHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    items := make([]*pb.InventoryItem, len(inventoryDTO.Products))
+    for i, productDTO := range inventoryDTO.Products {
+        items[i] = &pb.InventoryItem{
+            ProductId:    productDTO.ID,
+            ProductName:  productDTO.Name,
+            Quantity:     int32(productDTO.Quantity),
+            MadeToOrder:  productDTO.MadeToOrder,
+            Reserved:     int32(productDTO.Reserved),
+            ReorderPoint: int32(productDTO.ReorderPoint),
+            StockLevel:   productDTO.StockLevel,
+            Incoming:     int32(productDTO.Incoming),
+        }
+    }
+    
+    ingredients := make([]*pb.Ingredient, len(inventoryDTO.Ingredients))
+    for i, ingredientDTO := range inventoryDTO.Ingredients {
+        ingredients[i] = toIngredientPb(ingredientDTO)
+    }
+    
+    return &pb.GetInventoryResponse{
+        Items:       items,
+        Ingredients: ingredients,
+    }, nil
+}
+
+// ListLowStock lists products that are low on or out of stock
+func (s *StoreService) ListLowStock(
+    ctx context.Context,
+    req *pb.ListLowStockRequest,
+) (*pb.ListLowStockResponse, error) {
+    // Create query
+    query := queries.ListLowStockQuery{
+        StoreID: req.StoreId,
+    }
+    
+    // Execute query
+    alertDTOs, err := s.listLowStockHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    alerts := make([]*pb.StockAlert, len(alertDTOs))
+    for i, alertDTO := range alertDTOs {
+        alerts[i] = &pb.StockAlert{
+            StoreId:      alertDTO.StoreID,
+            ProductId:    alertDTO.ProductID,
+            ProductName:  alertDTO.ProductName,
+            Level:        alertDTO.Level,
+            Available:    int32(alertDTO.Available),
+            ReorderPoint: int32(alertDTO.ReorderPoint),
+            RaisedAt:     timestamppb.New(alertDTO.RaisedAt),
+        }
+    }
+    
+    return &pb.ListLowStockResponse{
+        Alerts: alerts,
+    }, nil
+}
+
+// ListInventoryAdjustments returns a store's stock correction history
+func (s *StoreService) ListInventoryAdjustments(
+    ctx context.Context,
+    req *pb.ListInventoryAdjustmentsRequest,
+) (*pb.ListInventoryAdjustmentsResponse, error) {
+    // Create query
+    query := queries.ListInventoryAdjustmentsQuery{
+        StoreID: req.StoreId,
+        ItemID:  req.ItemId,
+    }
+    
+    // Execute query
+    adjustmentDTOs, err := s.listAdjustmentsHandler.Handle(ctx, query)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    adjustments := make([]*pb.InventoryAdjustment, len(adjustmentDTOs))
+    for i, adjustmentDTO := range adjustmentDTOs {
+        adjustments[i] = toAdjustmentPb(adjustmentDTO)
+    }
+    
+    return &pb.ListInventoryAdjustmentsResponse{
+        Adjustments: adjustments,
+    }, nil
+}
+
+// TransferInventory sends stock from one store to another
+func (s *StoreService) TransferInventory(
+    ctx context.Context,
+    req *pb.TransferInventoryRequest,
+) (*pb.TransferInventoryResponse, error) {
+    // Validate request
+    if req.SourceStoreId == "" || req.DestinationStoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "source_store_id and destination_store_id are required")
+    }
+    
+    if len(req.Lines) == 0 {
+        return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+    }
+    
+    // Create command
+    cmd := commands.TransferInventoryCommand{
+        SourceStoreID:      req.SourceStoreId,
+        DestinationStoreID: req.DestinationStoreId,
+        Lines:              make([]commands.TransferLineInput, len(req.Lines)),
+        Note:               req.Note,
+    }
+    for i, line := range req.Lines {
+        cmd.Lines[i] = commands.TransferLineInput{
+            ItemType: line.ItemType,
+            ItemID:   line.ItemId,
+            Quantity: int(line.Quantity),
+        }
+    }
+    
+    // Execute command
+    transferDTO, err := s.transferInventoryHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.TransferInventoryResponse{
+        Transfer: toTransferPb(transferDTO),
     }, nil
 }
 
-// Helper function to convert domain errors to gRPC status
-func toGRPCError(err error) error {
-    // Map domain errors to appropriate gRPC codes
-    switch err {
-    case store.ErrStoreNotFound:
-        return status.Error(codes.NotFound, "store not found")
-    case store.ErrProductNotFound:
-        return status.Error(codes.NotFound, "product not found")
-    case store.ErrInsufficientStock:
-        return status.Error(codes.FailedPrecondition, "insufficient stock")
-    default:
-        return status.Error(codes.Internal, err.Error())
+// ReceiveTransfer checks transferred stock in at the destination store
+func (s *StoreService) ReceiveTransfer(
+    ctx context.Context,
+    req *pb.ReceiveTransferRequest,
+) (*pb.ReceiveTransferResponse, error) {
+    // Validate request
+    if req.TransferId == "" {
+        return nil, status.Error(codes.InvalidArgument, "transfer_id is required")
+    }
+    
+    // Execute command
+    transferDTO, err := s.receiveTransferHandler.Handle(ctx, commands.ReceiveTransferCommand{
+        TransferID: req.TransferId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.ReceiveTransferResponse{
+        Transfer: toTransferPb(transferDTO),
+    }, nil
+}
+
+// CancelTransfer calls off a transfer and returns its stock to the source store
+func (s *StoreService) CancelTransfer(
+    ctx context.Context,
+    req *pb.CancelTransferRequest,
+) (*pb.CancelTransferResponse, error) {
+    // Validate request
+    if req.TransferId == "" {
+        return nil, status.Error(codes.InvalidArgument, "transfer_id is required")
+    }
+    
+    // Execute command
+    transferDTO, err := s.cancelTransferHandler.Handle(ctx, commands.CancelTransferCommand{
+        TransferID: req.TransferId,
+        Reason:     req.Reason,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CancelTransferResponse{
+        Transfer: toTransferPb(transferDTO),
+    }, nil
+}
+
+// ListOpenTransfers lists transfers still in transit to or from a store
+func (s *StoreService) ListOpenTransfers(
+    ctx context.Context,
+    req *pb.ListOpenTransfersRequest,
+) (*pb.ListOpenTransfersResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute query
+    transferDTOs, err := s.listTransfersHandler.Handle(ctx, queries.ListOpenTransfersQuery{
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to protobuf
+    transfers := make([]*pb.Transfer, len(transferDTOs))
+    for i, transferDTO := range transferDTOs {
+        transfers[i] = toTransferPb(transferDTO)
+    }
+    
+    return &pb.ListOpenTransfersResponse{
+        Transfers: transfers,
+    }, nil
+}
+
+// SetOpeningHours replaces a store's weekly schedule and holiday closures
+func (s *StoreService) SetOpeningHours(
+    ctx context.Context,
+    req *pb.SetOpeningHoursRequest,
+) (*pb.SetOpeningHoursResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.Timezone == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and timezone are required")
+    }
+    
+    // Create command
+    cmd := commands.SetOpeningHoursCommand{
+        StoreID:  req.StoreId,
+        Timezone: req.Timezone,
+        Periods:  make([]dtos.OpeningPeriodDTO, len(req.Periods)),
+        Holidays: make([]dtos.HolidayClosureDTO, len(req.Holidays)),
+    }
+    for i, period := range req.Periods {
+        cmd.Periods[i] = dtos.OpeningPeriodDTO{
+            Day:    period.Day,
+            Opens:  period.Opens,
+            Closes: period.Closes,
+        }
+    }
+    for i, holiday := range req.Holidays {
+        cmd.Holidays[i] = dtos.HolidayClosureDTO{
+            Date: holiday.Date,
+            Name: holiday.Name,
+        }
+    }
+    
+    // Execute command
+    hoursDTO, err := s.setOpeningHoursHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.SetOpeningHoursResponse{
+        OpeningHours: toOpeningHoursPb(hoursDTO),
+    }, nil
+}
+
+// PauseOrdering stops a store taking orders for a while
+func (s *StoreService) PauseOrdering(
+    ctx context.Context,
+    req *pb.PauseOrderingRequest,
+) (*pb.PauseOrderingResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.Reason == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and reason are required")
+    }
+    
+    // Create command
+    cmd := commands.PauseOrderingCommand{
+        StoreID: req.StoreId,
+        Reason:  req.Reason,
+    }
+    if req.Until != nil {
+        cmd.Until = req.Until.AsTime()
+    }
+    
+    // Execute command
+    statusDTO, err := s.pauseOrderingHandler.Handle(ctx, cmd)
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.PauseOrderingResponse{
+        Status: toStoreStatusPb(statusDTO),
+    }, nil
+}
+
+// ResumeOrdering lifts a pause
+func (s *StoreService) ResumeOrdering(
+    ctx context.Context,
+    req *pb.ResumeOrderingRequest,
+) (*pb.ResumeOrderingResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute command
+    statusDTO, err := s.resumeOrderingHandler.Handle(ctx, commands.ResumeOrderingCommand{
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.ResumeOrderingResponse{
+        Status: toStoreStatusPb(statusDTO),
+    }, nil
+}
+
+// GetStoreStatus reports whether a store is taking orders right now
+func (s *StoreService) GetStoreStatus(
+    ctx context.Context,
+    req *pb.GetStoreStatusRequest,
+) (*pb.GetStoreStatusResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute query
+    statusDTO, err := s.getStoreStatusHandler.Handle(ctx, queries.GetStoreStatusQuery{
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.GetStoreStatusResponse{
+        Status: toStoreStatusPb(statusDTO),
+    }, nil
+}
+
+// SchedulePriceChange queues a product price change for a future time
+func (s *StoreService) SchedulePriceChange(
+    ctx context.Context,
+    req *pb.SchedulePriceChangeRequest,
+) (*pb.SchedulePriceChangeResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" || req.EffectiveAt == nil {
+        return nil, status.Error(codes.InvalidArgument, "store_id, product_id and effective_at are required")
+    }
+    
+    // Execute command
+    changeDTO, err := s.schedulePriceHandler.Handle(ctx, commands.SchedulePriceChangeCommand{
+        StoreID:     req.StoreId,
+        ProductID:   req.ProductId,
+        NewPrice:    req.NewPrice,
+        EffectiveAt: req.EffectiveAt.AsTime(),
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.SchedulePriceChangeResponse{
+        Change: toScheduledPriceChangePb(*changeDTO),
+    }, nil
+}
+
+// CancelPriceChange drops a queued price change before it takes effect
+func (s *StoreService) CancelPriceChange(
+    ctx context.Context,
+    req *pb.CancelPriceChangeRequest,
+) (*pb.CancelPriceChangeResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" || req.ChangeId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id, product_id and change_id are required")
+    }
+    
+    // Execute command
+    err := s.cancelPriceHandler.Handle(ctx, commands.CancelPriceChangeCommand{
+        StoreID:   req.StoreId,
+        ProductID: req.ProductId,
+        ChangeID:  req.ChangeId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.CancelPriceChangeResponse{Success: true}, nil
+}
+
+// AddPriceRule sets up a recurring discount window such as happy hour
+func (s *StoreService) AddPriceRule(
+    ctx context.Context,
+    req *pb.AddPriceRuleRequest,
+) (*pb.AddPriceRuleResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and name are required")
+    }
+    
+    // Execute command
+    ruleDTO, err := s.addPriceRuleHandler.Handle(ctx, commands.AddPriceRuleCommand{
+        StoreID:    req.StoreId,
+        Name:       req.Name,
+        Days:       req.Days,
+        Starts:     req.Starts,
+        Ends:       req.Ends,
+        PercentOff: int(req.PercentOff),
+        ProductIDs: req.ProductIds,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.AddPriceRuleResponse{
+        Rule: toPriceRulePb(*ruleDTO),
+    }, nil
+}
+
+// RemovePriceRule takes down a recurring discount window
+func (s *StoreService) RemovePriceRule(
+    ctx context.Context,
+    req *pb.RemovePriceRuleRequest,
+) (*pb.RemovePriceRuleResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.RuleId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and rule_id are required")
+    }
+    
+    // Execute command
+    err := s.removePriceRuleHandler.Handle(ctx, commands.RemovePriceRuleCommand{
+        StoreID: req.StoreId,
+        RuleID:  req.RuleId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.RemovePriceRuleResponse{Success: true}, nil
+}
+
+// GetPriceHistory returns a product's past, current and upcoming prices
+func (s *StoreService) GetPriceHistory(
+    ctx context.Context,
+    req *pb.GetPriceHistoryRequest,
+) (*pb.GetPriceHistoryResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and product_id are required")
+    }
+    
+    // Execute query
+    historyDTO, err := s.getPriceHistoryHandler.Handle(ctx, queries.GetPriceHistoryQuery{
+        StoreID:   req.StoreId,
+        ProductID: req.ProductId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    // Convert to response
+    resp := &pb.GetPriceHistoryResponse{
+        ProductId:    historyDTO.ProductID,
+        Currency:     historyDTO.Currency,
+        CurrentPrice: historyDTO.CurrentPrice,
+        PriceNow:     historyDTO.PriceNow,
+    }
+    for _, entry := range historyDTO.History {
+        pbEntry := &pb.PriceHistoryEntry{
+            Price:         entry.Price,
+            Reason:        entry.Reason,
+            EffectiveFrom: timestamppb.New(entry.EffectiveFrom),
+        }
+        if !entry.EffectiveTo.IsZero() {
+            pbEntry.EffectiveTo = timestamppb.New(entry.EffectiveTo)
+        }
+        resp.History = append(resp.History, pbEntry)
+    }
+    for _, change := range historyDTO.Scheduled {
+        resp.Scheduled = append(resp.Scheduled, toScheduledPriceChangePb(change))
+    }
+    
+    return resp, nil
+}
+
+// ListPriceRules returns a store's recurring discounts
+func (s *StoreService) ListPriceRules(
+    ctx context.Context,
+    req *pb.ListPriceRulesRequest,
+) (*pb.ListPriceRulesResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute query
+    ruleDTOs, err := s.listPriceRulesHandler.Handle(ctx, queries.ListPriceRulesQuery{
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    rules := make([]*pb.PriceRule, len(ruleDTOs))
+    for i, ruleDTO := range ruleDTOs {
+        rules[i] = toPriceRulePb(ruleDTO)
+    }
+    
+    return &pb.ListPriceRulesResponse{Rules: rules}, nil
+}
+
+// AddCategory adds a section to a store's menu
+func (s *StoreService) AddCategory(
+    ctx context.Context,
+    req *pb.AddCategoryRequest,
+) (*pb.AddCategoryResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and name are required")
+    }
+    
+    // Execute command
+    categoryDTO, err := s.addCategoryHandler.Handle(ctx, commands.AddCategoryCommand{
+        StoreID:      req.StoreId,
+        Name:         req.Name,
+        DisplayOrder: int(req.DisplayOrder),
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.AddCategoryResponse{
+        Category: toCategoryPb(*categoryDTO),
+    }, nil
+}
+
+// UpdateCategory renames or moves a menu section
+func (s *StoreService) UpdateCategory(
+    ctx context.Context,
+    req *pb.UpdateCategoryRequest,
+) (*pb.UpdateCategoryResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.CategoryId == "" || req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id, category_id and name are required")
+    }
+    
+    // Execute command
+    categoryDTO, err := s.updateCategoryHandler.Handle(ctx, commands.UpdateCategoryCommand{
+        StoreID:      req.StoreId,
+        CategoryID:   req.CategoryId,
+        Name:         req.Name,
+        DisplayOrder: int(req.DisplayOrder),
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.UpdateCategoryResponse{
+        Category: toCategoryPb(*categoryDTO),
+    }, nil
+}
+
+// EditProduct changes a product's name, description and place on the menu
+func (s *StoreService) EditProduct(
+    ctx context.Context,
+    req *pb.EditProductRequest,
+) (*pb.EditProductResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" || req.Name == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id, product_id and name are required")
+    }
+    
+    // Execute command
+    productDTO, err := s.editProductHandler.Handle(ctx, commands.EditProductCommand{
+        StoreID:      req.StoreId,
+        ProductID:    req.ProductId,
+        Name:         req.Name,
+        Description:  req.Description,
+        CategoryID:   req.CategoryId,
+        DisplayOrder: int(req.DisplayOrder),
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.EditProductResponse{
+        Product: toProductPb(*productDTO),
+    }, nil
+}
+
+// DeactivateProduct takes a product off the menu
+func (s *StoreService) DeactivateProduct(
+    ctx context.Context,
+    req *pb.DeactivateProductRequest,
+) (*pb.DeactivateProductResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and product_id are required")
+    }
+    
+    // Execute command
+    err := s.deactivateProductHandler.Handle(ctx, commands.DeactivateProductCommand{
+        StoreID:   req.StoreId,
+        ProductID: req.ProductId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.DeactivateProductResponse{Success: true}, nil
+}
+
+// ReactivateProduct puts a deactivated product back on the menu
+func (s *StoreService) ReactivateProduct(
+    ctx context.Context,
+    req *pb.ReactivateProductRequest,
+) (*pb.ReactivateProductResponse, error) {
+    // Validate request
+    if req.StoreId == "" || req.ProductId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id and product_id are required")
+    }
+    
+    // Execute command
+    err := s.reactivateProductHandler.Handle(ctx, commands.ReactivateProductCommand{
+        StoreID:   req.StoreId,
+        ProductID: req.ProductId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.ReactivateProductResponse{Success: true}, nil
+}
+
+// ListCategories returns a store's menu sections in display order
+func (s *StoreService) ListCategories(
+    ctx context.Context,
+    req *pb.ListCategoriesRequest,
+) (*pb.ListCategoriesResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute query
+    categoryDTOs, err := s.listCategoriesHandler.Handle(ctx, queries.ListCategoriesQuery{
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    categories := make([]*pb.Category, len(categoryDTOs))
+    for i, categoryDTO := range categoryDTOs {
+        categories[i] = toCategoryPb(categoryDTO)
+    }
+    
+    return &pb.ListCategoriesResponse{Categories: categories}, nil
+}
+
+// SetDeliveryBands replaces where a store delivers and what it charges
+func (s *StoreService) SetDeliveryBands(
+    ctx context.Context,
+    req *pb.SetDeliveryBandsRequest,
+) (*pb.SetDeliveryBandsResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    bands := make([]dtos.DeliveryBandDTO, len(req.Bands))
+    for i, band := range req.Bands {
+        if band.Fee < 0 {
+            return nil, status.Error(codes.InvalidArgument, "delivery fee cannot be negative")
+        }
+        bands[i] = dtos.DeliveryBandDTO{
+            Name:     band.Name,
+            ZipCodes: band.ZipCodes,
+            Fee:      band.Fee,
+            Currency: band.Currency,
+        }
+    }
+    
+    // Execute command
+    bandDTOs, err := s.setDeliveryBandsHandler.Handle(ctx, commands.SetDeliveryBandsCommand{
+        StoreID: req.StoreId,
+        Bands:   bands,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.SetDeliveryBandsResponse{Bands: toDeliveryBandsPb(bandDTOs)}, nil
+}
+
+// ListDeliveryBands returns where a store delivers and what it charges
+func (s *StoreService) ListDeliveryBands(
+    ctx context.Context,
+    req *pb.ListDeliveryBandsRequest,
+) (*pb.ListDeliveryBandsResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute query
+    bandDTOs, err := s.listDeliveryHandler.Handle(ctx, queries.ListDeliveryBandsQuery{
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.ListDeliveryBandsResponse{Bands: toDeliveryBandsPb(bandDTOs)}, nil
+}
+
+// toDeliveryBandsPb converts delivery band DTOs to protobuf messages
+func toDeliveryBandsPb(bandDTOs []dtos.DeliveryBandDTO) []*pb.DeliveryBand {
+    bands := make([]*pb.DeliveryBand, len(bandDTOs))
+    for i, band := range bandDTOs {
+        bands[i] = &pb.DeliveryBand{
+            Name:     band.Name,
+            ZipCodes: band.ZipCodes,
+            Fee:      band.Fee,
+            Currency: band.Currency,
+        }
+    }
+    return bands
+}
+
+// SetPrepTimes changes the figures a store's ready estimates are based on
+func (s *StoreService) SetPrepTimes(
+    ctx context.Context,
+    req *pb.SetPrepTimesRequest,
+) (*pb.SetPrepTimesResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    if req.PrepTimes == nil {
+        return nil, status.Error(codes.InvalidArgument, "prep_times is required")
+    }
+    
+    // Execute command
+    timesDTO, err := s.setPrepTimesHandler.Handle(ctx, commands.SetPrepTimesCommand{
+        StoreID: req.StoreId,
+        PrepTimes: dtos.PrepTimesDTO{
+            BaseMinutes:       int(req.PrepTimes.BaseMinutes),
+            MinutesPerItem:    int(req.PrepTimes.MinutesPerItem),
+            LargeOrderAmount:  req.PrepTimes.LargeOrderAmount,
+            Currency:          req.PrepTimes.Currency,
+            LargeOrderMinutes: int(req.PrepTimes.LargeOrderMinutes),
+            MinutesPerQueued:  int(req.PrepTimes.MinutesPerQueued),
+        },
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.SetPrepTimesResponse{PrepTimes: toPrepTimesPb(*timesDTO)}, nil
+}
+
+// GetPrepTimes returns the figures a store's ready estimates are based on
+func (s *StoreService) GetPrepTimes(
+    ctx context.Context,
+    req *pb.GetPrepTimesRequest,
+) (*pb.GetPrepTimesResponse, error) {
+    // Validate request
+    if req.StoreId == "" {
+        return nil, status.Error(codes.InvalidArgument, "store_id is required")
+    }
+    
+    // Execute query
+    timesDTO, err := s.getPrepTimesHandler.Handle(ctx, queries.GetPrepTimesQuery{
+        StoreID: req.StoreId,
+    })
+    if err != nil {
+        return nil, toGRPCError(err)
+    }
+    
+    return &pb.GetPrepTimesResponse{PrepTimes: toPrepTimesPb(*timesDTO)}, nil
+}
+
+// toPrepTimesPb converts a prep times DTO to its protobuf message
+func toPrepTimesPb(timesDTO dtos.PrepTimesDTO) *pb.PrepTimes {
+    return &pb.PrepTimes{
+        BaseMinutes:       int32(timesDTO.BaseMinutes),
+        MinutesPerItem:    int32(timesDTO.MinutesPerItem),
+        LargeOrderAmount:  timesDTO.LargeOrderAmount,
+        Currency:          timesDTO.Currency,
+        LargeOrderMinutes: int32(timesDTO.LargeOrderMinutes),
+        MinutesPerQueued:  int32(timesDTO.MinutesPerQueued),
+    }
+}
+
+// toCategoryPb converts a category DTO to its protobuf message
+func toCategoryPb(categoryDTO dtos.CategoryDTO) *pb.Category {
+    return &pb.Category{
+        Id:           categoryDTO.ID,
+        Name:         categoryDTO.Name,
+        DisplayOrder: int32(categoryDTO.DisplayOrder),
+    }
+}
+
+// toScheduledPriceChangePb converts a scheduled price DTO to its protobuf message
+func toScheduledPriceChangePb(changeDTO dtos.ScheduledPriceDTO) *pb.ScheduledPriceChange {
+    return &pb.ScheduledPriceChange{
+        Id:          changeDTO.ID,
+        ProductId:   changeDTO.ProductID,
+        Price:       changeDTO.Price,
+        EffectiveAt: timestamppb.New(changeDTO.EffectiveAt),
+    }
+}
+
+// toPriceRulePb converts a price rule DTO to its protobuf message
+func toPriceRulePb(ruleDTO dtos.PriceRuleDTO) *pb.PriceRule {
+    return &pb.PriceRule{
+        Id:         ruleDTO.ID,
+        Name:       ruleDTO.Name,
+        Days:       ruleDTO.Days,
+        Starts:     ruleDTO.Starts,
+        Ends:       ruleDTO.Ends,
+        PercentOff: int32(ruleDTO.PercentOff),
+        ProductIds: ruleDTO.ProductIDs,
+    }
+}
+
+// toOpeningHoursPb converts an opening hours DTO to its protobuf message
+func toOpeningHoursPb(hoursDTO *dtos.OpeningHoursDTO) *pb.OpeningHours {
+    if hoursDTO == nil {
+        return nil
+    }
+    
+    hours := &pb.OpeningHours{Timezone: hoursDTO.Timezone}
+    for _, period := range hoursDTO.Periods {
+        hours.Periods = append(hours.Periods, &pb.OpeningPeriod{
+            Day:    period.Day,
+            Opens:  period.Opens,
+            Closes: period.Closes,
+        })
+    }
+    for _, holiday := range hoursDTO.Holidays {
+        hours.Holidays = append(hours.Holidays, &pb.HolidayClosure{
+            Date: holiday.Date,
+            Name: holiday.Name,
+        })
+    }
+    
+    return hours
+}
+
+// toStoreStatusPb converts a store status DTO to its protobuf message
+func toStoreStatusPb(statusDTO *dtos.StoreStatusDTO) *pb.StoreStatus {
+    storeStatus := &pb.StoreStatus{
+        StoreId:      statusDTO.StoreID,
+        Open:         statusDTO.Open,
+        Reason:       statusDTO.Reason,
+        Paused:       statusDTO.Paused,
+        PauseReason:  statusDTO.PauseReason,
+        OpeningHours: toOpeningHoursPb(statusDTO.OpeningHours),
+    }
+    if !statusDTO.NextOpening.IsZero() {
+        storeStatus.NextOpening = timestamppb.New(statusDTO.NextOpening)
+    }
+    if !statusDTO.PausedUntil.IsZero() {
+        storeStatus.PausedUntil = timestamppb.New(statusDTO.PausedUntil)
+    }
+    
+    return storeStatus
+}
+
+// toTransferPb converts a transfer DTO to its protobuf message
+func toTransferPb(transferDTO *dtos.TransferDTO) *pb.Transfer {
+    lines := make([]*pb.TransferLine, len(transferDTO.Lines))
+    for i, line := range transferDTO.Lines {
+        lines[i] = &pb.TransferLine{
+            ItemType:          line.ItemType,
+            SourceItemId:      line.SourceItemID,
+            DestinationItemId: line.DestinationItemID,
+            ItemName:          line.ItemName,
+            Quantity:          int32(line.Quantity),
+        }
+    }
+    
+    transfer := &pb.Transfer{
+        Id:                 transferDTO.ID,
+        SourceStoreId:      transferDTO.SourceStoreID,
+        DestinationStoreId: transferDTO.DestinationStoreID,
+        Status:             transferDTO.Status,
+        Lines:              lines,
+        Note:               transferDTO.Note,
+        DispatchedAt:       timestamppb.New(transferDTO.DispatchedAt),
+    }
+    if !transferDTO.ClosedAt.IsZero() {
+        transfer.ClosedAt = timestamppb.New(transferDTO.ClosedAt)
+    }
+    
+    return transfer
+}
+
+// toAdjustmentPb converts an inventory adjustment DTO to its protobuf message
+func toAdjustmentPb(adjustmentDTO dtos.InventoryAdjustmentDTO) *pb.InventoryAdjustment {
+    return &pb.InventoryAdjustment{
+        Id:             adjustmentDTO.ID,
+        ItemType:       adjustmentDTO.ItemType,
+        ItemId:         adjustmentDTO.ItemID,
+        ItemName:       adjustmentDTO.ItemName,
+        Delta:          int32(adjustmentDTO.Delta),
+        QuantityBefore: int32(adjustmentDTO.QuantityBefore),
+        QuantityAfter:  int32(adjustmentDTO.QuantityAfter),
+        Reason:         adjustmentDTO.Reason,
+        Note:           adjustmentDTO.Note,
+        RecordedAt:     timestamppb.New(adjustmentDTO.RecordedAt),
+    }
+}
+
+// toProductPb converts a product DTO to its protobuf message
+func toProductPb(productDTO dtos.ProductDTO) *pb.Product {
+    groups := make([]*pb.ModifierGroup, len(productDTO.ModifierGroups))
+    for i, group := range productDTO.ModifierGroups {
+        options := make([]*pb.ModifierOption, len(group.Options))
+        for j, option := range group.Options {
+            options[j] = &pb.ModifierOption{
+                Id:         option.ID,
+                Name:       option.Name,
+                PriceDelta: option.PriceDelta,
+                Recipe:     toRecipePb(option.Recipe),
+            }
+        }
+        
+        groups[i] = &pb.ModifierGroup{
+            Id:            group.ID,
+            Name:          group.Name,
+            Required:      group.Required,
+            MinSelections: int32(group.MinSelections),
+            MaxSelections: int32(group.MaxSelections),
+            Options:       options,
+        }
+    }
+    
+    return &pb.Product{
+        Id:             productDTO.ID,
+        Name:           productDTO.Name,
+        Description:    productDTO.Description,
+        Price:          productDTO.Price,
+        Currency:       productDTO.Currency,
+        IsActive:       productDTO.IsActive,
+        TaxExempt:      productDTO.TaxExempt,
+        ModifierGroups: groups,
+        Recipe:         toRecipePb(productDTO.Recipe),
+        CategoryId:     productDTO.CategoryID,
+        DisplayOrder:   int32(productDTO.DisplayOrder),
+    }
+}
+
+// toRecipePb converts recipe components to their protobuf messages
+func toRecipePb(componentDTOs []dtos.RecipeComponentDTO) []*pb.RecipeComponent {
+    components := make([]*pb.RecipeComponent, len(componentDTOs))
+    for i, componentDTO := range componentDTOs {
+        components[i] = &pb.RecipeComponent{
+            IngredientId: componentDTO.IngredientID,
+            Quantity:     int32(componentDTO.Quantity),
+        }
+    }
+    return components
+}
+
+// toIngredientPb converts an ingredient DTO to its protobuf message
+func toIngredientPb(ingredientDTO dtos.IngredientDTO) *pb.Ingredient {
+    return &pb.Ingredient{
+        Id:       ingredientDTO.ID,
+        Name:     ingredientDTO.Name,
+        Unit:     ingredientDTO.Unit,
+        Quantity: int32(ingredientDTO.Quantity),
+        Reserved: int32(ingredientDTO.Reserved),
+        Incoming: int32(ingredientDTO.Incoming),
     }
 }